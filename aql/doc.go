@@ -0,0 +1,39 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+/*
+Package aql provides a builder for AQL queries.
+
+The builder composes a query from its operations and puts all values
+into bind variables, so user input never becomes part of the query string.
+
+	q := aql.New().
+		For("u", "users").
+		Filter("u.age >= ? && u.name != ?", 18, name).
+		Sort("u.name", aql.Ascending).
+		Limit(0, 10).
+		Return("u")
+	query, bindVars, err := q.Build()
+	if err != nil {
+		// Handle error
+	}
+	cursor, err := db.Query(ctx, query, bindVars)
+*/
+package aql
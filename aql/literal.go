@@ -0,0 +1,69 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package aql
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// IsValidName returns true if the given name is a valid, unquoted AQL variable or attribute name.
+func IsValidName(name string) bool {
+	if name == "" {
+		return false
+	}
+	hasLetter := false
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		switch {
+		case (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z'):
+			hasLetter = true
+		case c == '_' || c == '$':
+			if i > 0 && c == '$' {
+				return false
+			}
+		case c >= '0' && c <= '9':
+			if i == 0 {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	return hasLetter
+}
+
+// Name returns the given name quoted with backticks, so it can be safely used
+// as variable or attribute name in an AQL query.
+func Name(name string) string {
+	return "`" + strings.NewReplacer("\\", "\\\\", "`", "\\`").Replace(name) + "`"
+}
+
+// Literal returns the given value as AQL literal.
+// Strings are quoted and escaped, so the result can be safely embedded in an AQL query.
+// Prefer bind variables over literals where possible.
+func Literal(value interface{}) (string, error) {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(encoded), nil
+}
@@ -0,0 +1,219 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package aql
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SortDirection is the direction of a SORT operation.
+type SortDirection string
+
+const (
+	// Ascending sorts in ascending order.
+	Ascending SortDirection = "ASC"
+	// Descending sorts in descending order.
+	Descending SortDirection = "DESC"
+)
+
+// Query is a builder for an AQL query.
+// Values passed to its methods are added as bind variables.
+// A Query is not safe for concurrent use.
+type Query struct {
+	operations []string
+	bindVars   map[string]interface{}
+	err        error
+}
+
+// New creates a new, empty query.
+func New() *Query {
+	return &Query{
+		bindVars: make(map[string]interface{}),
+	}
+}
+
+// For adds a `FOR variable IN collection` operation.
+// The collection name is passed as collection bind variable.
+func (q *Query) For(variable, collection string) *Query {
+	if !q.checkVariable(variable) {
+		return q
+	}
+	return q.add(fmt.Sprintf("FOR %s IN %s", variable, q.bindCollection(collection)))
+}
+
+// ForIn adds a `FOR variable IN expression` operation.
+// Each `?` in the expression is replaced by a bind parameter holding the matching argument.
+func (q *Query) ForIn(variable, expression string, args ...interface{}) *Query {
+	if !q.checkVariable(variable) {
+		return q
+	}
+	return q.add(fmt.Sprintf("FOR %s IN %s", variable, q.bindExpression(expression, args)))
+}
+
+// Let adds a `LET variable = value` operation.
+// The value is passed as bind variable.
+func (q *Query) Let(variable string, value interface{}) *Query {
+	if !q.checkVariable(variable) {
+		return q
+	}
+	return q.add(fmt.Sprintf("LET %s = %s", variable, q.bind(value)))
+}
+
+// Filter adds a `FILTER expression` operation.
+// Each `?` in the expression is replaced by a bind parameter holding the matching argument.
+func (q *Query) Filter(expression string, args ...interface{}) *Query {
+	return q.add("FILTER " + q.bindExpression(expression, args))
+}
+
+// Sort adds a `SORT expression direction` operation.
+// Multiple calls to Sort are merged into a single SORT operation.
+func (q *Query) Sort(expression string, direction SortDirection) *Query {
+	if direction != Ascending && direction != Descending {
+		q.setError(fmt.Errorf("invalid sort direction '%s'", direction))
+		return q
+	}
+	criterion := expression + " " + string(direction)
+	if last := len(q.operations) - 1; last >= 0 && strings.HasPrefix(q.operations[last], "SORT ") {
+		q.operations[last] += ", " + criterion
+		return q
+	}
+	return q.add("SORT " + criterion)
+}
+
+// Limit adds a `LIMIT offset, count` operation.
+func (q *Query) Limit(offset, count int) *Query {
+	if offset < 0 || count < 0 {
+		q.setError(fmt.Errorf("invalid limit %d, %d", offset, count))
+		return q
+	}
+	return q.add(fmt.Sprintf("LIMIT %d, %d", offset, count))
+}
+
+// Return adds a `RETURN expression` operation.
+// Each `?` in the expression is replaced by a bind parameter holding the matching argument.
+func (q *Query) Return(expression string, args ...interface{}) *Query {
+	return q.add("RETURN " + q.bindExpression(expression, args))
+}
+
+// Raw adds the given operation to the query as is.
+// Each `?` in the operation is replaced by a bind parameter holding the matching argument.
+func (q *Query) Raw(operation string, args ...interface{}) *Query {
+	return q.add(q.bindExpression(operation, args))
+}
+
+// Build returns the query string and its bind variables,
+// which can be passed to Database.Query.
+// It returns the first error encountered while building the query.
+func (q *Query) Build() (string, map[string]interface{}, error) {
+	if q.err != nil {
+		return "", nil, q.err
+	}
+	if len(q.operations) == 0 {
+		return "", nil, fmt.Errorf("empty query")
+	}
+	bindVars := make(map[string]interface{}, len(q.bindVars))
+	for k, v := range q.bindVars {
+		bindVars[k] = v
+	}
+	return strings.Join(q.operations, " "), bindVars, nil
+}
+
+// String returns the query string.
+func (q *Query) String() string {
+	return strings.Join(q.operations, " ")
+}
+
+// add appends the given operation.
+func (q *Query) add(operation string) *Query {
+	if q.err == nil {
+		q.operations = append(q.operations, operation)
+	}
+	return q
+}
+
+// setError records the given error, unless an error was already recorded.
+func (q *Query) setError(err error) {
+	if q.err == nil {
+		q.err = err
+	}
+}
+
+// checkVariable records an error if the given name is not a valid AQL variable name.
+func (q *Query) checkVariable(name string) bool {
+	if !IsValidName(name) {
+		q.setError(fmt.Errorf("invalid variable name '%s'", name))
+		return false
+	}
+	return true
+}
+
+// bind adds the given value as bind variable and returns its bind parameter.
+func (q *Query) bind(value interface{}) string {
+	name := fmt.Sprintf("p%d", len(q.bindVars))
+	q.bindVars[name] = value
+	return "@" + name
+}
+
+// bindCollection adds the given collection name as collection bind variable and returns its bind parameter.
+func (q *Query) bindCollection(collection string) string {
+	name := fmt.Sprintf("@p%d", len(q.bindVars))
+	q.bindVars[name] = collection
+	return "@" + name
+}
+
+// bindExpression replaces every `?` placeholder outside of string literals in the given expression
+// with a bind parameter holding the matching argument.
+func (q *Query) bindExpression(expression string, args []interface{}) string {
+	var sb strings.Builder
+	argIndex := 0
+	for i := 0; i < len(expression); i++ {
+		c := expression[i]
+		switch c {
+		case '\'', '"', '`':
+			end := i + 1
+			for end < len(expression) && expression[end] != c {
+				if expression[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			if end >= len(expression) {
+				end = len(expression) - 1
+			}
+			sb.WriteString(expression[i : end+1])
+			i = end
+		case '?':
+			if argIndex >= len(args) {
+				q.setError(fmt.Errorf("not enough arguments for '%s'", expression))
+				return expression
+			}
+			sb.WriteString(q.bind(args[argIndex]))
+			argIndex++
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	if argIndex != len(args) {
+		q.setError(fmt.Errorf("too many arguments for '%s'", expression))
+	}
+	return sb.String()
+}
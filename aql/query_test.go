@@ -0,0 +1,98 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package aql
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestQueryBuild(t *testing.T) {
+	query, bindVars, err := New().
+		For("u", "users").
+		Filter("u.age >= ? && u.name != '?'", 18).
+		Sort("u.name", Ascending).
+		Sort("u.age", Descending).
+		Limit(5, 10).
+		Return("u").
+		Build()
+	if err != nil {
+		t.Fatalf("Build failed: %s", err)
+	}
+	expectedQuery := "FOR u IN @@p0 FILTER u.age >= @p1 && u.name != '?' SORT u.name ASC, u.age DESC LIMIT 5, 10 RETURN u"
+	if query != expectedQuery {
+		t.Errorf("Expected query '%s', got '%s'", expectedQuery, query)
+	}
+	expectedBindVars := map[string]interface{}{"@p0": "users", "p1": 18}
+	if !reflect.DeepEqual(bindVars, expectedBindVars) {
+		t.Errorf("Expected bind variables %v, got %v", expectedBindVars, bindVars)
+	}
+}
+
+func TestQueryBuildInjection(t *testing.T) {
+	name := "x' || true || '"
+	query, bindVars, err := New().For("u", "users").Filter("u.name == ?", name).Return("u").Build()
+	if err != nil {
+		t.Fatalf("Build failed: %s", err)
+	}
+	if query != "FOR u IN @@p0 FILTER u.name == @p1 RETURN u" {
+		t.Errorf("Unexpected query '%s'", query)
+	}
+	if bindVars["p1"] != name {
+		t.Errorf("Expected value in bind variable, got %v", bindVars)
+	}
+}
+
+func TestQueryBuildErrors(t *testing.T) {
+	tests := []*Query{
+		New(),
+		New().For("u v", "users"),
+		New().Let("1x", 5),
+		New().Filter("a == ? && b == ?", 1),
+		New().Filter("a == ?", 1, 2),
+		New().Sort("a", SortDirection("UP")),
+		New().Limit(-1, 5),
+	}
+	for i, q := range tests {
+		if _, _, err := q.Build(); err == nil {
+			t.Errorf("Expected error for query %d", i)
+		}
+	}
+}
+
+func TestName(t *testing.T) {
+	if n := Name("a`b"); n != "`a\\`b`" {
+		t.Errorf("Unexpected name %s", n)
+	}
+	if !IsValidName("_key") || !IsValidName("$x1") || IsValidName("1x") || IsValidName("a-b") || IsValidName("_") {
+		t.Error("Unexpected IsValidName result")
+	}
+}
+
+func TestLiteral(t *testing.T) {
+	l, err := Literal("it's \"quoted\"")
+	if err != nil {
+		t.Fatalf("Literal failed: %s", err)
+	}
+	if l != `"it's \"quoted\""` {
+		t.Errorf("Unexpected literal %s", l)
+	}
+}
@@ -52,6 +52,8 @@ func BasicAuthentication(userName, password string) Authentication {
 }
 
 // JWTAuthentication creates a JWT token authentication implementation based on the given username & password.
+// The token is obtained using the `_open/auth` API. With the HTTP connection, the token is renewed
+// automatically shortly before it expires, or when the server rejects it.
 func JWTAuthentication(userName, password string) Authentication {
 	return &userNameAuthentication{
 		authType: AuthenticationTypeJWT,
@@ -92,6 +94,12 @@ func RawAuthentication(value string) Authentication {
 	}
 }
 
+// JWTTokenAuthentication creates an authentication implementation based on the given (externally obtained) JWT token.
+// The token is not renewed.
+func JWTTokenAuthentication(token string) Authentication {
+	return RawAuthentication("bearer " + token)
+}
+
 // rawAuthentication implements Raw authentication.
 type rawAuthentication struct {
 	value string
@@ -0,0 +1,196 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package driver
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// BindVars converts the given struct or map into bind variables for an AQL query.
+//
+// For a struct, every exported field becomes a bind variable. The name of the bind variable is
+// taken from the `aql` tag of the field, or the field name if there is no such tag.
+// Fields tagged with `aql:"-"` are skipped. Collection bind variables (`@@name` in the query) are
+// created by prefixing the tag name with `@` (e.g. `aql:"@users"`), or by adding the `collection`
+// option (e.g. `aql:"users,collection"`). Anonymous embedded structs are flattened.
+//
+// For a map, the keys must be strings. The map is copied.
+func BindVars(v interface{}) (map[string]interface{}, error) {
+	val := reflect.ValueOf(v)
+	for val.Kind() == reflect.Ptr {
+		if val.IsNil() {
+			return nil, WithStack(InvalidArgumentError{Message: "bind variables are nil"})
+		}
+		val = val.Elem()
+	}
+	result := make(map[string]interface{})
+	switch val.Kind() {
+	case reflect.Struct:
+		if err := addStructBindVars(val, result); err != nil {
+			return nil, WithStack(err)
+		}
+	case reflect.Map:
+		if val.Type().Key().Kind() != reflect.String {
+			return nil, WithStack(InvalidArgumentError{Message: fmt.Sprintf("bind variables map must have string keys, got %s", val.Type().Key())})
+		}
+		for _, k := range val.MapKeys() {
+			result[k.String()] = val.MapIndex(k).Interface()
+		}
+	default:
+		return nil, WithStack(InvalidArgumentError{Message: fmt.Sprintf("bind variables must be struct or map, got %s", val.Kind())})
+	}
+	return result, nil
+}
+
+// addStructBindVars adds the bind variables of the fields of the given struct to result.
+func addStructBindVars(val reflect.Value, result map[string]interface{}) error {
+	structType := val.Type()
+	for i := 0; i < structType.NumField(); i++ {
+		f := structType.Field(i)
+		tag := f.Tag.Get("aql")
+		if tag == "-" {
+			continue
+		}
+		tagParts := strings.Split(tag, ",")
+		name := tagParts[0]
+		if f.Anonymous && name == "" {
+			fieldVal := val.Field(i)
+			if fieldVal.Kind() == reflect.Ptr {
+				if fieldVal.IsNil() {
+					continue
+				}
+				fieldVal = fieldVal.Elem()
+			}
+			if fieldVal.Kind() == reflect.Struct {
+				if err := addStructBindVars(fieldVal, result); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+		if f.PkgPath != "" {
+			// Unexported field
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		for _, option := range tagParts[1:] {
+			if option == "collection" && !strings.HasPrefix(name, "@") {
+				name = "@" + name
+			}
+		}
+		if _, found := result[name]; found {
+			return InvalidArgumentError{Message: fmt.Sprintf("duplicate bind variable '%s'", name)}
+		}
+		result[name] = val.Field(i).Interface()
+	}
+	return nil
+}
+
+// ValidateBindVars checks that the given bind variables match the bind parameters used in the given AQL query.
+// An InvalidArgumentError is returned if a bind parameter of the query has no bind variable,
+// or if a bind variable is not used in the query.
+// Collection bind parameters (`@@name`) must be given as bind variable `@name`.
+func ValidateBindVars(query string, bindVars map[string]interface{}) error {
+	params := QueryBindParameters(query)
+	used := make(map[string]struct{}, len(params))
+	var missing, unused []string
+	for _, p := range params {
+		used[p] = struct{}{}
+		if _, found := bindVars[p]; !found {
+			missing = append(missing, p)
+		}
+	}
+	for name := range bindVars {
+		if _, found := used[name]; !found {
+			unused = append(unused, name)
+		}
+	}
+	sort.Strings(unused)
+	var messages []string
+	if len(missing) > 0 {
+		messages = append(messages, "missing bind variables: "+strings.Join(missing, ", "))
+	}
+	if len(unused) > 0 {
+		messages = append(messages, "unused bind variables: "+strings.Join(unused, ", "))
+	}
+	if len(messages) > 0 {
+		return WithStack(InvalidArgumentError{Message: strings.Join(messages, "; ")})
+	}
+	return nil
+}
+
+// QueryBindParameters returns the names of the bind parameters used in the given AQL query, in order of first use.
+// Collection bind parameters (`@@name`) are returned as `@name`, which is the name of their bind variable.
+// Bind parameters in string literals and comments are ignored.
+func QueryBindParameters(query string) []string {
+	var result []string
+	seen := make(map[string]struct{})
+	for i := 0; i < len(query); i++ {
+		switch c := query[i]; {
+		case c == '\'' || c == '"' || c == '`':
+			// Skip string literal or quoted name
+			for i++; i < len(query) && query[i] != c; i++ {
+				if query[i] == '\\' {
+					i++
+				}
+			}
+		case c == '/' && i+1 < len(query) && query[i+1] == '/':
+			// Skip single line comment
+			for i += 2; i < len(query) && query[i] != '\n'; i++ {
+			}
+		case c == '/' && i+1 < len(query) && query[i+1] == '*':
+			// Skip multi line comment
+			end := strings.Index(query[i+2:], "*/")
+			if end < 0 {
+				return result
+			}
+			i += end + 3
+		case c == '@':
+			start := i + 1
+			if start < len(query) && query[start] == '@' {
+				start++
+			}
+			end := start
+			for end < len(query) && isBindParameterChar(query[end]) {
+				end++
+			}
+			if end > start {
+				name := query[i+1 : end]
+				if _, found := seen[name]; !found {
+					seen[name] = struct{}{}
+					result = append(result, name)
+				}
+			}
+			i = end - 1
+		}
+	}
+	return result
+}
+
+// isBindParameterChar returns true if the given character can be part of a bind parameter name.
+func isBindParameterChar(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
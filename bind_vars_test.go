@@ -0,0 +1,104 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package driver
+
+import (
+	"reflect"
+	"testing"
+)
+
+type bindVarsBase struct {
+	Limit int `aql:"limit"`
+}
+
+type bindVarsTest struct {
+	bindVarsBase
+	Name       string `aql:"name"`
+	Collection string `aql:"col,collection"`
+	Graph      string `aql:"@graph"`
+	Ignored    string `aql:"-"`
+	Plain      bool
+	unexported string
+}
+
+func TestBindVarsStruct(t *testing.T) {
+	v := bindVarsTest{
+		bindVarsBase: bindVarsBase{Limit: 5},
+		Name:         "foo",
+		Collection:   "users",
+		Graph:        "social",
+		Ignored:      "x",
+		Plain:        true,
+		unexported:   "y",
+	}
+	bindVars, err := BindVars(&v)
+	if err != nil {
+		t.Fatalf("BindVars failed: %s", err)
+	}
+	expected := map[string]interface{}{
+		"limit":  5,
+		"name":   "foo",
+		"@col":   "users",
+		"@graph": "social",
+		"Plain":  true,
+	}
+	if !reflect.DeepEqual(bindVars, expected) {
+		t.Errorf("Expected %v, got %v", expected, bindVars)
+	}
+}
+
+func TestBindVarsMap(t *testing.T) {
+	bindVars, err := BindVars(map[string]string{"name": "foo"})
+	if err != nil {
+		t.Fatalf("BindVars failed: %s", err)
+	}
+	if !reflect.DeepEqual(bindVars, map[string]interface{}{"name": "foo"}) {
+		t.Errorf("Unexpected bind variables %v", bindVars)
+	}
+	if _, err := BindVars(map[int]string{1: "foo"}); !IsInvalidArgument(err) {
+		t.Errorf("Expected InvalidArgumentError, got %v", err)
+	}
+	if _, err := BindVars(5); !IsInvalidArgument(err) {
+		t.Errorf("Expected InvalidArgumentError, got %v", err)
+	}
+}
+
+func TestQueryBindParameters(t *testing.T) {
+	query := "FOR d IN @@col /* @comment */ FILTER d.name == @name && d.x == '@string' // @line\n LIMIT @limit RETURN @name"
+	params := QueryBindParameters(query)
+	expected := []string{"@col", "name", "limit"}
+	if !reflect.DeepEqual(params, expected) {
+		t.Errorf("Expected %v, got %v", expected, params)
+	}
+}
+
+func TestValidateBindVars(t *testing.T) {
+	query := "FOR d IN @@col FILTER d.name == @name RETURN d"
+	if err := ValidateBindVars(query, map[string]interface{}{"@col": "users", "name": "foo"}); err != nil {
+		t.Errorf("Expected success, got %s", err)
+	}
+	if err := ValidateBindVars(query, map[string]interface{}{"@col": "users"}); !IsInvalidArgument(err) {
+		t.Errorf("Expected InvalidArgumentError for missing bind variable, got %v", err)
+	}
+	if err := ValidateBindVars(query, map[string]interface{}{"@col": "users", "name": "foo", "other": 1}); !IsInvalidArgument(err) {
+		t.Errorf("Expected InvalidArgumentError for unused bind variable, got %v", err)
+	}
+}
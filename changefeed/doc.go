@@ -0,0 +1,40 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+/*
+Package changefeed provides a feed of changes of a database, built on the Write-Ahead Log tailing API (`_api/wal/tail`).
+
+A Tailer reads the Write-Ahead Log of a database and emits the operations it finds on a channel:
+
+	t := changefeed.NewTailer(client, db, &changefeed.TailerOptions{From: savedTick})
+	go func() {
+		for op := range t.Operations() {
+			fmt.Printf("%s %s/%s (tick %s)\n", op.Type, op.Collection, op.Key, op.Tick)
+		}
+	}()
+	err := t.Run(ctx)
+
+Operations of a transaction are emitted once the transaction is committed; operations of aborted transactions are dropped.
+Store the tick given to TailerOptions.Checkpoint to resume the feed later from that point.
+The checkpoint does not move beyond the start of an open transaction, so a resumed feed may deliver some operations again.
+
+Tailing is supported by single servers (and leaders in active failover), not by coordinators.
+*/
+package changefeed
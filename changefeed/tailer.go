@@ -0,0 +1,481 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package changefeed
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"path"
+	"strconv"
+	"sync"
+	"time"
+
+	driver "github.com/arangodb/go-driver"
+)
+
+const (
+	defaultPollInterval = time.Second
+	defaultBatchTTL     = time.Minute * 10
+)
+
+// ErrTickNotPresent is returned when the start tick of the tailer is no longer present in the Write-Ahead Log.
+// Operations between that tick and the oldest tick in the log have been lost, so the feed cannot be resumed.
+var ErrTickNotPresent = errors.New("Start tick is no longer present in the Write-Ahead Log")
+
+// OperationType is the type of an Operation.
+type OperationType string
+
+const (
+	// OperationSave is a document that has been inserted, updated or replaced.
+	// The Write-Ahead Log does not distinguish between these operations.
+	OperationSave = OperationType("save")
+	// OperationRemove is a document that has been removed.
+	OperationRemove = OperationType("remove")
+	// OperationCreateCollection is a collection that has been created.
+	OperationCreateCollection = OperationType("createCollection")
+	// OperationDropCollection is a collection that has been dropped.
+	OperationDropCollection = OperationType("dropCollection")
+	// OperationRenameCollection is a collection that has been renamed.
+	OperationRenameCollection = OperationType("renameCollection")
+	// OperationChangeCollection is a collection whose properties have been changed.
+	OperationChangeCollection = OperationType("changeCollection")
+	// OperationCreateIndex is an index that has been created.
+	OperationCreateIndex = OperationType("createIndex")
+	// OperationDropIndex is an index that has been dropped.
+	OperationDropIndex = OperationType("dropIndex")
+)
+
+// Replication marker types, as found in the Write-Ahead Log.
+const (
+	markerCreateCollection  = 2000
+	markerDropCollection    = 2001
+	markerRenameCollection  = 2002
+	markerChangeCollection  = 2003
+	markerCreateIndex       = 2100
+	markerDropIndex         = 2101
+	markerTransactionStart  = 2200
+	markerTransactionCommit = 2201
+	markerTransactionAbort  = 2202
+	markerDocument          = 2300
+	markerRemove            = 2302
+)
+
+var markerOperationTypes = map[int]OperationType{
+	markerCreateCollection: OperationCreateCollection,
+	markerDropCollection:   OperationDropCollection,
+	markerRenameCollection: OperationRenameCollection,
+	markerChangeCollection: OperationChangeCollection,
+	markerCreateIndex:      OperationCreateIndex,
+	markerDropIndex:        OperationDropIndex,
+	markerDocument:         OperationSave,
+	markerRemove:           OperationRemove,
+}
+
+// Operation is a single change found in the Write-Ahead Log.
+type Operation struct {
+	// Tick of the operation in the Write-Ahead Log.
+	Tick driver.Tick
+	// Type of the operation.
+	Type OperationType
+	// Collection is the name of the collection the operation applies to.
+	Collection string
+	// CollectionID is the globally unique ID of the collection the operation applies to.
+	CollectionID string
+	// Key of the document (for OperationSave & OperationRemove).
+	Key string
+	// Data holds the document (for OperationSave), the key & revision of the removed document (for OperationRemove)
+	// or the collection/index definition (for other operations).
+	Data json.RawMessage
+}
+
+// TailerOptions holds optional options for a Tailer.
+type TailerOptions struct {
+	// From is the tick to start tailing from (exclusive).
+	// If not set, tailing starts at the current end of the Write-Ahead Log.
+	From driver.Tick
+	// ChunkSize is the approximate maximum size (in bytes) of the data fetched in a single request.
+	ChunkSize int64
+	// PollInterval is the time to wait before polling again when all operations have been read.
+	// If not set, the Write-Ahead Log is polled every second.
+	PollInterval time.Duration
+	// ServerID identifies this client to the server. It is used to keep a batch that prevents
+	// removal of the Write-Ahead Log while tailing. If not set, no batch is used.
+	ServerID int64
+	// BufferSize is the capacity of the operations channel.
+	BufferSize int
+	// Checkpoint, if set, is called with the tick up to which all operations have been delivered
+	// to the operations channel. Store it and pass it as From to resume the feed.
+	// While a transaction is open, the checkpoint stays just before the start of the oldest open transaction,
+	// so operations delivered after that tick may be delivered again when resuming.
+	Checkpoint func(tick driver.Tick)
+}
+
+// Tailer reads the Write-Ahead Log of a database and emits the operations found on a channel.
+type Tailer struct {
+	c       driver.Client
+	db      driver.Database
+	options TailerOptions
+	ops     chan Operation
+
+	mutex        sync.Mutex
+	lastTick     driver.Tick
+	fetchTick    driver.Tick
+	lastScanned  driver.Tick
+	collections  map[string]string
+	transactions map[string]*transaction
+}
+
+// transaction holds the operations of a transaction that has not been committed or aborted yet.
+type transaction struct {
+	start driver.Tick
+	ops   []Operation
+}
+
+// marker is a single entry of the Write-Ahead Log.
+type marker struct {
+	Tick driver.Tick     `json:"tick"`
+	Type int             `json:"type"`
+	CUID string          `json:"cuid"`
+	TID  string          `json:"tid"`
+	Data json.RawMessage `json:"data"`
+}
+
+// NewTailer creates a new Tailer for the given database.
+func NewTailer(c driver.Client, db driver.Database, opts *TailerOptions) *Tailer {
+	t := &Tailer{
+		c:            c,
+		db:           db,
+		collections:  make(map[string]string),
+		transactions: make(map[string]*transaction),
+	}
+	if opts != nil {
+		t.options = *opts
+	}
+	if t.options.PollInterval <= 0 {
+		t.options.PollInterval = defaultPollInterval
+	}
+	t.lastTick = t.options.From
+	t.fetchTick = t.options.From
+	t.ops = make(chan Operation, t.options.BufferSize)
+	return t
+}
+
+// Operations returns the channel on which the operations are emitted.
+// The channel is closed when Run returns.
+func (t *Tailer) Operations() <-chan Operation {
+	return t.ops
+}
+
+// LastTick returns the tick up to which all operations have been delivered.
+func (t *Tailer) LastTick() driver.Tick {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return t.lastTick
+}
+
+// Run tails the Write-Ahead Log until the given context is canceled or an error occurs.
+// It must be called only once.
+func (t *Tailer) Run(ctx context.Context) error {
+	defer close(t.ops)
+
+	if t.options.ServerID != 0 {
+		batch, err := t.c.Replication().CreateBatch(ctx, t.db, t.options.ServerID, defaultBatchTTL)
+		if err != nil {
+			return driver.WithStack(err)
+		}
+		defer batch.Delete(context.Background())
+		go t.extendBatch(ctx, batch)
+	}
+	if err := t.loadCollections(ctx); err != nil {
+		return driver.WithStack(err)
+	}
+	if t.lastTick == "" {
+		tick, err := t.currentTick(ctx)
+		if err != nil {
+			return driver.WithStack(err)
+		}
+		t.fetchTick = tick
+		t.setLastTick(tick)
+	}
+
+	for {
+		checkMore, err := t.tail(ctx)
+		if err != nil {
+			return driver.WithStack(err)
+		}
+		if !checkMore {
+			select {
+			case <-time.After(t.options.PollInterval):
+			case <-ctx.Done():
+				return driver.WithStack(ctx.Err())
+			}
+		}
+	}
+}
+
+// extendBatch extends the lifetime of the given batch until the given context is canceled.
+func (t *Tailer) extendBatch(ctx context.Context, batch driver.Batch) {
+	for {
+		select {
+		case <-time.After(defaultBatchTTL / 2):
+			batch.Extend(ctx, defaultBatchTTL)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// tail fetches and processes a single chunk of the Write-Ahead Log.
+// Returns true when more data is available.
+func (t *Tailer) tail(ctx context.Context) (bool, error) {
+	conn := t.c.Connection()
+	req, err := conn.NewRequest("GET", path.Join("_db", t.db.Name(), "_api/wal/tail"))
+	if err != nil {
+		return false, driver.WithStack(err)
+	}
+	req.SetQuery("from", string(t.fetchTick))
+	if t.lastScanned != "" {
+		req.SetQuery("lastScanned", string(t.lastScanned))
+	}
+	if t.options.ChunkSize > 0 {
+		req.SetQuery("chunkSize", strconv.FormatInt(t.options.ChunkSize, 10))
+	}
+	if t.options.ServerID != 0 {
+		req.SetQuery("serverId", strconv.FormatInt(t.options.ServerID, 10))
+	}
+	var raw []byte
+	resp, err := conn.Do(driver.WithRawResponse(ctx, &raw), req)
+	if err != nil {
+		return false, driver.WithStack(err)
+	}
+	if err := resp.CheckStatus(200, 204); err != nil {
+		return false, driver.WithStack(err)
+	}
+	if resp.Header("x-arango-replication-frompresent") == "false" {
+		return false, driver.WithStack(ErrTickNotPresent)
+	}
+	if err := t.processMarkers(ctx, raw); err != nil {
+		return false, driver.WithStack(err)
+	}
+	if tick := driver.Tick(resp.Header("x-arango-replication-lastincluded")); tick != "" && tick != "0" {
+		// Always continue reading after the last included tick, the operations of open
+		// transactions are buffered until they are committed.
+		t.fetchTick = tick
+		t.checkpoint(tick)
+	}
+	if scanned := resp.Header("x-arango-replication-lastscanned"); scanned != "" {
+		t.lastScanned = driver.Tick(scanned)
+	}
+	return resp.Header("x-arango-replication-checkmore") == "true", nil
+}
+
+// processMarkers parses the given Write-Ahead Log entries (one per line) and emits the resulting operations.
+func (t *Tailer) processMarkers(ctx context.Context, data []byte) error {
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(nil, len(data)+1)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		var m marker
+		if err := json.Unmarshal(line, &m); err != nil {
+			return driver.WithStack(err)
+		}
+		if err := t.processMarker(ctx, m); err != nil {
+			return driver.WithStack(err)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return driver.WithStack(err)
+	}
+	return nil
+}
+
+// processMarker handles a single Write-Ahead Log entry.
+func (t *Tailer) processMarker(ctx context.Context, m marker) error {
+	switch m.Type {
+	case markerTransactionStart:
+		if _, found := t.transactions[m.TID]; !found {
+			t.transactions[m.TID] = &transaction{start: m.Tick}
+		}
+		return nil
+	case markerTransactionAbort:
+		delete(t.transactions, m.TID)
+		t.checkpoint(m.Tick)
+		return nil
+	case markerTransactionCommit:
+		trx, found := t.transactions[m.TID]
+		delete(t.transactions, m.TID)
+		if found {
+			for _, op := range trx.ops {
+				if err := t.emit(ctx, op); err != nil {
+					return driver.WithStack(err)
+				}
+			}
+		}
+		t.checkpoint(m.Tick)
+		return nil
+	}
+	opType, found := markerOperationTypes[m.Type]
+	if !found {
+		// Not relevant for the feed
+		return nil
+	}
+	op := Operation{
+		Tick:         m.Tick,
+		Type:         opType,
+		CollectionID: m.CUID,
+		Data:         m.Data,
+	}
+	var data struct {
+		Key  string `json:"_key"`
+		Name string `json:"name"`
+	}
+	if len(m.Data) > 0 {
+		if err := json.Unmarshal(m.Data, &data); err != nil {
+			return driver.WithStack(err)
+		}
+	}
+	switch opType {
+	case OperationSave, OperationRemove:
+		op.Key = data.Key
+	case OperationCreateCollection, OperationRenameCollection:
+		if data.Name != "" {
+			t.collections[m.CUID] = data.Name
+		}
+	}
+	if name, found := t.collections[m.CUID]; found {
+		op.Collection = name
+	} else if m.CUID != "" {
+		// Unknown collection, reload the collection names
+		if err := t.loadCollections(ctx); err != nil {
+			return driver.WithStack(err)
+		}
+		op.Collection = t.collections[m.CUID]
+	}
+
+	if trx, found := t.transactions[m.TID]; found && m.TID != "" && m.TID != "0" {
+		trx.ops = append(trx.ops, op)
+		return nil
+	}
+	if err := t.emit(ctx, op); err != nil {
+		return driver.WithStack(err)
+	}
+	t.checkpoint(op.Tick)
+	return nil
+}
+
+// emit sends the given operation to the operations channel.
+func (t *Tailer) emit(ctx context.Context, op Operation) error {
+	select {
+	case t.ops <- op:
+		return nil
+	case <-ctx.Done():
+		return driver.WithStack(ctx.Err())
+	}
+}
+
+// checkpoint records that all operations up to the given tick have been delivered.
+// While transactions are open, the checkpoint is kept just before the start of the oldest one,
+// so its operations are read again when resuming from the checkpoint.
+func (t *Tailer) checkpoint(tick driver.Tick) {
+	if len(t.transactions) == 0 {
+		t.setLastTick(tick)
+		return
+	}
+	var oldest uint64
+	for _, trx := range t.transactions {
+		if start := tickValue(trx.start); oldest == 0 || start < oldest {
+			oldest = start
+		}
+	}
+	if oldest > 0 {
+		t.setLastTick(driver.Tick(strconv.FormatUint(oldest-1, 10)))
+	}
+}
+
+// tickValue returns the numeric value of the given tick, or 0 if it is not a valid tick.
+func tickValue(tick driver.Tick) uint64 {
+	v, _ := strconv.ParseUint(string(tick), 10, 64)
+	return v
+}
+
+// setLastTick stores the given tick as the tick up to which all operations have been delivered.
+func (t *Tailer) setLastTick(tick driver.Tick) {
+	t.mutex.Lock()
+	changed := t.lastTick != tick
+	t.lastTick = tick
+	t.mutex.Unlock()
+	if changed && t.options.Checkpoint != nil {
+		t.options.Checkpoint(tick)
+	}
+}
+
+// currentTick returns the last tick of the Write-Ahead Log.
+func (t *Tailer) currentTick(ctx context.Context) (driver.Tick, error) {
+	conn := t.c.Connection()
+	req, err := conn.NewRequest("GET", path.Join("_db", t.db.Name(), "_api/wal/lastTick"))
+	if err != nil {
+		return "", driver.WithStack(err)
+	}
+	resp, err := conn.Do(ctx, req)
+	if err != nil {
+		return "", driver.WithStack(err)
+	}
+	if err := resp.CheckStatus(200); err != nil {
+		return "", driver.WithStack(err)
+	}
+	var result struct {
+		Tick driver.Tick `json:"tick"`
+	}
+	if err := resp.ParseBody("", &result); err != nil {
+		return "", driver.WithStack(err)
+	}
+	return result.Tick, nil
+}
+
+// loadCollections loads the names of all collections of the database.
+func (t *Tailer) loadCollections(ctx context.Context) error {
+	conn := t.c.Connection()
+	req, err := conn.NewRequest("GET", path.Join("_db", t.db.Name(), "_api/collection"))
+	if err != nil {
+		return driver.WithStack(err)
+	}
+	resp, err := conn.Do(ctx, req)
+	if err != nil {
+		return driver.WithStack(err)
+	}
+	if err := resp.CheckStatus(200); err != nil {
+		return driver.WithStack(err)
+	}
+	var infos []driver.CollectionInfo
+	if err := resp.ParseBody("result", &infos); err != nil {
+		return driver.WithStack(err)
+	}
+	for _, info := range infos {
+		t.collections[info.GloballyUniqueId] = info.Name
+	}
+	return nil
+}
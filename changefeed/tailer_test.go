@@ -0,0 +1,203 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package changefeed
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"testing"
+
+	driver "github.com/arangodb/go-driver"
+)
+
+func TestProcessMarkers(t *testing.T) {
+	var checkpoints []driver.Tick
+	tailer := NewTailer(nil, nil, &TailerOptions{
+		BufferSize: 10,
+		Checkpoint: func(tick driver.Tick) { checkpoints = append(checkpoints, tick) },
+	})
+	tailer.collections["c1"] = "books"
+	data := strings.Join([]string{
+		`{"tick":"10","type":2300,"cuid":"c1","tid":"0","data":{"_key":"a","_rev":"1","title":"A"}}`,
+		`{"tick":"11","type":2200,"tid":"77"}`,
+		`{"tick":"12","type":2300,"cuid":"c1","tid":"77","data":{"_key":"b","_rev":"2"}}`,
+		`{"tick":"13","type":2200,"tid":"78"}`,
+		`{"tick":"14","type":2302,"cuid":"c1","tid":"78","data":{"_key":"a","_rev":"3"}}`,
+		`{"tick":"15","type":2202,"tid":"78"}`,
+		`{"tick":"16","type":2201,"tid":"77"}`,
+		`{"tick":"17","type":2000,"cuid":"c2","tid":"0","data":{"name":"authors"}}`,
+		`{"tick":"18","type":2302,"cuid":"c2","tid":"0","data":{"_key":"x","_rev":"4"}}`,
+		``,
+	}, "\n")
+	if err := tailer.processMarkers(context.Background(), []byte(data)); err != nil {
+		t.Fatalf("Expected success, got %s", err)
+	}
+	close(tailer.ops)
+
+	expected := []Operation{
+		{Tick: "10", Type: OperationSave, Collection: "books", Key: "a"},
+		{Tick: "12", Type: OperationSave, Collection: "books", Key: "b"},
+		{Tick: "17", Type: OperationCreateCollection, Collection: "authors"},
+		{Tick: "18", Type: OperationRemove, Collection: "authors", Key: "x"},
+	}
+	var ops []Operation
+	for op := range tailer.ops {
+		ops = append(ops, op)
+	}
+	if len(ops) != len(expected) {
+		t.Fatalf("Expected %d operations, got %d: %v", len(expected), len(ops), ops)
+	}
+	for i, op := range ops {
+		e := expected[i]
+		if op.Tick != e.Tick || op.Type != e.Type || op.Collection != e.Collection || op.Key != e.Key {
+			t.Errorf("Unexpected operation %d; got %+v, expected %+v", i, op, e)
+		}
+	}
+	if tailer.LastTick() != "18" {
+		t.Errorf("Expected last tick 18, got %s", tailer.LastTick())
+	}
+	if len(checkpoints) == 0 || checkpoints[0] != "10" {
+		t.Errorf("Unexpected checkpoints %v", checkpoints)
+	}
+}
+
+type testChunk struct {
+	markers      []string
+	lastIncluded string
+	checkMore    bool
+}
+
+type testClient struct {
+	driver.Client
+	conn *testConnection
+}
+
+func (c *testClient) Connection() driver.Connection { return c.conn }
+
+type testDatabase struct {
+	driver.Database
+}
+
+func (d *testDatabase) Name() string { return "db" }
+
+type testConnection struct {
+	driver.Connection
+	chunks []testChunk
+	froms  []string
+}
+
+func (c *testConnection) NewRequest(method, path string) (driver.Request, error) {
+	return &testRequest{query: make(map[string]string)}, nil
+}
+
+func (c *testConnection) Do(ctx context.Context, req driver.Request) (driver.Response, error) {
+	c.froms = append(c.froms, req.(*testRequest).query["from"])
+	chunk := c.chunks[0]
+	c.chunks = c.chunks[1:]
+	if raw, ok := ctx.Value(driver.ContextKey("arangodb-rawResponse")).(*[]byte); ok {
+		*raw = []byte(strings.Join(chunk.markers, "\n"))
+	}
+	return &testResponse{headers: map[string]string{
+		"x-arango-replication-lastincluded": chunk.lastIncluded,
+		"x-arango-replication-checkmore":    strconv.FormatBool(chunk.checkMore),
+	}}, nil
+}
+
+type testRequest struct {
+	driver.Request
+	query map[string]string
+}
+
+func (r *testRequest) SetQuery(key, value string) driver.Request {
+	r.query[key] = value
+	return r
+}
+
+type testResponse struct {
+	driver.Response
+	headers map[string]string
+}
+
+func (r *testResponse) CheckStatus(validStatusCodes ...int) error { return nil }
+func (r *testResponse) Header(key string) string                  { return r.headers[key] }
+
+func TestTailTransactionAcrossChunks(t *testing.T) {
+	conn := &testConnection{chunks: []testChunk{
+		{
+			markers: []string{
+				`{"tick":"10","type":2300,"cuid":"c1","tid":"0","data":{"_key":"a"}}`,
+				`{"tick":"11","type":2200,"tid":"77"}`,
+				`{"tick":"12","type":2300,"cuid":"c1","tid":"77","data":{"_key":"b"}}`,
+			},
+			lastIncluded: "12",
+			checkMore:    true,
+		},
+		{
+			markers: []string{
+				`{"tick":"13","type":2300,"cuid":"c1","tid":"0","data":{"_key":"c"}}`,
+				`{"tick":"14","type":2201,"tid":"77"}`,
+			},
+			lastIncluded: "14",
+		},
+	}}
+	var checkpoints []driver.Tick
+	tailer := NewTailer(&testClient{conn: conn}, &testDatabase{}, &TailerOptions{
+		From:       "5",
+		BufferSize: 10,
+		Checkpoint: func(tick driver.Tick) { checkpoints = append(checkpoints, tick) },
+	})
+	tailer.collections["c1"] = "books"
+
+	var keys []string
+	for _, expectedCheckMore := range []bool{true, false} {
+		checkMore, err := tailer.tail(context.Background())
+		if err != nil {
+			t.Fatalf("Expected success, got %s", err)
+		}
+		if checkMore != expectedCheckMore {
+			t.Errorf("Expected checkMore %v, got %v", expectedCheckMore, checkMore)
+		}
+		if expectedCheckMore {
+			// The transaction is still open, so the checkpoint must not move beyond its start
+			if tailer.LastTick() != "10" {
+				t.Errorf("Expected last tick 10 while the transaction is open, got %s", tailer.LastTick())
+			}
+		}
+	}
+	close(tailer.ops)
+	for op := range tailer.ops {
+		keys = append(keys, op.Key)
+	}
+
+	if strings.Join(conn.froms, ",") != "5,12" {
+		t.Errorf("Expected requests from ticks 5 and 12, got %v", conn.froms)
+	}
+	if strings.Join(keys, ",") != "a,c,b" {
+		t.Errorf("Expected each operation to be emitted once, got %v", keys)
+	}
+	if tailer.LastTick() != "14" {
+		t.Errorf("Expected last tick 14, got %s", tailer.LastTick())
+	}
+	if len(checkpoints) != 2 || checkpoints[0] != "10" || checkpoints[1] != "14" {
+		t.Errorf("Unexpected checkpoints %v", checkpoints)
+	}
+}
@@ -54,6 +54,13 @@ type Client interface {
 	// Connection returns the connection used by this client
 	Connection() Connection
 
+	// Do performs a request with the given method and path (relative to the server root, e.g. "_db/mydb/_api/foo")
+	// for APIs that are not (yet) supported by this driver.
+	// The given body is sent when it is not nil, and the response body is unmarshalled into result when it is not nil.
+	// Authentication, endpoint selection and other connection settings apply as for all other requests.
+	// When the server responds with a status code of 300 or higher, an ArangoError is returned together with the response.
+	Do(ctx context.Context, method, path string, body interface{}, result interface{}) (Response, error)
+
 	// Database functions
 	ClientDatabases
 
@@ -69,12 +76,21 @@ type Client interface {
 	// Server/cluster administration functions
 	ClientServerAdmin
 
+	// Log level and log entries functions
+	ClientLog
+
+	// Endpoint health functions
+	ClientHealth
+
 	// Replication functions
 	ClientReplication
 
 	// Backup functions
 	ClientAdminBackup
 
+	// Async job functions
+	ClientAsyncJob
+
 	ClientFoxx
 }
 
@@ -90,6 +106,25 @@ type ClientConfig struct {
 	// If this value is > 0, automatic synchronization is started on a go routine.
 	// This feature requires ArangoDB 3.1.15 or up.
 	SynchronizeEndpointsInterval time.Duration
+	// Logger is used to log all requests performed by the client (method, path, duration & status).
+	// If nil, nothing is logged.
+	Logger Logger
+	// RequestLogLevel is the level at which completed requests are logged. Defaults to LogLevelDebug.
+	RequestLogLevel LogLevel
+	// ErrorLogLevel is the level at which failed requests are logged. Defaults to LogLevelWarning.
+	ErrorLogLevel LogLevel
+	// RetryLogLevel is the level at which retry decisions are logged. Defaults to LogLevelInfo.
+	RetryLogLevel LogLevel
+	// RetryPolicy configures automatic retries of failed requests.
+	// If nil, requests are not retried.
+	RetryPolicy *RetryPolicy
+	// MaxConcurrentRequests limits the number of requests that are in progress at the same time.
+	// Additional requests block until a request completes or their context is done.
+	// If this value is 0, the number of concurrent requests is not limited by the client.
+	MaxConcurrentRequests int
+	// RequestsPerSecond limits the rate at which requests are sent.
+	// If this value is 0, the rate of requests is not limited.
+	RequestsPerSecond float64
 }
 
 // VersionInfo describes the version of a database server.
@@ -0,0 +1,104 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package driver
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// ClientAsyncJob provides access to the async job API via the Client interface.
+type ClientAsyncJob interface {
+	AsyncJob() AsyncJobService
+}
+
+// AsyncJobStatusType is the status of an async job.
+type AsyncJobStatusType string
+
+const (
+	// JobDone is the status of a job whose result is available.
+	JobDone AsyncJobStatusType = "done"
+	// JobPending is the status of a job that is queued or running.
+	JobPending AsyncJobStatusType = "pending"
+)
+
+// AsyncJobDeleteType specifies which async job results are deleted.
+type AsyncJobDeleteType string
+
+const (
+	// DeleteAllJobs deletes the results of all jobs.
+	DeleteAllJobs AsyncJobDeleteType = "all"
+	// DeleteExpiredJobs deletes the results of jobs that finished before AsyncJobDeleteOptions.Stamp.
+	DeleteExpiredJobs AsyncJobDeleteType = "expired"
+	// DeleteSingleJob deletes the result of the job with id AsyncJobDeleteOptions.JobID.
+	DeleteSingleJob AsyncJobDeleteType = "single"
+)
+
+// AsyncJobListOptions contains options for AsyncJobService.List.
+type AsyncJobListOptions struct {
+	// Count is the maximum number of job ids to return.
+	Count int
+}
+
+// AsyncJobDeleteOptions contains options for AsyncJobService.Delete.
+type AsyncJobDeleteOptions struct {
+	// JobID is the id of the job to delete, used with DeleteSingleJob.
+	JobID string
+	// Stamp is the time before which the results of finished jobs are deleted, used with DeleteExpiredJobs.
+	Stamp time.Time
+}
+
+// AsyncJobService provides access to async jobs, started by performing requests
+// with a context configured using WithAsync.
+// The result of a finished job is fetched by performing the same request again
+// with a context configured using WithAsyncID.
+type AsyncJobService interface {
+	// List returns the ids of jobs with the given status.
+	List(ctx context.Context, jobType AsyncJobStatusType, opts *AsyncJobListOptions) ([]string, error)
+	// Status returns the status of the job with the given id.
+	Status(ctx context.Context, jobID string) (AsyncJobStatusType, error)
+	// Cancel cancels the job with the given id.
+	Cancel(ctx context.Context, jobID string) error
+	// Delete deletes the results of jobs.
+	Delete(ctx context.Context, deleteType AsyncJobDeleteType, opts *AsyncJobDeleteOptions) error
+}
+
+// AsyncJobInProgressError is returned for a request performed with a context configured using WithAsync
+// (or WithAsyncID) when its job has not finished yet.
+type AsyncJobInProgressError struct {
+	// JobID is the id of the job.
+	JobID string
+}
+
+// Error returns the error message of an AsyncJobInProgressError.
+func (e AsyncJobInProgressError) Error() string {
+	return fmt.Sprintf("Async job %s is in progress", e.JobID)
+}
+
+// IsAsyncJobInProgress returns the id of the job and true if the given error is (or is caused by)
+// an AsyncJobInProgressError.
+func IsAsyncJobInProgress(err error) (string, bool) {
+	if aerr, ok := Cause(err).(AsyncJobInProgressError); ok {
+		return aerr.JobID, true
+	}
+	return "", false
+}
@@ -0,0 +1,232 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package driver
+
+import (
+	"context"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+)
+
+const (
+	asyncHeader   = "x-arango-async"
+	asyncIDHeader = "x-arango-async-id"
+)
+
+// withAsyncJobEndpoint configures the given context to send requests to the endpoint (found in the given cache)
+// that started the given job, unless the context already specifies an endpoint.
+func withAsyncJobEndpoint(ctx context.Context, jobs *endpointCache, jobID string) context.Context {
+	ctx = contextOrBackground(ctx)
+	if ctx.Value(keyEndpoint) != nil {
+		return ctx
+	}
+	if endpoint, found := jobs.get(jobID); found {
+		return WithEndpoint(ctx, endpoint)
+	}
+	return ctx
+}
+
+type asyncJobService struct {
+	conn Connection
+	jobs *endpointCache
+}
+
+// AsyncJob returns the service used to manage async jobs.
+func (c *client) AsyncJob() AsyncJobService {
+	return &asyncJobService{
+		conn: c.conn,
+		jobs: c.asyncJobs,
+	}
+}
+
+// List returns the ids of jobs with the given status.
+func (s *asyncJobService) List(ctx context.Context, jobType AsyncJobStatusType, opts *AsyncJobListOptions) ([]string, error) {
+	req, err := s.conn.NewRequest("GET", path.Join("_api/job", string(jobType)))
+	if err != nil {
+		return nil, WithStack(err)
+	}
+	if opts != nil && opts.Count > 0 {
+		req.SetQuery("count", strconv.Itoa(opts.Count))
+	}
+	var raw []byte
+	resp, err := s.conn.Do(WithRawResponse(ctx, &raw), req)
+	if err != nil {
+		return nil, WithStack(err)
+	}
+	if err := resp.CheckStatus(200); err != nil {
+		return nil, WithStack(err)
+	}
+	var ids []string
+	if err := s.conn.Unmarshal(raw, &ids); err != nil {
+		return nil, WithStack(err)
+	}
+	return ids, nil
+}
+
+// Status returns the status of the job with the given id.
+func (s *asyncJobService) Status(ctx context.Context, jobID string) (AsyncJobStatusType, error) {
+	req, err := s.conn.NewRequest("GET", path.Join("_api/job", jobID))
+	if err != nil {
+		return "", WithStack(err)
+	}
+	resp, err := s.conn.Do(withAsyncJobEndpoint(ctx, s.jobs, jobID), req)
+	if err != nil {
+		return "", WithStack(err)
+	}
+	if err := resp.CheckStatus(200, 204); err != nil {
+		return "", WithStack(err)
+	}
+	if resp.StatusCode() == 204 {
+		return JobPending, nil
+	}
+	return JobDone, nil
+}
+
+// Cancel cancels the job with the given id.
+func (s *asyncJobService) Cancel(ctx context.Context, jobID string) error {
+	req, err := s.conn.NewRequest("PUT", path.Join("_api/job", jobID, "cancel"))
+	if err != nil {
+		return WithStack(err)
+	}
+	resp, err := s.conn.Do(withAsyncJobEndpoint(ctx, s.jobs, jobID), req)
+	if err != nil {
+		return WithStack(err)
+	}
+	if err := resp.CheckStatus(200); err != nil {
+		return WithStack(err)
+	}
+	return nil
+}
+
+// Delete deletes the results of jobs.
+func (s *asyncJobService) Delete(ctx context.Context, deleteType AsyncJobDeleteType, opts *AsyncJobDeleteOptions) error {
+	p := path.Join("_api/job", string(deleteType))
+	if deleteType == DeleteSingleJob {
+		if opts == nil || opts.JobID == "" {
+			return WithStack(InvalidArgumentError{Message: "JobID must be set to delete a single job"})
+		}
+		p = path.Join("_api/job", opts.JobID)
+		ctx = withAsyncJobEndpoint(ctx, s.jobs, opts.JobID)
+	}
+	req, err := s.conn.NewRequest("DELETE", p)
+	if err != nil {
+		return WithStack(err)
+	}
+	if deleteType == DeleteExpiredJobs {
+		if opts == nil || opts.Stamp.IsZero() {
+			return WithStack(InvalidArgumentError{Message: "Stamp must be set to delete expired jobs"})
+		}
+		req.SetQuery("stamp", strconv.FormatInt(opts.Stamp.Unix(), 10))
+	}
+	resp, err := s.conn.Do(ctx, req)
+	if err != nil {
+		return WithStack(err)
+	}
+	if err := resp.CheckStatus(200); err != nil {
+		return WithStack(err)
+	}
+	switch deleteType {
+	case DeleteSingleJob:
+		s.jobs.remove(opts.JobID)
+	case DeleteAllJobs:
+		s.jobs.clear()
+	case DeleteExpiredJobs:
+		// Jobs that started after the stamp cannot have finished before it
+		s.jobs.removeBefore(opts.Stamp)
+	}
+	return nil
+}
+
+// newAsyncConnection creates a connection that supports requests performed with a context
+// configured using WithAsync or WithAsyncID.
+// The endpoints of the coordinators that started the jobs are stored in the given cache.
+func newAsyncConnection(conn Connection, jobs *endpointCache) Connection {
+	return &asyncConnection{Connection: conn, jobs: jobs}
+}
+
+// asyncConnection is a Connection that starts async jobs and fetches their results.
+type asyncConnection struct {
+	Connection
+	jobs *endpointCache
+}
+
+// Do performs a given request, returning its response.
+func (c *asyncConnection) Do(ctx context.Context, req Request) (Response, error) {
+	if ctx == nil {
+		return c.Connection.Do(ctx, req)
+	}
+	if v := ctx.Value(keyAsyncID); v != nil {
+		if jobID, ok := v.(string); ok && jobID != "" {
+			return c.fetchJobResult(ctx, req, jobID)
+		}
+	}
+	if v := ctx.Value(keyAsync); v != nil {
+		if async, ok := v.(bool); ok && async {
+			req.SetHeader(asyncHeader, "store")
+			resp, err := c.Connection.Do(ctx, req)
+			if err != nil {
+				return nil, WithStack(err)
+			}
+			if jobID := resp.Header(asyncIDHeader); resp.StatusCode() == http.StatusAccepted && jobID != "" {
+				if endpoint := resp.Endpoint(); endpoint != "" {
+					c.jobs.set(jobID, endpoint)
+				}
+				return nil, WithStack(AsyncJobInProgressError{JobID: jobID})
+			}
+			return resp, nil
+		}
+	}
+	return c.Connection.Do(ctx, req)
+}
+
+// fetchJobResult fetches the result of the job with given id, started by the given request.
+func (c *asyncConnection) fetchJobResult(ctx context.Context, req Request, jobID string) (Response, error) {
+	// Jobs are stored per database
+	p := path.Join("_api/job", jobID)
+	if parts := strings.Split(strings.TrimPrefix(req.Path(), "/"), "/"); len(parts) > 1 && parts[0] == "_db" {
+		p = path.Join("_db", parts[1], p)
+	}
+	jobReq, err := c.Connection.NewRequest("PUT", p)
+	if err != nil {
+		return nil, WithStack(err)
+	}
+	resp, err := c.Connection.Do(withAsyncJobEndpoint(ctx, c.jobs, jobID), jobReq)
+	if err != nil {
+		return nil, WithStack(err)
+	}
+	if resp.StatusCode() == http.StatusNoContent {
+		return nil, WithStack(AsyncJobInProgressError{JobID: jobID})
+	}
+	// The result of a job can only be fetched once
+	c.jobs.remove(jobID)
+	return resp, nil
+}
+
+// SetAuthentication creates a copy of the connection with the given authentication.
+func (c *asyncConnection) SetAuthentication(auth Authentication) (Connection, error) {
+	conn, err := c.Connection.SetAuthentication(auth)
+	if err != nil {
+		return nil, WithStack(err)
+	}
+	return newAsyncConnection(conn, c.jobs), nil
+}
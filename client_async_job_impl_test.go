@@ -0,0 +1,103 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package driver
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+type testAsyncJobResponse struct {
+	Response
+	status int
+}
+
+func (r *testAsyncJobResponse) StatusCode() int                           { return r.status }
+func (r *testAsyncJobResponse) Endpoint() string                          { return "http://coordinator1:8529" }
+func (r *testAsyncJobResponse) CheckStatus(validStatusCodes ...int) error { return nil }
+
+func (r *testAsyncJobResponse) Header(key string) string {
+	if key == asyncIDHeader {
+		return "job1"
+	}
+	return ""
+}
+
+type testAsyncJobRequest struct {
+	testRetryRequest
+}
+
+func (r *testAsyncJobRequest) SetHeader(key, value string) Request { return r }
+func (r *testAsyncJobRequest) SetQuery(key, value string) Request  { return r }
+
+type testAsyncJobConnection struct {
+	Connection
+}
+
+func (c *testAsyncJobConnection) NewRequest(method, path string) (Request, error) {
+	return &testAsyncJobRequest{testRetryRequest{method: method}}, nil
+}
+
+func (c *testAsyncJobConnection) Do(ctx context.Context, req Request) (Response, error) {
+	if req.Method() == "DELETE" {
+		return &testAsyncJobResponse{status: 200}, nil
+	}
+	return &testAsyncJobResponse{status: 202}, nil
+}
+
+func TestAsyncJobEndpoints(t *testing.T) {
+	jobs := newEndpointCache(defaultEndpointCacheSize)
+	c := &client{conn: newAsyncConnection(&testAsyncJobConnection{}, jobs), asyncJobs: jobs}
+	startJob := func() {
+		if _, err := c.conn.Do(WithAsync(context.Background()), &testAsyncJobRequest{}); err == nil {
+			t.Fatal("Expected AsyncJobInProgressError")
+		} else if jobID, ok := IsAsyncJobInProgress(err); !ok || jobID != "job1" {
+			t.Fatalf("Expected AsyncJobInProgressError, got %v", err)
+		}
+		if endpoint, found := jobs.get("job1"); !found || endpoint != "http://coordinator1:8529" {
+			t.Fatalf("Expected job endpoint to be stored, got %q", endpoint)
+		}
+	}
+
+	startJob()
+	if err := c.AsyncJob().Delete(context.Background(), DeleteAllJobs, nil); err != nil {
+		t.Fatalf("Delete failed: %s", err)
+	}
+	if _, found := jobs.get("job1"); found {
+		t.Error("Expected job endpoints to be cleared after deleting all jobs")
+	}
+
+	startJob()
+	if err := c.AsyncJob().Delete(context.Background(), DeleteExpiredJobs, &AsyncJobDeleteOptions{Stamp: time.Now().Add(-time.Hour)}); err != nil {
+		t.Fatalf("Delete failed: %s", err)
+	}
+	if _, found := jobs.get("job1"); !found {
+		t.Error("Expected endpoint of job started after the stamp to be kept")
+	}
+	if err := c.AsyncJob().Delete(context.Background(), DeleteExpiredJobs, &AsyncJobDeleteOptions{Stamp: time.Now().Add(time.Second)}); err != nil {
+		t.Fatalf("Delete failed: %s", err)
+	}
+	if _, found := jobs.get("job1"); found {
+		t.Error("Expected endpoint of job started before the stamp to be removed")
+	}
+
+}
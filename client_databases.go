@@ -64,7 +64,8 @@ type CreateDatabaseOptions struct {
 
 // CreateDatabaseDefaultOptions contains options that change defaults for collections
 type CreateDatabaseDefaultOptions struct {
-	// Default replication factor for collections in database
+	// Default replication factor for collections in database.
+	// Use ReplicationFactorSatellite to make satellite collections the default.
 	ReplicationFactor int `json:"replicationFactor,omitempty"`
 	// Default write concern for collections in database
 	WriteConcern int `json:"writeConcern,omitempty"`
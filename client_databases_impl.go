@@ -24,6 +24,7 @@ package driver
 
 import (
 	"context"
+	"encoding/json"
 	"path"
 )
 
@@ -151,3 +152,18 @@ func (c *client) CreateDatabase(ctx context.Context, name string, options *Creat
 	}
 	return db, nil
 }
+
+type createDatabaseDefaultOptionsInternal struct {
+	ReplicationFactor replicationFactor `json:"replicationFactor,omitempty"`
+	WriteConcern      int               `json:"writeConcern,omitempty"`
+	Sharding          DatabaseSharding  `json:"sharding,omitempty"`
+}
+
+// MarshalJSON converts CreateDatabaseDefaultOptions into json
+func (p CreateDatabaseDefaultOptions) MarshalJSON() ([]byte, error) {
+	return json.Marshal(createDatabaseDefaultOptionsInternal{
+		ReplicationFactor: replicationFactor(p.ReplicationFactor),
+		WriteConcern:      p.WriteConcern,
+		Sharding:          p.Sharding,
+	})
+}
@@ -0,0 +1,56 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package driver
+
+import (
+	"context"
+	"time"
+)
+
+// ClientHealth provides functions to check the health of the endpoints of a client.
+type ClientHealth interface {
+	// Ping checks that the server behind the given endpoint responds to requests.
+	// If endpoint is empty, the request is sent to any endpoint of the connection.
+	Ping(ctx context.Context, endpoint string) error
+
+	// Health pings all endpoints of the connection in parallel and returns their health.
+	Health(ctx context.Context) []EndpointHealth
+
+	// WaitUntilReady blocks until at least the given number of endpoints respond to requests,
+	// or the given context is done.
+	// If minEndpoints <= 0 or exceeds the number of endpoints, all endpoints must respond.
+	WaitUntilReady(ctx context.Context, minEndpoints int) error
+}
+
+// EndpointHealth contains the health of a single endpoint.
+type EndpointHealth struct {
+	// Endpoint is the endpoint that was checked.
+	Endpoint string
+	// Error is the error returned when pinging the endpoint, nil if the endpoint is healthy.
+	Error error
+	// Latency is the time it took to ping the endpoint.
+	Latency time.Duration
+}
+
+// Healthy returns true if the endpoint responded successfully.
+func (h EndpointHealth) Healthy() bool {
+	return h.Error == nil
+}
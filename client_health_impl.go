@@ -0,0 +1,106 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package driver
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const (
+	// readyPollInterval is the time between health checks in WaitUntilReady.
+	readyPollInterval = time.Second
+)
+
+// Ping checks that the server behind the given endpoint responds to requests.
+func (c *client) Ping(ctx context.Context, endpoint string) error {
+	if endpoint != "" {
+		ctx = WithEndpoint(ctx, endpoint)
+	}
+	req, err := c.conn.NewRequest("GET", "_api/version")
+	if err != nil {
+		return WithStack(err)
+	}
+	resp, err := c.conn.Do(ctx, req)
+	if err != nil {
+		return WithStack(err)
+	}
+	if err := resp.CheckStatus(200); err != nil {
+		return WithStack(err)
+	}
+	return nil
+}
+
+// Health pings all endpoints of the connection in parallel and returns their health.
+func (c *client) Health(ctx context.Context) []EndpointHealth {
+	endpoints := c.conn.Endpoints()
+	result := make([]EndpointHealth, len(endpoints))
+	var wg sync.WaitGroup
+	for i, ep := range endpoints {
+		wg.Add(1)
+		go func(i int, ep string) {
+			defer wg.Done()
+			start := time.Now()
+			err := c.Ping(ctx, ep)
+			result[i] = EndpointHealth{
+				Endpoint: ep,
+				Error:    err,
+				Latency:  time.Since(start),
+			}
+		}(i, ep)
+	}
+	wg.Wait()
+	return result
+}
+
+// WaitUntilReady blocks until at least the given number of endpoints respond to requests,
+// or the given context is done.
+func (c *client) WaitUntilReady(ctx context.Context, minEndpoints int) error {
+	ctx = contextOrBackground(ctx)
+	for {
+		health := c.Health(ctx)
+		required := minEndpoints
+		if required <= 0 || required > len(health) {
+			required = len(health)
+		}
+		ready := 0
+		var lastErr error
+		for _, h := range health {
+			if h.Healthy() {
+				ready++
+			} else {
+				lastErr = h.Error
+			}
+		}
+		if ready >= required {
+			return nil
+		}
+		select {
+		case <-time.After(readyPollInterval):
+		case <-ctx.Done():
+			if lastErr != nil {
+				return WithStack(lastErr)
+			}
+			return WithStack(ctx.Err())
+		}
+	}
+}
@@ -25,6 +25,7 @@ package driver
 import (
 	"context"
 	"path"
+	"sync"
 	"time"
 
 	"github.com/arangodb/go-driver/util"
@@ -43,8 +44,21 @@ func NewClient(config ClientConfig) (Client, error) {
 			return nil, WithStack(err)
 		}
 	}
+	asyncJobs := newEndpointCache(defaultEndpointCacheSize)
+	conn = newAsyncConnection(conn, asyncJobs)
+	conn = newTransactionConnection(conn, newEndpointCache(defaultEndpointCacheSize))
+	if config.MaxConcurrentRequests > 0 || config.RequestsPerSecond > 0 {
+		conn = newLimitConnection(conn, config.MaxConcurrentRequests, config.RequestsPerSecond)
+	}
+	if config.RetryPolicy != nil {
+		conn = newRetryConnection(conn, *config.RetryPolicy, config.Logger, config.RetryLogLevel)
+	}
+	if config.Logger != nil {
+		conn = newLoggingConnection(conn, config.Logger, config.RequestLogLevel, config.ErrorLogLevel)
+	}
 	c := &client{
-		conn: conn,
+		conn:      conn,
+		asyncJobs: asyncJobs,
 	}
 	if config.SynchronizeEndpointsInterval > 0 {
 		go c.autoSynchronizeEndpoints(config.SynchronizeEndpointsInterval)
@@ -55,6 +69,11 @@ func NewClient(config ClientConfig) (Client, error) {
 // client implements the Client interface.
 type client struct {
 	conn Connection
+	// asyncJobs holds the endpoints of the coordinators that started async jobs.
+	asyncJobs *endpointCache
+
+	versionMutex sync.Mutex
+	version      Version
 }
 
 // Connection returns the connection used by this client
@@ -62,6 +81,41 @@ func (c *client) Connection() Connection {
 	return c.conn
 }
 
+// Do performs a request with the given method and path, for APIs that are not (yet) supported by this driver.
+func (c *client) Do(ctx context.Context, method, path string, body interface{}, result interface{}) (Response, error) {
+	req, err := c.conn.NewRequest(method, path)
+	if err != nil {
+		return nil, WithStack(err)
+	}
+	applyContextSettings(ctx, req)
+	if body != nil {
+		if _, err := req.SetBody(body); err != nil {
+			return nil, WithStack(err)
+		}
+	}
+	var raw *[]byte
+	if ctx != nil {
+		raw, _ = ctx.Value(keyRawResponse).(*[]byte)
+	}
+	if raw == nil {
+		raw = &[]byte{}
+		ctx = WithRawResponse(ctx, raw)
+	}
+	resp, err := c.conn.Do(ctx, req)
+	if err != nil {
+		return nil, WithStack(err)
+	}
+	if resp.StatusCode() >= 300 {
+		return resp, WithStack(resp.CheckStatus())
+	}
+	if result != nil && len(*raw) > 0 {
+		if err := c.conn.Unmarshal(*raw, result); err != nil {
+			return resp, WithStack(err)
+		}
+	}
+	return resp, nil
+}
+
 // SynchronizeEndpoints fetches all endpoints from an ArangoDB cluster and updates the
 // connection to use those endpoints.
 // When this client is connected to a single server, nothing happens.
@@ -0,0 +1,95 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package driver
+
+import "context"
+
+// ClientLog provides access to the log levels and log entries of an arangodb server.
+// In a cluster, use WithServerID to target a specific server.
+type ClientLog interface {
+	// GetLogLevels returns the log level of every log topic of the server.
+	GetLogLevels(ctx context.Context) (LogLevels, error)
+
+	// SetLogLevels changes the log levels of the given log topics of the server.
+	// Topics that are not included are not changed.
+	SetLogLevels(ctx context.Context, levels LogLevels) error
+
+	// LogEntries returns log entries of the server that match the given filter.
+	// This call needs ArangoDB 3.8 and up.
+	LogEntries(ctx context.Context, filter *LogEntriesFilter) (LogEntries, error)
+}
+
+// LogLevel is the level of a log message or log topic.
+type LogLevel string
+
+const (
+	LogLevelFatal   LogLevel = "FATAL"
+	LogLevelError   LogLevel = "ERROR"
+	LogLevelWarning LogLevel = "WARNING"
+	LogLevelInfo    LogLevel = "INFO"
+	LogLevelDebug   LogLevel = "DEBUG"
+	LogLevelTrace   LogLevel = "TRACE"
+	// LogLevelDefault resets a log topic to its default level.
+	LogLevelDefault LogLevel = "DEFAULT"
+)
+
+// LogLevels maps log topics to their log level.
+type LogLevels map[string]LogLevel
+
+// LogEntriesFilter contains the filter options for ClientLog.LogEntries.
+type LogEntriesFilter struct {
+	// UpTo returns log entries up to (and including) the given level.
+	UpTo LogLevel
+	// Level returns only log entries of the given level. Cannot be combined with UpTo.
+	Level LogLevel
+	// Start returns only log entries with an ID greater than or equal to the given value.
+	Start int
+	// Size limits the number of returned log entries.
+	Size int
+	// Offset skips the given number of log entries.
+	Offset int
+	// Search returns only log entries containing the given text.
+	Search string
+	// SortDescending returns the newest log entries first.
+	SortDescending bool
+}
+
+// LogEntries contains log entries of a server.
+type LogEntries struct {
+	// Total is the number of log entries matching the filter before Size and Offset are applied.
+	Total int `json:"total"`
+	// Messages contains the log entries.
+	Messages []LogEntryMessage `json:"messages,omitempty"`
+}
+
+// LogEntryMessage is a single log entry.
+type LogEntryMessage struct {
+	// ID of the log entry.
+	ID int `json:"id"`
+	// Topic of the log entry.
+	Topic string `json:"topic,omitempty"`
+	// Level of the log entry.
+	Level LogLevel `json:"level,omitempty"`
+	// Date is the time of the log entry in ISO 8601 format.
+	Date string `json:"date,omitempty"`
+	// Message is the text of the log entry.
+	Message string `json:"message,omitempty"`
+}
@@ -0,0 +1,112 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package driver
+
+import (
+	"context"
+	"strconv"
+	"strings"
+)
+
+// GetLogLevels returns the log level of every log topic of the server.
+func (c *client) GetLogLevels(ctx context.Context) (LogLevels, error) {
+	req, err := c.conn.NewRequest("GET", "_admin/log/level")
+	if err != nil {
+		return nil, WithStack(err)
+	}
+	applyContextSettings(ctx, req)
+	resp, err := c.conn.Do(ctx, req)
+	if err != nil {
+		return nil, WithStack(err)
+	}
+	if err := resp.CheckStatus(200); err != nil {
+		return nil, WithStack(err)
+	}
+	var data LogLevels
+	if err := resp.ParseBody("", &data); err != nil {
+		return nil, WithStack(err)
+	}
+	return data, nil
+}
+
+// SetLogLevels changes the log levels of the given log topics of the server.
+func (c *client) SetLogLevels(ctx context.Context, levels LogLevels) error {
+	req, err := c.conn.NewRequest("PUT", "_admin/log/level")
+	if err != nil {
+		return WithStack(err)
+	}
+	applyContextSettings(ctx, req)
+	if _, err := req.SetBody(levels); err != nil {
+		return WithStack(err)
+	}
+	resp, err := c.conn.Do(ctx, req)
+	if err != nil {
+		return WithStack(err)
+	}
+	if err := resp.CheckStatus(200); err != nil {
+		return WithStack(err)
+	}
+	return nil
+}
+
+// LogEntries returns log entries of the server that match the given filter.
+func (c *client) LogEntries(ctx context.Context, filter *LogEntriesFilter) (LogEntries, error) {
+	req, err := c.conn.NewRequest("GET", "_admin/log/entries")
+	if err != nil {
+		return LogEntries{}, WithStack(err)
+	}
+	applyContextSettings(ctx, req)
+	if filter != nil {
+		if filter.UpTo != "" {
+			req.SetQuery("upto", strings.ToLower(string(filter.UpTo)))
+		}
+		if filter.Level != "" {
+			req.SetQuery("level", strings.ToLower(string(filter.Level)))
+		}
+		if filter.Start > 0 {
+			req.SetQuery("start", strconv.Itoa(filter.Start))
+		}
+		if filter.Size > 0 {
+			req.SetQuery("size", strconv.Itoa(filter.Size))
+		}
+		if filter.Offset > 0 {
+			req.SetQuery("offset", strconv.Itoa(filter.Offset))
+		}
+		if filter.Search != "" {
+			req.SetQuery("search", filter.Search)
+		}
+		if filter.SortDescending {
+			req.SetQuery("sort", "desc")
+		}
+	}
+	resp, err := c.conn.Do(ctx, req)
+	if err != nil {
+		return LogEntries{}, WithStack(err)
+	}
+	if err := resp.CheckStatus(200); err != nil {
+		return LogEntries{}, WithStack(c.featureVersionError(ctx, err, "log entries", "3.8"))
+	}
+	var data LogEntries
+	if err := resp.ParseBody("", &data); err != nil {
+		return LogEntries{}, WithStack(err)
+	}
+	return data, nil
+}
@@ -22,7 +22,10 @@
 
 package driver
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 // ClientServerAdmin provides access to server administrations functions of an arangodb database server
 // or an entire cluster of arangodb servers.
@@ -40,6 +43,112 @@ type ClientServerAdmin interface {
 
 	// Statistics queries statistics from a specific server
 	Statistics(ctx context.Context) (ServerStatistics, error)
+
+	// License returns the license of the server or cluster.
+	// This call needs ArangoDB 3.9 and up.
+	License(ctx context.Context) (License, error)
+	// SetLicense installs the given license key.
+	// If force is set, a license that would reduce the features of the server/cluster is installed anyway.
+	// This call needs ArangoDB Enterprise Edition 3.9 and up.
+	SetLicense(ctx context.Context, license string, force bool) error
+
+	// JWTSecrets returns the hashes of the JWT secrets used by the server.
+	// This call needs ArangoDB Enterprise Edition 3.7 and up.
+	JWTSecrets(ctx context.Context) (JWTSecrets, error)
+	// RotateJWTSecrets makes the server reload its JWT secrets from the secret folder (`--server.jwt-secret-folder`)
+	// and returns the hashes of the secrets in use afterwards.
+	// This call needs ArangoDB Enterprise Edition 3.7 and up.
+	RotateJWTSecrets(ctx context.Context) (JWTSecrets, error)
+
+	// TLS returns the TLS keyfile, client CA and SNI configuration of the server.
+	// This call needs ArangoDB Enterprise Edition 3.7 and up.
+	TLS(ctx context.Context) (TLSData, error)
+	// ReloadTLS makes the server reload its TLS keyfile, client CA and SNI configuration from disk
+	// and returns the configuration in use afterwards.
+	// This call needs ArangoDB Enterprise Edition 3.7 and up.
+	ReloadTLS(ctx context.Context) (TLSData, error)
+
+	// EncryptionKeys returns the hashes of the user-supplied encryption-at-rest keys of the server.
+	// This call needs ArangoDB Enterprise Edition 3.7 and up.
+	EncryptionKeys(ctx context.Context) ([]SHA256Hash, error)
+	// RotateEncryptionKeys makes the server reload the user-supplied encryption keys from the keys folder
+	// (`--rocksdb.encryption-keyfolder`), re-encrypt its internal encryption key with the first key,
+	// and returns the hashes of the keys in use afterwards.
+	// This call needs ArangoDB Enterprise Edition 3.7 and up.
+	RotateEncryptionKeys(ctx context.Context) ([]SHA256Hash, error)
+}
+
+// SHA256Hash holds the SHA-256 hash of a secret or key.
+type SHA256Hash struct {
+	SHA256 string `json:"sha256"`
+}
+
+// JWTSecrets contains the hashes of the JWT secrets used by a server.
+type JWTSecrets struct {
+	// Active is the secret used to sign new tokens.
+	Active *SHA256Hash `json:"active,omitempty"`
+	// Passive are the other secrets that are accepted to validate tokens.
+	Passive []SHA256Hash `json:"passive,omitempty"`
+}
+
+// TLSData contains the TLS configuration of a server.
+type TLSData struct {
+	// Keyfile is the server keyfile.
+	Keyfile TLSKeyFile `json:"keyfile"`
+	// ClientCA is the CA used to verify client certificates.
+	ClientCA *TLSKeyFile `json:"clientCA,omitempty"`
+	// SNI holds the keyfiles used for server name indication, by server name.
+	SNI map[string]TLSKeyFile `json:"SNI,omitempty"`
+}
+
+// TLSKeyFile describes a TLS keyfile of a server.
+type TLSKeyFile struct {
+	// SHA256 is the hash of the whole keyfile.
+	SHA256 string `json:"sha256,omitempty"`
+	// Certificates are the certificates in the keyfile (PEM encoded).
+	Certificates []string `json:"certificates,omitempty"`
+	// PrivateKeySHA256 is the hash of the private key.
+	PrivateKeySHA256 string `json:"privateKeySHA256,omitempty"`
+}
+
+// LicenseStatus is the status of a license.
+type LicenseStatus string
+
+const (
+	// LicenseStatusGood indicates that the license is valid for more than 2 weeks.
+	LicenseStatusGood LicenseStatus = "good"
+	// LicenseStatusExpiring indicates that the license is valid for less than 2 weeks.
+	LicenseStatusExpiring LicenseStatus = "expiring"
+	// LicenseStatusExpired indicates that the license has expired.
+	LicenseStatusExpired LicenseStatus = "expired"
+	// LicenseStatusReadOnly indicates that the license has expired more than 2 weeks ago,
+	// and the server/cluster only accepts read requests.
+	LicenseStatusReadOnly LicenseStatus = "read-only"
+)
+
+// License contains information about the license of a server or cluster.
+type License struct {
+	// Features contains the licensed features.
+	Features LicenseFeatures `json:"features"`
+	// License is the encrypted license key.
+	License string `json:"license,omitempty"`
+	// Version of the license format.
+	Version int `json:"version,omitempty"`
+	// Status of the license.
+	Status LicenseStatus `json:"status,omitempty"`
+	// Hash of the license key.
+	Hash string `json:"hash,omitempty"`
+}
+
+// LicenseFeatures contains the features of a license.
+type LicenseFeatures struct {
+	// Expires is the expiry time of the license as Unix timestamp (seconds).
+	Expires int64 `json:"expires,omitempty"`
+}
+
+// ExpiresAt returns the expiry time of the license.
+func (f LicenseFeatures) ExpiresAt() time.Time {
+	return time.Unix(f.Expires, 0)
 }
 
 type ServerMode string
@@ -80,6 +80,49 @@ func (c *client) SetServerMode(ctx context.Context, mode ServerMode) error {
 	return nil
 }
 
+// License returns the license of the server or cluster.
+func (c *client) License(ctx context.Context) (License, error) {
+	req, err := c.conn.NewRequest("GET", "_admin/license")
+	if err != nil {
+		return License{}, WithStack(err)
+	}
+	resp, err := c.conn.Do(ctx, req)
+	if err != nil {
+		return License{}, WithStack(err)
+	}
+	if err := resp.CheckStatus(200); err != nil {
+		return License{}, WithStack(c.featureVersionError(ctx, err, "license", "3.9"))
+	}
+	var data License
+	if err := resp.ParseBody("", &data); err != nil {
+		return License{}, WithStack(err)
+	}
+	return data, nil
+}
+
+// SetLicense installs the given license key.
+func (c *client) SetLicense(ctx context.Context, license string, force bool) error {
+	req, err := c.conn.NewRequest("PUT", "_admin/license")
+	if err != nil {
+		return WithStack(err)
+	}
+	if force {
+		req.SetQuery("force", "true")
+	}
+	req, err = req.SetBody(license)
+	if err != nil {
+		return WithStack(err)
+	}
+	resp, err := c.conn.Do(ctx, req)
+	if err != nil {
+		return WithStack(err)
+	}
+	if err := resp.CheckStatus(201); err != nil {
+		return WithStack(c.featureVersionError(ctx, err, "license", "3.9"))
+	}
+	return nil
+}
+
 // Shutdown a specific server, optionally removing it from its cluster.
 func (c *client) Shutdown(ctx context.Context, removeFromCluster bool) error {
 	req, err := c.conn.NewRequest("DELETE", "_admin/shutdown")
@@ -118,3 +161,84 @@ func (c *client) Statistics(ctx context.Context) (ServerStatistics, error) {
 	}
 	return data, nil
 }
+
+// JWTSecrets returns the hashes of the JWT secrets used by the server.
+func (c *client) JWTSecrets(ctx context.Context) (JWTSecrets, error) {
+	var data JWTSecrets
+	if err := c.serverAdminRequest(ctx, "GET", "_admin/server/jwt", "JWT secrets", "3.7", &data); err != nil {
+		return JWTSecrets{}, WithStack(err)
+	}
+	return data, nil
+}
+
+// RotateJWTSecrets makes the server reload its JWT secrets from the secret folder.
+func (c *client) RotateJWTSecrets(ctx context.Context) (JWTSecrets, error) {
+	var data JWTSecrets
+	if err := c.serverAdminRequest(ctx, "POST", "_admin/server/jwt", "JWT secrets", "3.7", &data); err != nil {
+		return JWTSecrets{}, WithStack(err)
+	}
+	return data, nil
+}
+
+// TLS returns the TLS keyfile, client CA and SNI configuration of the server.
+func (c *client) TLS(ctx context.Context) (TLSData, error) {
+	var data TLSData
+	if err := c.serverAdminRequest(ctx, "GET", "_admin/server/tls", "TLS configuration", "3.7", &data); err != nil {
+		return TLSData{}, WithStack(err)
+	}
+	return data, nil
+}
+
+// ReloadTLS makes the server reload its TLS keyfile, client CA and SNI configuration from disk.
+func (c *client) ReloadTLS(ctx context.Context) (TLSData, error) {
+	var data TLSData
+	if err := c.serverAdminRequest(ctx, "POST", "_admin/server/tls", "TLS configuration", "3.7", &data); err != nil {
+		return TLSData{}, WithStack(err)
+	}
+	return data, nil
+}
+
+// encryptionKeysResult is the result of the encryption API.
+type encryptionKeysResult struct {
+	Keys []SHA256Hash `json:"encryption-keys,omitempty"`
+}
+
+// EncryptionKeys returns the hashes of the user-supplied encryption-at-rest keys of the server.
+func (c *client) EncryptionKeys(ctx context.Context) ([]SHA256Hash, error) {
+	var data encryptionKeysResult
+	if err := c.serverAdminRequest(ctx, "GET", "_admin/server/encryption", "encryption keys", "3.7", &data); err != nil {
+		return nil, WithStack(err)
+	}
+	return data.Keys, nil
+}
+
+// RotateEncryptionKeys makes the server reload the user-supplied encryption keys from the keys folder.
+func (c *client) RotateEncryptionKeys(ctx context.Context) ([]SHA256Hash, error) {
+	var data encryptionKeysResult
+	if err := c.serverAdminRequest(ctx, "POST", "_admin/server/encryption", "encryption keys", "3.7", &data); err != nil {
+		return nil, WithStack(err)
+	}
+	return data.Keys, nil
+}
+
+// serverAdminRequest performs a request without body to the given server admin API
+// and parses the result field of the response into result.
+// A 404 response of a server older than minVersion is returned as UnsupportedServerVersionError.
+func (c *client) serverAdminRequest(ctx context.Context, method, path, feature string, minVersion Version, result interface{}) error {
+	req, err := c.conn.NewRequest(method, path)
+	if err != nil {
+		return WithStack(err)
+	}
+	applyContextSettings(ctx, req)
+	resp, err := c.conn.Do(ctx, req)
+	if err != nil {
+		return WithStack(err)
+	}
+	if err := resp.CheckStatus(200); err != nil {
+		return WithStack(c.featureVersionError(ctx, err, feature, minVersion))
+	}
+	if err := resp.ParseBody("result", result); err != nil {
+		return WithStack(err)
+	}
+	return nil
+}
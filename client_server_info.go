@@ -32,12 +32,105 @@ type ClientServerInfo interface {
 	// Use WithDetails to configure a context that will include additional details in the return VersionInfo.
 	Version(ctx context.Context) (VersionInfo, error)
 
+	// ServerSemVer returns the version of the connected database server.
+	// The version is fetched once and cached for the lifetime of the client.
+	ServerSemVer(ctx context.Context) (Version, error)
+
+	// RequireVersion returns an UnsupportedServerVersionError when the version of the connected
+	// database server does not satisfy the given constraint, e.g. ">=3.11" or ">=3.10, <3.12".
+	// See Version.Satisfies for the constraint syntax.
+	RequireVersion(ctx context.Context, constraint string) error
+
 	// ServerRole returns the role of the server that answers the request.
 	ServerRole(ctx context.Context) (ServerRole, error)
 
 	// Gets the ID of this server in the cluster.
 	// An error is returned when calling this to a server that is not part of a cluster.
 	ServerID(ctx context.Context) (string, error)
+
+	// Availability returns whether the server is available to serve requests, and its mode.
+	// A server that is not available (e.g. because it is a follower or is shutting down)
+	// is reported without an error.
+	Availability(ctx context.Context) (ServerAvailability, error)
+
+	// EngineInfo returns information about the storage engine of the server.
+	EngineInfo(ctx context.Context) (EngineInfo, error)
+
+	// SupportInfo returns information about the deployment and the hosts it runs on,
+	// as used in support requests. In a cluster, the information of all servers is returned.
+	// This call needs ArangoDB 3.9 and up.
+	SupportInfo(ctx context.Context) (SupportInfo, error)
+}
+
+// SupportInfo contains information about a deployment, as returned by the support-info API.
+type SupportInfo struct {
+	// Date is the time the information was collected (ISO 8601).
+	Date string `json:"date,omitempty"`
+	// Deployment describes the deployment.
+	Deployment SupportInfoDeployment `json:"deployment"`
+	// Host describes the server that answered the request.
+	Host *SupportInfoHost `json:"host,omitempty"`
+}
+
+// SupportInfoDeployment describes the layout of a deployment.
+type SupportInfoDeployment struct {
+	// Type of the deployment, e.g. "single" or "cluster".
+	Type string `json:"type,omitempty"`
+	// Servers holds the host information of all servers of a cluster, by server ID.
+	Servers map[string]SupportInfoHost `json:"servers,omitempty"`
+	// Agents is the number of agents of a cluster.
+	Agents int `json:"agents,omitempty"`
+	// Coordinators is the number of coordinators of a cluster.
+	Coordinators int `json:"coordinators,omitempty"`
+	// DBServers is the number of DB-Servers of a cluster.
+	DBServers int `json:"dbServers,omitempty"`
+	// Shards holds shard statistics of a cluster.
+	Shards map[string]interface{} `json:"shards,omitempty"`
+}
+
+// SupportInfoHost describes a single server and the host it runs on.
+type SupportInfoHost struct {
+	// Role of the server, e.g. "SINGLE", "COORDINATOR" or "PRIMARY".
+	Role string `json:"role,omitempty"`
+	// Maintenance is true if the server is in maintenance mode.
+	Maintenance bool `json:"maintenance,omitempty"`
+	// ReadOnly is true if the server is in read-only mode.
+	ReadOnly bool `json:"readOnly,omitempty"`
+	// Version of the server.
+	Version string `json:"version,omitempty"`
+	// Build of the server.
+	Build string `json:"build,omitempty"`
+	// License of the server, "community" or "enterprise".
+	License string `json:"license,omitempty"`
+	// OS of the host.
+	OS string `json:"os,omitempty"`
+	// Platform of the host.
+	Platform string `json:"platform,omitempty"`
+	// PhysicalMemory is the memory (in bytes) available to the server.
+	PhysicalMemory SupportInfoValue `json:"physicalMemory"`
+	// NumberOfCores is the number of cores available to the server.
+	NumberOfCores SupportInfoValue `json:"numberOfCores"`
+	// ProcessStats holds statistics of the server process.
+	ProcessStats map[string]interface{} `json:"processStats,omitempty"`
+	// EngineStats holds statistics of the storage engine.
+	EngineStats map[string]interface{} `json:"engineStats,omitempty"`
+}
+
+// SupportInfoValue is a detected value that may have been overridden by configuration.
+type SupportInfoValue struct {
+	// Value that is used by the server.
+	Value int64 `json:"value"`
+	// Overridden is true if the value was set by configuration instead of being detected.
+	Overridden bool `json:"overridden"`
+}
+
+// ServerAvailability contains the availability of a server.
+type ServerAvailability struct {
+	// Available is true if the server accepts requests.
+	Available bool `json:"available"`
+	// Mode is the mode in which the server is operating.
+	// Only set when the server is available.
+	Mode ServerMode `json:"mode,omitempty"`
 }
 
 // ServerRole is the role of an arangod server
@@ -24,6 +24,7 @@ package driver
 
 import (
 	"context"
+	"net/http"
 )
 
 // Version returns version information from the connected database server.
@@ -47,6 +48,60 @@ func (c *client) Version(ctx context.Context) (VersionInfo, error) {
 	return data, nil
 }
 
+// ServerSemVer returns the version of the connected database server.
+// The version is cached after the first successful request.
+func (c *client) ServerSemVer(ctx context.Context) (Version, error) {
+	c.versionMutex.Lock()
+	defer c.versionMutex.Unlock()
+	if c.version != "" {
+		return c.version, nil
+	}
+	info, err := c.Version(ctx)
+	if err != nil {
+		return "", WithStack(err)
+	}
+	c.version = info.Version
+	return c.version, nil
+}
+
+// RequireVersion returns an UnsupportedServerVersionError when the version of the connected
+// database server does not satisfy the given constraint.
+func (c *client) RequireVersion(ctx context.Context, constraint string) error {
+	version, err := c.ServerSemVer(ctx)
+	if err != nil {
+		return WithStack(err)
+	}
+	ok, err := version.Satisfies(constraint)
+	if err != nil {
+		return WithStack(err)
+	}
+	if !ok {
+		return WithStack(UnsupportedServerVersionError{Required: constraint, Actual: version})
+	}
+	return nil
+}
+
+// featureVersionError converts a 404 error of a request for the given feature into an
+// UnsupportedServerVersionError, when the server is older than the given version.
+// Otherwise err is returned as is.
+func (c *client) featureVersionError(ctx context.Context, err error, feature string, minVersion Version) error {
+	if !IsArangoErrorWithCode(err, http.StatusNotFound) {
+		return err
+	}
+	version, verr := c.ServerSemVer(ctx)
+	if verr != nil || version.CompareTo(minVersion) >= 0 {
+		return err
+	}
+	return UnsupportedServerVersionError{Feature: feature, Required: ">=" + string(minVersion), Actual: version}
+}
+
+// connFeatureVersionError is like client.featureVersionError, for APIs that are not performed by the client
+// itself (e.g. cluster or collection APIs). The server version is requested using the given connection.
+func connFeatureVersionError(ctx context.Context, conn Connection, err error, feature string, minVersion Version) error {
+	c := &client{conn: conn}
+	return c.featureVersionError(ctx, err, feature, minVersion)
+}
+
 // roleResponse contains the response body of the `/admin/server/role` api.
 type roleResponse struct {
 	// Role of the server within a cluster
@@ -133,6 +188,74 @@ func (c *client) ServerID(ctx context.Context) (string, error) {
 	return data.ID, nil
 }
 
+// Availability returns whether the server is available to serve requests, and its mode.
+func (c *client) Availability(ctx context.Context) (ServerAvailability, error) {
+	req, err := c.conn.NewRequest("GET", "_admin/server/availability")
+	if err != nil {
+		return ServerAvailability{}, WithStack(err)
+	}
+	applyContextSettings(ctx, req)
+	resp, err := c.conn.Do(ctx, req)
+	if err != nil {
+		return ServerAvailability{}, WithStack(err)
+	}
+	if resp.StatusCode() == 503 {
+		return ServerAvailability{Available: false}, nil
+	}
+	if err := resp.CheckStatus(200); err != nil {
+		return ServerAvailability{}, WithStack(err)
+	}
+	var data struct {
+		Mode ServerMode `json:"mode,omitempty"`
+	}
+	if err := resp.ParseBody("", &data); err != nil {
+		return ServerAvailability{}, WithStack(err)
+	}
+	return ServerAvailability{Available: true, Mode: data.Mode}, nil
+}
+
+// EngineInfo returns information about the storage engine of the server.
+func (c *client) EngineInfo(ctx context.Context) (EngineInfo, error) {
+	req, err := c.conn.NewRequest("GET", "_api/engine")
+	if err != nil {
+		return EngineInfo{}, WithStack(err)
+	}
+	applyContextSettings(ctx, req)
+	resp, err := c.conn.Do(ctx, req)
+	if err != nil {
+		return EngineInfo{}, WithStack(err)
+	}
+	if err := resp.CheckStatus(200); err != nil {
+		return EngineInfo{}, WithStack(err)
+	}
+	var data EngineInfo
+	if err := resp.ParseBody("", &data); err != nil {
+		return EngineInfo{}, WithStack(err)
+	}
+	return data, nil
+}
+
+// SupportInfo returns information about the deployment and the hosts it runs on.
+func (c *client) SupportInfo(ctx context.Context) (SupportInfo, error) {
+	req, err := c.conn.NewRequest("GET", "_admin/support-info")
+	if err != nil {
+		return SupportInfo{}, WithStack(err)
+	}
+	applyContextSettings(ctx, req)
+	resp, err := c.conn.Do(ctx, req)
+	if err != nil {
+		return SupportInfo{}, WithStack(err)
+	}
+	if err := resp.CheckStatus(200); err != nil {
+		return SupportInfo{}, WithStack(c.featureVersionError(ctx, err, "support info", "3.9"))
+	}
+	var data SupportInfo
+	if err := resp.ParseBody("", &data); err != nil {
+		return SupportInfo{}, WithStack(err)
+	}
+	return data, nil
+}
+
 // clusterEndpoints returns the endpoints of a cluster.
 func (c *client) echo(ctx context.Context) error {
 	req, err := c.conn.NewRequest("GET", "_admin/echo")
@@ -0,0 +1,134 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package driver
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+)
+
+type testVersionResponse struct {
+	Response
+	status int
+	body   string
+}
+
+func (r *testVersionResponse) CheckStatus(validStatusCodes ...int) error {
+	for _, code := range validStatusCodes {
+		if code == r.status {
+			return nil
+		}
+	}
+	return ArangoError{HasError: true, Code: r.status, ErrorNum: r.status}
+}
+
+func (r *testVersionResponse) ParseBody(field string, result interface{}) error {
+	return json.Unmarshal([]byte(r.body), result)
+}
+
+type testVersionRequest struct {
+	Request
+	path string
+}
+
+func (r *testVersionRequest) SetHeader(key, value string) Request { return r }
+
+type testVersionConnection struct {
+	Connection
+	version string
+	paths   []string
+}
+
+func (c *testVersionConnection) NewRequest(method, path string) (Request, error) {
+	return &testVersionRequest{path: path}, nil
+}
+
+func (c *testVersionConnection) Do(ctx context.Context, req Request) (Response, error) {
+	path := req.(*testVersionRequest).path
+	c.paths = append(c.paths, path)
+	if path == "_api/version" {
+		return &testVersionResponse{status: 200, body: `{"server":"arango","version":"` + c.version + `"}`}, nil
+	}
+	return &testVersionResponse{status: 404}, nil
+}
+
+func TestClientRequireVersion(t *testing.T) {
+	conn := &testVersionConnection{version: "3.10.5"}
+	c := &client{conn: conn}
+	ctx := context.Background()
+
+	if err := c.RequireVersion(ctx, ">=3.10"); err != nil {
+		t.Errorf("Expected version to satisfy >=3.10, got %v", err)
+	}
+	err := c.RequireVersion(ctx, ">=3.11")
+	if !IsUnsupportedServerVersion(err) {
+		t.Fatalf("Expected UnsupportedServerVersionError, got %v", err)
+	}
+	if msg := err.Error(); msg != "server version 3.10.5 does not satisfy >=3.11" {
+		t.Errorf("Unexpected error message %q", msg)
+	}
+	if err := c.RequireVersion(ctx, "~>"); !IsInvalidArgument(err) {
+		t.Errorf("Expected invalid argument error, got %v", err)
+	}
+
+	// The version is only fetched once.
+	if len(conn.paths) != 1 {
+		t.Errorf("Expected 1 request, got %v", conn.paths)
+	}
+}
+
+func TestClientFeatureVersionError(t *testing.T) {
+	ctx := context.Background()
+
+	c := &client{conn: &testVersionConnection{version: "3.8.1"}}
+	_, err := c.SupportInfo(ctx)
+	if !IsUnsupportedServerVersion(err) {
+		t.Fatalf("Expected UnsupportedServerVersionError, got %v", err)
+	}
+	if msg := err.Error(); msg != "support info requires server version >=3.9, got 3.8.1" {
+		t.Errorf("Unexpected error message %q", msg)
+	}
+
+	// A 404 of a server that supports the feature is returned as is.
+	c = &client{conn: &testVersionConnection{version: "3.9.0"}}
+	if _, err := c.SupportInfo(ctx); IsUnsupportedServerVersion(err) || !IsNotFound(err) {
+		t.Errorf("Expected not found error, got %v", err)
+	}
+
+	c = &client{conn: &testVersionConnection{version: "3.8.1"}}
+	if _, err := c.License(ctx); !IsUnsupportedServerVersion(err) {
+		t.Errorf("Expected UnsupportedServerVersionError for license, got %v", err)
+	}
+
+	// APIs that are not performed by the client request the version using their connection.
+	cl, err := newCluster(&testVersionConnection{version: "3.9.0"})
+	if err != nil {
+		t.Fatalf("newCluster failed: %v", err)
+	}
+	_, err = cl.RebalanceStatus(ctx)
+	if !IsUnsupportedServerVersion(err) {
+		t.Fatalf("Expected UnsupportedServerVersionError for rebalancing, got %v", err)
+	}
+	if msg := err.Error(); msg != "rebalancing requires server version >=3.10, got 3.9.0" {
+		t.Errorf("Unexpected error message %q", msg)
+	}
+}
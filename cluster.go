@@ -36,6 +36,10 @@ type Cluster interface {
 	// Get the inventory of the cluster containing all collections (with entire details) of a database.
 	DatabaseInventory(ctx context.Context, db Database) (DatabaseInventory, error)
 
+	// ShardDistribution returns the planned and current distribution of the shards of all collections
+	// of a database, keyed by collection name.
+	ShardDistribution(ctx context.Context, db Database) (map[string]CollectionShardDistribution, error)
+
 	// MoveShard moves a single shard of the given collection from server `fromServer` to
 	// server `toServer`.
 	MoveShard(ctx context.Context, col Collection, shard ShardID, fromServer, toServer ServerID) error
@@ -49,6 +53,39 @@ type Cluster interface {
 	// IsCleanedOut checks if the dbserver with given ID has been cleaned out.
 	IsCleanedOut(ctx context.Context, serverID string) (bool, error)
 
+	// Job returns the status of the agency job with the given ID.
+	// Use WithJobIDResponse to obtain the ID of the job started by MoveShard, CleanOutServer or ResignServer.
+	Job(ctx context.Context, jobID string) (ClusterJob, error)
+
+	// RebalanceStatus returns the current imbalance of the cluster.
+	// This call needs ArangoDB 3.10 and up.
+	RebalanceStatus(ctx context.Context) (RebalanceStatus, error)
+
+	// ComputeRebalancePlan computes a plan of shard moves that rebalances the cluster, without executing it.
+	// This call needs ArangoDB 3.10 and up.
+	ComputeRebalancePlan(ctx context.Context, opts *RebalanceOptions) (RebalancePlan, error)
+
+	// ExecuteRebalancePlan executes the given shard moves, e.g. of a plan computed by ComputeRebalancePlan.
+	// This call needs ArangoDB 3.10 and up.
+	ExecuteRebalancePlan(ctx context.Context, moves []RebalanceMove) error
+
+	// Rebalance computes a plan of shard moves that rebalances the cluster and executes it.
+	// This call needs ArangoDB 3.10 and up.
+	Rebalance(ctx context.Context, opts *RebalanceOptions) (RebalancePlan, error)
+
+	// SetMaintenance enables or disables the maintenance mode of the supervision of the entire cluster.
+	// While enabled, the supervision does not trigger failovers, e.g. during a rolling upgrade.
+	SetMaintenance(ctx context.Context, enabled bool) error
+
+	// ServerMaintenance returns the maintenance state of the DBServer with the given ID.
+	// This call needs ArangoDB 3.8 and up.
+	ServerMaintenance(ctx context.Context, serverID ServerID) (ServerMaintenance, error)
+
+	// SetServerMaintenance changes the maintenance mode of the DBServer with the given ID.
+	// The maintenance mode ends automatically after the given timeout. A timeout of 0 uses the server default.
+	// This call needs ArangoDB 3.8 and up.
+	SetServerMaintenance(ctx context.Context, serverID ServerID, mode ServerMaintenanceMode, timeout time.Duration) error
+
 	// RemoveServer is a low-level option to remove a server from a cluster.
 	// This function is suitable for servers of type coordinator or dbserver.
 	// The use of `ClientServerAdmin.Shutdown` is highly recommended above this function.
@@ -58,6 +95,22 @@ type Cluster interface {
 // ServerID identifies an arangod server in a cluster.
 type ServerID string
 
+// CollectionShardDistribution contains the distribution of the shards of a collection over the DBServers.
+type CollectionShardDistribution struct {
+	// Plan contains the planned servers of each shard.
+	Plan map[ShardID]ShardServers `json:"Plan,omitempty"`
+	// Current contains the servers currently responsible for each shard.
+	Current map[ShardID]ShardServers `json:"Current,omitempty"`
+}
+
+// ShardServers contains the servers responsible for a shard.
+type ShardServers struct {
+	// Leader is the DBServer that is the leader of the shard.
+	Leader ServerID `json:"leader,omitempty"`
+	// Followers are the DBServers that hold a replica of the shard.
+	Followers []ServerID `json:"followers,omitempty"`
+}
+
 // ClusterHealth contains health information for all servers in a cluster.
 type ClusterHealth struct {
 	// Unique identifier of the entire cluster.
@@ -0,0 +1,167 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package cluster
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	driver "github.com/arangodb/go-driver"
+)
+
+const (
+	defaultFailureThreshold = 5
+	defaultProbeInterval    = 5 * time.Second
+	probeTimeout            = 5 * time.Second
+)
+
+// CircuitBreakerConfig configures per-endpoint circuit breaking of a cluster connection.
+// After a number of consecutive failures, a server is marked unhealthy and no longer selected
+// for new requests. It is probed in the background and reinstated once it responds again,
+// until the connection is closed.
+// When all servers are unhealthy, requests are sent to unhealthy servers anyway.
+type CircuitBreakerConfig struct {
+	// FailureThreshold is the number of consecutive failures after which a server is marked unhealthy.
+	// Defaults to 5.
+	FailureThreshold int
+	// ProbeInterval is the interval between probes of an unhealthy server. Defaults to 5s.
+	ProbeInterval time.Duration
+}
+
+// serverHealth holds the circuit breaker state of a single server.
+type serverHealth struct {
+	failures  int32
+	unhealthy int32
+}
+
+// isHealthy returns true if the server has not been marked unhealthy.
+func (h *serverHealth) isHealthy() bool {
+	return atomic.LoadInt32(&h.unhealthy) == 0
+}
+
+// getHealth returns the circuit breaker state of the given server,
+// or nil if the server is no longer used.
+func (c *clusterConnection) getHealth(s driver.Connection) *serverHealth {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	for i, x := range c.servers {
+		if x == s {
+			return c.health[i]
+		}
+	}
+	return nil
+}
+
+// recordResult updates the circuit breaker state of the given server with the outcome of a request.
+// Only network errors & timeouts of the server count as failures.
+func (c *clusterConnection) recordResult(ctx context.Context, s driver.Connection, err error) {
+	if c.circuitBreaker == nil {
+		return
+	}
+	h := c.getHealth(s)
+	if h == nil {
+		return
+	}
+	if err == nil || driver.IsArangoError(err) {
+		atomic.StoreInt32(&h.failures, 0)
+		return
+	}
+	if ctx.Err() != nil || driver.IsCanceled(err) {
+		// Caller gave up, not a failure of the server
+		return
+	}
+	if int(atomic.AddInt32(&h.failures, 1)) >= c.circuitBreaker.FailureThreshold {
+		if atomic.CompareAndSwapInt32(&h.unhealthy, 0, 1) {
+			go c.probeServer(h)
+		}
+	}
+}
+
+// probeServer periodically probes the server with the given health state until it responds,
+// after which it is reinstated.
+// Probing stops when the server is no longer used or the connection is closed.
+func (c *clusterConnection) probeServer(h *serverHealth) {
+	timer := time.NewTimer(c.circuitBreaker.ProbeInterval)
+	defer timer.Stop()
+	for {
+		select {
+		case <-timer.C:
+		case <-c.stop:
+			return
+		}
+		timer.Reset(c.circuitBreaker.ProbeInterval)
+		s, found := c.getServerForHealth(h)
+		if !found {
+			return
+		}
+		if probe(s) {
+			atomic.StoreInt32(&h.failures, 0)
+			atomic.StoreInt32(&h.unhealthy, 0)
+			return
+		}
+	}
+}
+
+// getServerForHealth returns the server with the given health state.
+func (c *clusterConnection) getServerForHealth(h *serverHealth) (driver.Connection, bool) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	for i, x := range c.health {
+		if x == h {
+			return c.servers[i], true
+		}
+	}
+	return nil, false
+}
+
+// probe returns true if the given server responds to a version request.
+func probe(s driver.Connection) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), probeTimeout)
+	defer cancel()
+	req, err := s.NewRequest("GET", "_api/version")
+	if err != nil {
+		return false
+	}
+	resp, err := s.Do(ctx, req)
+	if err != nil {
+		return false
+	}
+	return resp.CheckStatus(200) == nil
+}
+
+// nextHealthyIndex returns the first index starting at the given index of a server that is healthy.
+// If no server is healthy, the given index is returned.
+// The caller must hold the mutex.
+func (c *clusterConnection) nextHealthyIndex(index int) int {
+	if c.circuitBreaker == nil {
+		return index
+	}
+	for i := 0; i < len(c.servers); i++ {
+		x := (index + i) % len(c.servers)
+		if c.health[x].isHealthy() {
+			return x
+		}
+	}
+	return index
+}
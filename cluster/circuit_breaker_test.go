@@ -0,0 +1,121 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package cluster
+
+import (
+	"context"
+	"errors"
+	"io"
+	"runtime"
+	"testing"
+	"time"
+
+	driver "github.com/arangodb/go-driver"
+)
+
+type testCBRequest struct {
+	driver.Request
+}
+
+func (r *testCBRequest) Written() bool { return false }
+
+type testCBResponse struct {
+	driver.Response
+}
+
+func (r *testCBResponse) StatusCode() int { return 200 }
+
+type testCBConnection struct {
+	driver.Connection
+	endpoint string
+	fail     bool
+	calls    int
+}
+
+func (c *testCBConnection) Endpoints() []string { return []string{c.endpoint} }
+
+func (c *testCBConnection) Do(ctx context.Context, req driver.Request) (driver.Response, error) {
+	c.calls++
+	if c.fail {
+		return nil, errors.New("connection refused")
+	}
+	return &testCBResponse{}, nil
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	conns := map[string]*testCBConnection{
+		"a": {endpoint: "a", fail: true},
+		"b": {endpoint: "b"},
+	}
+	builder := func(endpoint string) (driver.Connection, error) { return conns[endpoint], nil }
+	config := ConnectionConfig{
+		LoadBalancingStrategy: NewRoundRobinStrategy(),
+		CircuitBreaker:        &CircuitBreakerConfig{FailureThreshold: 2, ProbeInterval: time.Hour},
+	}
+	c, err := NewConnection(config, builder, []string{"a", "b"})
+	if err != nil {
+		t.Fatalf("NewConnection failed: %v", err)
+	}
+	for i := 0; i < 10; i++ {
+		if _, err := c.Do(context.Background(), &testCBRequest{}); err != nil {
+			t.Fatalf("Do failed: %v", err)
+		}
+	}
+	if calls := conns["a"].calls; calls != 2 {
+		t.Errorf("Expected 2 calls to failing server, got %d", calls)
+	}
+	if calls := conns["b"].calls; calls != 10 {
+		t.Errorf("Expected 10 calls to healthy server, got %d", calls)
+	}
+}
+
+func TestCircuitBreakerProbeStopsOnClose(t *testing.T) {
+	goroutines := runtime.NumGoroutine()
+	conns := map[string]*testCBConnection{
+		"a": {endpoint: "a", fail: true},
+		"b": {endpoint: "b"},
+	}
+	builder := func(endpoint string) (driver.Connection, error) { return conns[endpoint], nil }
+	config := ConnectionConfig{
+		LoadBalancingStrategy: NewRoundRobinStrategy(),
+		CircuitBreaker:        &CircuitBreakerConfig{FailureThreshold: 1, ProbeInterval: time.Hour},
+	}
+	c, err := NewConnection(config, builder, []string{"a", "b"})
+	if err != nil {
+		t.Fatalf("NewConnection failed: %v", err)
+	}
+	if _, err := c.Do(context.Background(), &testCBRequest{}); err != nil {
+		t.Fatalf("Do failed: %v", err)
+	}
+	if runtime.NumGoroutine() <= goroutines {
+		t.Fatal("Expected unhealthy server to be probed in the background")
+	}
+	if err := c.(io.Closer).Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for runtime.NumGoroutine() > goroutines {
+		if time.Now().After(deadline) {
+			t.Fatalf("Expected probe to stop after Close, got %d goroutines, expected %d", runtime.NumGoroutine(), goroutines)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
@@ -26,22 +26,34 @@ import (
 	"context"
 	"math"
 	"net/http"
+	"runtime"
 	"sort"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	driver "github.com/arangodb/go-driver"
+	"github.com/arangodb/go-driver/util"
 )
 
 const (
 	keyFollowLeaderRedirect driver.ContextKey = "arangodb-followLeaderRedirect"
+	keyStickySession        driver.ContextKey = "arangodb-stickySession"
 )
 
 // ConnectionConfig provides all configuration options for a cluster connection.
 type ConnectionConfig struct {
 	// DefaultTimeout is the timeout used by requests that have no timeout set in the given context.
 	DefaultTimeout time.Duration
+	// LoadBalancingStrategy selects the server to which each request is sent.
+	// If not set, requests are sent to the same server until it fails, and requests of a context
+	// prepared with driver.WithStickySession always go to the same server (see NewStickyStrategy).
+	// Use driver.WithEndpoint to send a request to a specific server instead.
+	LoadBalancingStrategy LoadBalancingStrategy
+	// CircuitBreaker enables per-endpoint circuit breaking.
+	// If nil, servers are never marked unhealthy.
+	CircuitBreaker *CircuitBreakerConfig
 }
 
 // ServerConnectionBuilder specifies a function called by the cluster connection when it
@@ -50,6 +62,8 @@ type ServerConnectionBuilder func(endpoint string) (driver.Connection, error)
 
 // NewConnection creates a new cluster connection to a cluster of servers.
 // The given connections are existing connections to each of the servers.
+// The returned connection implements io.Closer. Closing it stops the background probing of
+// unhealthy servers (see CircuitBreakerConfig), which also stops once the connection is no longer referenced.
 func NewConnection(config ConnectionConfig, connectionBuilder ServerConnectionBuilder, endpoints []string) (driver.Connection, error) {
 	if connectionBuilder == nil {
 		return nil, driver.WithStack(driver.InvalidArgumentError{Message: "Must a connection builder"})
@@ -60,30 +74,84 @@ func NewConnection(config ConnectionConfig, connectionBuilder ServerConnectionBu
 	if config.DefaultTimeout == 0 {
 		config.DefaultTimeout = defaultTimeout
 	}
+	if config.LoadBalancingStrategy == nil {
+		config.LoadBalancingStrategy = NewStickyStrategy()
+	}
+	if cb := config.CircuitBreaker; cb != nil {
+		cbCopy := *cb
+		if cbCopy.FailureThreshold <= 0 {
+			cbCopy.FailureThreshold = defaultFailureThreshold
+		}
+		if cbCopy.ProbeInterval <= 0 {
+			cbCopy.ProbeInterval = defaultProbeInterval
+		}
+		config.CircuitBreaker = &cbCopy
+	}
 	cConn := &clusterConnection{
 		connectionBuilder: connectionBuilder,
 		defaultTimeout:    config.DefaultTimeout,
+		strategy:          config.LoadBalancingStrategy,
+		circuitBreaker:    config.CircuitBreaker,
+		stop:              make(chan struct{}),
 	}
 	// Initialize endpoints
 	if err := cConn.UpdateEndpoints(endpoints); err != nil {
 		return nil, driver.WithStack(err)
 	}
-	return cConn, nil
+	conn := &connection{cConn}
+	runtime.SetFinalizer(conn, (*connection).Close)
+	return conn, nil
+}
+
+// connection is the cluster connection handed out to callers.
+// Background goroutines only reference the embedded clusterConnection,
+// so the connection can be finalized (and closed) once the caller no longer references it.
+type connection struct {
+	*clusterConnection
+}
+
+// SetAuthentication configures the authentication used for this connection.
+func (c *connection) SetAuthentication(auth driver.Authentication) (driver.Connection, error) {
+	if _, err := c.clusterConnection.SetAuthentication(auth); err != nil {
+		return nil, driver.WithStack(err)
+	}
+	return c, nil
 }
 
 const (
 	defaultTimeout                   = 9 * time.Minute
 	keyEndpoint    driver.ContextKey = "arangodb-endpoint"
+	// leaderEndpointHeader is the header in which a follower of an active failover deployment
+	// returns the endpoint of the current leader.
+	leaderEndpointHeader = "X-Arango-Endpoint"
+	// leaderDiscoveryTimeout is the maximum time spent to discover the current leader.
+	leaderDiscoveryTimeout = 5 * time.Second
 )
 
 type clusterConnection struct {
 	connectionBuilder ServerConnectionBuilder
 	servers           []driver.Connection
+	pending           []*int32
+	health            []*serverHealth
 	endpoints         []string
 	current           int
 	mutex             sync.RWMutex
 	defaultTimeout    time.Duration
 	auth              driver.Authentication
+	strategy          LoadBalancingStrategy
+	circuitBreaker    *CircuitBreakerConfig
+	// stop is closed when the connection is closed, stopping background probes.
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+// Close stops all background activity of the connection.
+// Requests can still be sent afterwards, but unhealthy servers are no longer probed.
+func (c *clusterConnection) Close() error {
+	c.stopOnce.Do(func() {
+		close(c.stop)
+	})
+	return nil
 }
 
 // NewRequest creates a new request with given method and path.
@@ -104,6 +172,9 @@ func (c *clusterConnection) NewRequest(method, path string) (driver.Request, err
 }
 
 // Do performs a given request, returning its response.
+// When a follower of an active failover deployment responds that it is not the leader,
+// the request is retried against the current leader, unless disabled using driver.WithFollowLeaderRedirect.
+// Such requests have not been executed by the follower, so retrying them is safe for all methods.
 func (c *clusterConnection) Do(ctx context.Context, req driver.Request) (driver.Response, error) {
 	followLeaderRedirect := true
 	if ctx == nil {
@@ -142,12 +213,20 @@ func (c *clusterConnection) Do(ctx context.Context, req driver.Request) (driver.
 	attempt := 1
 	s := specificServer
 	if s == nil {
-		s = c.getCurrentServer()
+		s = c.selectServer(ctx)
 	}
 	for {
 		// Send request to specific endpoint with a 1/3 timeout (so we get 3 attempts)
 		serverCtx, cancel := context.WithTimeout(ctx, time.Duration(float64(timeout)/timeoutDivider))
+		pending := c.getPendingCounter(s)
+		if pending != nil {
+			atomic.AddInt32(pending, 1)
+		}
 		resp, err := s.Do(serverCtx, req)
+		if pending != nil {
+			atomic.AddInt32(pending, -1)
+		}
+		c.recordResult(ctx, s, err)
 		cancel()
 
 		isNoLeaderResponse := false
@@ -197,10 +276,81 @@ func (c *clusterConnection) Do(ctx context.Context, req driver.Request) (driver.
 			// We've tried all servers. Giving up.
 			return nil, driver.WithStack(err)
 		}
-		s = c.getNextServer()
+		if isNoLeaderResponse {
+			// The server is a follower, which did not execute the request.
+			// Retry against the current leader, if it can be found.
+			if leader, found := c.getLeaderServer(ctx, s, resp); found && leader != s {
+				s = leader
+				continue
+			}
+		}
+		s = c.getNextServer(s)
 	}
 }
 
+// getLeaderServer returns the server that is the current leader of an active failover deployment,
+// given a "no leader" response of a follower.
+// The leader is taken from the response header, or discovered using the given server.
+// If the leader is found, it becomes the currently used server.
+func (c *clusterConnection) getLeaderServer(ctx context.Context, follower driver.Connection, resp driver.Response) (driver.Connection, bool) {
+	if endpoint := resp.Header(leaderEndpointHeader); endpoint != "" {
+		if s, found := c.useServer(util.FixupEndpointURLScheme(endpoint)); found {
+			return s, true
+		}
+	}
+	discoveryCtx, cancel := context.WithTimeout(ctx, leaderDiscoveryTimeout)
+	defer cancel()
+	endpoint, err := discoverLeaderEndpoint(discoveryCtx, follower)
+	if err != nil || endpoint == "" {
+		return nil, false
+	}
+	return c.useServer(util.FixupEndpointURLScheme(endpoint))
+}
+
+// discoverLeaderEndpoint fetches the endpoints of the deployment from the given server.
+// In an active failover deployment, the first endpoint is the leader.
+func discoverLeaderEndpoint(ctx context.Context, s driver.Connection) (string, error) {
+	req, err := s.NewRequest("GET", "_api/cluster/endpoints")
+	if err != nil {
+		return "", driver.WithStack(err)
+	}
+	resp, err := s.Do(ctx, req)
+	if err != nil {
+		return "", driver.WithStack(err)
+	}
+	if err := resp.CheckStatus(200); err != nil {
+		return "", driver.WithStack(err)
+	}
+	var data struct {
+		Endpoints []struct {
+			Endpoint string `json:"endpoint,omitempty"`
+		} `json:"endpoints,omitempty"`
+	}
+	if err := resp.ParseBody("", &data); err != nil {
+		return "", driver.WithStack(err)
+	}
+	if len(data.Endpoints) == 0 {
+		return "", nil
+	}
+	return data.Endpoints[0].Endpoint, nil
+}
+
+// useServer makes the server with the given endpoint the currently used server.
+func (c *clusterConnection) useServer(endpoint string) (driver.Connection, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for i, s := range c.servers {
+		for _, x := range s.Endpoints() {
+			if x == endpoint {
+				c.current = i
+				return s, true
+			}
+		}
+	}
+	return nil, false
+}
+
 /*func printError(err error, indent string) {
 	if err == nil {
 		return
@@ -259,6 +409,8 @@ func (c *clusterConnection) UpdateEndpoints(endpoints []string) error {
 
 	// Create new connections
 	servers := make([]driver.Connection, 0, len(endpoints))
+	pending := make([]*int32, 0, len(endpoints))
+	health := make([]*serverHealth, 0, len(endpoints))
 	for _, ep := range endpoints {
 		conn, err := c.connectionBuilder(ep)
 		if err != nil {
@@ -271,12 +423,16 @@ func (c *clusterConnection) UpdateEndpoints(endpoints []string) error {
 			}
 		}
 		servers = append(servers, conn)
+		pending = append(pending, new(int32))
+		health = append(health, &serverHealth{})
 	}
 
 	// Swap connections
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
 	c.servers = servers
+	c.pending = pending
+	c.health = health
 	c.endpoints = endpoints
 	c.current = 0
 
@@ -321,11 +477,36 @@ func (c *clusterConnection) Protocols() driver.ProtocolSet {
 	return result
 }
 
-// getCurrentServer returns the currently used server.
-func (c *clusterConnection) getCurrentServer() driver.Connection {
+// selectServer selects the server for a new request using the load balancing strategy.
+// The currently used server is not changed, so a selection made for a single context does not
+// affect the server used by other requests.
+func (c *clusterConnection) selectServer(ctx context.Context) driver.Connection {
 	c.mutex.RLock()
 	defer c.mutex.RUnlock()
-	return c.servers[c.current]
+
+	pending := make([]int, len(c.pending))
+	for i, p := range c.pending {
+		pending[i] = int(atomic.LoadInt32(p))
+	}
+	index := c.current
+	if selected := c.strategy.SelectServer(ctx, c.current, pending); selected >= 0 && selected < len(c.servers) {
+		index = selected
+	}
+	return c.servers[c.nextHealthyIndex(index)]
+}
+
+// getPendingCounter returns the counter of requests in progress for the given server,
+// or nil if the server is no longer used.
+func (c *clusterConnection) getPendingCounter(s driver.Connection) *int32 {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	for i, x := range c.servers {
+		if x == s {
+			return c.pending[i]
+		}
+	}
+	return nil
 }
 
 // getSpecificServer returns the server with the given endpoint.
@@ -345,10 +526,21 @@ func (c *clusterConnection) getSpecificServer(endpoint string) (driver.Connectio
 	return nil, false
 }
 
-// getNextServer changes the currently used server and returns the new server.
-func (c *clusterConnection) getNextServer() driver.Connection {
+// getNextServer returns the server to fail over to after the given server failed.
+// If the failed server is the currently used server, the next server becomes the currently used server.
+func (c *clusterConnection) getNextServer(failed driver.Connection) driver.Connection {
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
-	c.current = (c.current + 1) % len(c.servers)
-	return c.servers[c.current]
+	index := c.current
+	for i, x := range c.servers {
+		if x == failed {
+			index = i
+			break
+		}
+	}
+	next := c.nextHealthyIndex((index + 1) % len(c.servers))
+	if index == c.current {
+		c.current = next
+	}
+	return c.servers[next]
 }
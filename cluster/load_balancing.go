@@ -0,0 +1,107 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package cluster
+
+import (
+	"context"
+	"hash/fnv"
+	"math/rand"
+	"sync/atomic"
+)
+
+// LoadBalancingStrategy selects the server to which a request is sent.
+// It is called for every request that is not bound to a specific endpoint using driver.WithEndpoint.
+// When the selected server fails, the request fails over to the next server.
+// SelectServer is called concurrently, so implementations must be safe for concurrent use.
+type LoadBalancingStrategy interface {
+	// SelectServer returns the index of the server to send a new request to, which must be in [0, len(pending)).
+	// current is the index of the currently used server, which only changes when that server fails
+	// (or when following the leader of an active failover deployment).
+	// pending contains the number of requests that are in progress for each server.
+	SelectServer(ctx context.Context, current int, pending []int) int
+}
+
+// NewStickyStrategy returns a strategy that sends all requests of a context prepared with
+// driver.WithStickySession to the same server, chosen from the session key.
+// Other requests keep being sent to the same server until it fails.
+// This is the default strategy.
+func NewStickyStrategy() LoadBalancingStrategy {
+	return stickyStrategy{}
+}
+
+// NewRoundRobinStrategy returns a strategy that sends each request to the next server.
+func NewRoundRobinStrategy() LoadBalancingStrategy {
+	return &roundRobinStrategy{}
+}
+
+// NewRandomStrategy returns a strategy that sends each request to a random server.
+func NewRandomStrategy() LoadBalancingStrategy {
+	return randomStrategy{}
+}
+
+// NewLeastPendingStrategy returns a strategy that sends each request to the server with the least
+// requests in progress.
+func NewLeastPendingStrategy() LoadBalancingStrategy {
+	return leastPendingStrategy{}
+}
+
+type stickyStrategy struct{}
+
+// SelectServer returns the server of the sticky session of the context, or the current server
+// when the context has no sticky session.
+func (stickyStrategy) SelectServer(ctx context.Context, current int, pending []int) int {
+	if key, ok := ctx.Value(keyStickySession).(string); ok && len(pending) > 0 {
+		h := fnv.New32a()
+		h.Write([]byte(key))
+		return int(h.Sum32() % uint32(len(pending)))
+	}
+	return current
+}
+
+type roundRobinStrategy struct {
+	next uint32
+}
+
+// SelectServer returns the next server.
+func (s *roundRobinStrategy) SelectServer(ctx context.Context, current int, pending []int) int {
+	return int((atomic.AddUint32(&s.next, 1) - 1) % uint32(len(pending)))
+}
+
+type randomStrategy struct{}
+
+// SelectServer returns a random server.
+func (randomStrategy) SelectServer(ctx context.Context, current int, pending []int) int {
+	return rand.Intn(len(pending))
+}
+
+type leastPendingStrategy struct{}
+
+// SelectServer returns the server with the least requests in progress.
+// On a tie, the current server is preferred.
+func (leastPendingStrategy) SelectServer(ctx context.Context, current int, pending []int) int {
+	result := current
+	for i, p := range pending {
+		if p < pending[result] {
+			result = i
+		}
+	}
+	return result
+}
@@ -0,0 +1,116 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	driver "github.com/arangodb/go-driver"
+)
+
+func TestStickyStrategy(t *testing.T) {
+	s := NewStickyStrategy()
+	if idx := s.SelectServer(context.Background(), 2, []int{0, 0, 5}); idx != 2 {
+		t.Errorf("Expected 2, got %d", idx)
+	}
+
+	ctx := driver.WithStickySession(context.Background(), "session-1")
+	expected := s.SelectServer(ctx, 0, []int{0, 0, 0})
+	for current := 0; current < 3; current++ {
+		if idx := s.SelectServer(ctx, current, []int{0, 0, 0}); idx != expected {
+			t.Errorf("Expected sticky server %d, got %d", expected, idx)
+		}
+	}
+	selected := make(map[int]bool)
+	for i := 0; i < 20; i++ {
+		ctx := driver.WithStickySession(context.Background(), fmt.Sprintf("session-%d", i))
+		selected[s.SelectServer(ctx, 0, []int{0, 0, 0})] = true
+	}
+	if len(selected) < 2 {
+		t.Errorf("Expected sessions to be spread over servers, got %v", selected)
+	}
+}
+
+func TestRoundRobinStrategy(t *testing.T) {
+	s := NewRoundRobinStrategy()
+	pending := []int{0, 0, 0}
+	for i := 0; i < 6; i++ {
+		if idx := s.SelectServer(context.Background(), 0, pending); idx != i%3 {
+			t.Errorf("Expected %d, got %d", i%3, idx)
+		}
+	}
+}
+
+func TestRandomStrategy(t *testing.T) {
+	s := NewRandomStrategy()
+	for i := 0; i < 100; i++ {
+		if idx := s.SelectServer(context.Background(), 0, []int{0, 0, 0}); idx < 0 || idx >= 3 {
+			t.Fatalf("Index %d out of range", idx)
+		}
+	}
+}
+
+func TestLeastPendingStrategy(t *testing.T) {
+	s := NewLeastPendingStrategy()
+	if idx := s.SelectServer(context.Background(), 0, []int{3, 1, 2}); idx != 1 {
+		t.Errorf("Expected 1, got %d", idx)
+	}
+	if idx := s.SelectServer(context.Background(), 2, []int{1, 1, 1}); idx != 2 {
+		t.Errorf("Expected current server 2 on a tie, got %d", idx)
+	}
+}
+
+func TestStickySessionKeepsCurrentServer(t *testing.T) {
+	conns := map[string]*testCBConnection{
+		"a": {endpoint: "a"},
+		"b": {endpoint: "b"},
+		"c": {endpoint: "c"},
+	}
+	builder := func(endpoint string) (driver.Connection, error) { return conns[endpoint], nil }
+	c, err := NewConnection(ConnectionConfig{}, builder, []string{"a", "b", "c"})
+	if err != nil {
+		t.Fatalf("NewConnection failed: %v", err)
+	}
+	// Find a session that is not sent to the current server
+	var ctx context.Context
+	for i := 0; ctx == nil; i++ {
+		sessionCtx := driver.WithStickySession(context.Background(), fmt.Sprintf("session-%d", i))
+		if NewStickyStrategy().SelectServer(sessionCtx, 0, []int{0, 0, 0}) != 0 {
+			ctx = sessionCtx
+		}
+	}
+	for i := 0; i < 3; i++ {
+		if _, err := c.Do(ctx, &testCBRequest{}); err != nil {
+			t.Fatalf("Do failed: %v", err)
+		}
+		if _, err := c.Do(context.Background(), &testCBRequest{}); err != nil {
+			t.Fatalf("Do failed: %v", err)
+		}
+	}
+	if calls := conns["a"].calls; calls != 3 {
+		t.Errorf("Expected 3 calls without session to the current server, got %d", calls)
+	}
+	if calls := conns["b"].calls + conns["c"].calls; calls != 3 {
+		t.Errorf("Expected 3 calls of the session to another server, got %d", calls)
+	}
+}
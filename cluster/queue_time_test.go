@@ -0,0 +1,74 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package cluster
+
+import (
+	"context"
+	"testing"
+
+	driver "github.com/arangodb/go-driver"
+)
+
+type testQueueTimeRequest struct {
+	driver.Request
+	written bool
+}
+
+func (r *testQueueTimeRequest) Written() bool { return r.written }
+
+type testQueueTimeResponse struct {
+	driver.Response
+}
+
+func (r *testQueueTimeResponse) StatusCode() int          { return 412 }
+func (r *testQueueTimeResponse) Header(key string) string { return "2" }
+func (r *testQueueTimeResponse) CheckStatus(validStatusCodes ...int) error {
+	return driver.ArangoError{HasError: true, Code: 412, ErrorNum: driver.ErrQueueTimeRequirementViolated}
+}
+
+type testQueueTimeConnection struct {
+	driver.Connection
+	endpoint string
+}
+
+func (c *testQueueTimeConnection) Endpoints() []string { return []string{c.endpoint} }
+
+func (c *testQueueTimeConnection) Do(ctx context.Context, req driver.Request) (driver.Response, error) {
+	req.(*testQueueTimeRequest).written = true
+	return nil, driver.WithStack(driver.LoadQueueTimeResponse(ctx, &testQueueTimeResponse{}))
+}
+
+func TestQueueTimeExceeded(t *testing.T) {
+	builder := func(endpoint string) (driver.Connection, error) {
+		return &testQueueTimeConnection{endpoint: endpoint}, nil
+	}
+	c, err := NewConnection(ConnectionConfig{}, builder, []string{"a", "b"})
+	if err != nil {
+		t.Fatalf("NewConnection failed: %v", err)
+	}
+	_, err = c.Do(context.Background(), &testQueueTimeRequest{})
+	if !driver.IsQueueTimeExceeded(err) {
+		t.Fatalf("Expected QueueTimeExceededError, got %v", err)
+	}
+	if !driver.IsPreconditionFailed(err) {
+		t.Errorf("Expected precondition failed error, got %v", err)
+	}
+}
@@ -85,6 +85,27 @@ func (c *cluster) DatabaseInventory(ctx context.Context, db Database) (DatabaseI
 	return result, nil
 }
 
+// ShardDistribution returns the planned and current distribution of the shards of all collections of a database.
+func (c *cluster) ShardDistribution(ctx context.Context, db Database) (map[string]CollectionShardDistribution, error) {
+	req, err := c.conn.NewRequest("GET", path.Join("_db", db.Name(), "_admin/cluster/shardDistribution"))
+	if err != nil {
+		return nil, WithStack(err)
+	}
+	applyContextSettings(ctx, req)
+	resp, err := c.conn.Do(ctx, req)
+	if err != nil {
+		return nil, WithStack(err)
+	}
+	if err := resp.CheckStatus(200); err != nil {
+		return nil, WithStack(err)
+	}
+	var result map[string]CollectionShardDistribution
+	if err := resp.ParseBody("results", &result); err != nil {
+		return nil, WithStack(err)
+	}
+	return result, nil
+}
+
 type moveShardRequest struct {
 	Database   string   `json:"database"`
 	Collection string   `json:"collection"`
@@ -0,0 +1,41 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package driver
+
+import "time"
+
+// ServerMaintenanceMode is the maintenance mode of a DBServer.
+type ServerMaintenanceMode string
+
+const (
+	// ServerMaintenanceModeMaintenance indicates that the supervision does not trigger failovers for the DBServer.
+	ServerMaintenanceModeMaintenance ServerMaintenanceMode = "maintenance"
+	// ServerMaintenanceModeNormal indicates that the DBServer is supervised normally.
+	ServerMaintenanceModeNormal ServerMaintenanceMode = "normal"
+)
+
+// ServerMaintenance contains the maintenance state of a DBServer.
+type ServerMaintenance struct {
+	// Mode is the maintenance mode of the server.
+	Mode ServerMaintenanceMode `json:"Mode,omitempty"`
+	// Until is the time at which the maintenance mode ends automatically.
+	Until time.Time `json:"Until,omitempty"`
+}
@@ -0,0 +1,104 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package driver
+
+import (
+	"context"
+	"path"
+	"time"
+)
+
+// SetMaintenance enables or disables the maintenance mode of the supervision of the entire cluster.
+func (c *cluster) SetMaintenance(ctx context.Context, enabled bool) error {
+	req, err := c.conn.NewRequest("PUT", "_admin/cluster/maintenance")
+	if err != nil {
+		return WithStack(err)
+	}
+	mode := "off"
+	if enabled {
+		mode = "on"
+	}
+	if _, err := req.SetBody(mode); err != nil {
+		return WithStack(err)
+	}
+	applyContextSettings(ctx, req)
+	resp, err := c.conn.Do(ctx, req)
+	if err != nil {
+		return WithStack(err)
+	}
+	if err := resp.CheckStatus(200); err != nil {
+		return WithStack(err)
+	}
+	return nil
+}
+
+// ServerMaintenance returns the maintenance state of the DBServer with the given ID.
+func (c *cluster) ServerMaintenance(ctx context.Context, serverID ServerID) (ServerMaintenance, error) {
+	req, err := c.conn.NewRequest("GET", path.Join("_admin/cluster/maintenance", string(serverID)))
+	if err != nil {
+		return ServerMaintenance{}, WithStack(err)
+	}
+	applyContextSettings(ctx, req)
+	resp, err := c.conn.Do(ctx, req)
+	if err != nil {
+		return ServerMaintenance{}, WithStack(err)
+	}
+	if err := resp.CheckStatus(200); err != nil {
+		return ServerMaintenance{}, WithStack(connFeatureVersionError(ctx, c.conn, err, "server maintenance", "3.8"))
+	}
+	var result struct {
+		Result *ServerMaintenance `json:"result,omitempty"`
+	}
+	if err := resp.ParseBody("", &result); err != nil {
+		return ServerMaintenance{}, WithStack(err)
+	}
+	if result.Result == nil {
+		return ServerMaintenance{Mode: ServerMaintenanceModeNormal}, nil
+	}
+	return *result.Result, nil
+}
+
+// SetServerMaintenance changes the maintenance mode of the DBServer with the given ID.
+func (c *cluster) SetServerMaintenance(ctx context.Context, serverID ServerID, mode ServerMaintenanceMode, timeout time.Duration) error {
+	req, err := c.conn.NewRequest("PUT", path.Join("_admin/cluster/maintenance", string(serverID)))
+	if err != nil {
+		return WithStack(err)
+	}
+	input := struct {
+		Mode    ServerMaintenanceMode `json:"mode"`
+		Timeout int                   `json:"timeout,omitempty"`
+	}{
+		Mode:    mode,
+		Timeout: int(timeout / time.Second),
+	}
+	if _, err := req.SetBody(input); err != nil {
+		return WithStack(err)
+	}
+	applyContextSettings(ctx, req)
+	resp, err := c.conn.Do(ctx, req)
+	if err != nil {
+		return WithStack(err)
+	}
+	if err := resp.CheckStatus(200); err != nil {
+		return WithStack(connFeatureVersionError(ctx, c.conn, err, "server maintenance", "3.8"))
+	}
+	return nil
+}
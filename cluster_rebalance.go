@@ -0,0 +1,130 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package driver
+
+// ClusterJobStatus is the status of an agency job in a cluster.
+type ClusterJobStatus string
+
+const (
+	// ClusterJobStatusToDo indicates that the job has not been started yet.
+	ClusterJobStatusToDo ClusterJobStatus = "ToDo"
+	// ClusterJobStatusPending indicates that the job is running.
+	ClusterJobStatusPending ClusterJobStatus = "Pending"
+	// ClusterJobStatusFinished indicates that the job has finished successfully.
+	ClusterJobStatusFinished ClusterJobStatus = "Finished"
+	// ClusterJobStatusFailed indicates that the job has failed.
+	ClusterJobStatusFailed ClusterJobStatus = "Failed"
+)
+
+// ClusterJob contains information about an agency job (e.g. a shard move) in a cluster.
+type ClusterJob struct {
+	// ID of the job.
+	ID string `json:"id"`
+	// Status of the job.
+	Status ClusterJobStatus `json:"status"`
+	// Type of the job, e.g. moveShard or cleanOutServer.
+	Type string `json:"type,omitempty"`
+	// Reason contains the reason of a failed job.
+	Reason string `json:"reason,omitempty"`
+	// TimeCreated is the time the job was created.
+	TimeCreated string `json:"timeCreated,omitempty"`
+	// TimeStarted is the time the job was started.
+	TimeStarted string `json:"timeStarted,omitempty"`
+	// TimeFinished is the time the job was finished.
+	TimeFinished string `json:"timeFinished,omitempty"`
+}
+
+// IsDone returns true if the job has finished or failed.
+func (j ClusterJob) IsDone() bool {
+	return j.Status == ClusterJobStatusFinished || j.Status == ClusterJobStatusFailed
+}
+
+// RebalanceOptions contains options to compute a plan to rebalance the shards of a cluster.
+type RebalanceOptions struct {
+	// MaximumNumberOfMoves limits the number of shard moves of the plan.
+	MaximumNumberOfMoves int `json:"maximumNumberOfMoves,omitempty"`
+	// LeaderChanges allows the plan to switch leaders and followers of shards.
+	LeaderChanges *bool `json:"leaderChanges,omitempty"`
+	// MoveLeaders allows the plan to move leader shards.
+	MoveLeaders *bool `json:"moveLeaders,omitempty"`
+	// MoveFollowers allows the plan to move follower shards.
+	MoveFollowers *bool `json:"moveFollowers,omitempty"`
+	// PiFactor is the weight of the number of shards of a database per server.
+	PiFactor float64 `json:"piFactor,omitempty"`
+	// DatabasesExcluded lists the databases whose shards are not moved.
+	DatabasesExcluded []string `json:"databasesExcluded,omitempty"`
+}
+
+// RebalanceMove describes the move of a single shard.
+type RebalanceMove struct {
+	// From is the server the shard is moved from.
+	From ServerID `json:"from"`
+	// To is the server the shard is moved to.
+	To ServerID `json:"to"`
+	// Shard is the ID of the moved shard.
+	Shard ShardID `json:"shard"`
+	// Collection is the ID of the collection of the shard.
+	Collection string `json:"collection"`
+	// IsLeader is true if the leader of the shard is moved.
+	IsLeader bool `json:"isLeader"`
+}
+
+// RebalancePlan contains the moves that rebalance the shards of a cluster.
+type RebalancePlan struct {
+	// ImbalanceBefore is the imbalance of the cluster before the moves.
+	ImbalanceBefore ClusterImbalance `json:"imbalanceBefore"`
+	// ImbalanceAfter is the expected imbalance of the cluster after the moves.
+	ImbalanceAfter ClusterImbalance `json:"imbalanceAfter"`
+	// Moves lists the shard moves of the plan.
+	Moves []RebalanceMove `json:"moves,omitempty"`
+}
+
+// RebalanceStatus contains the current imbalance of a cluster and the number of shard moves in progress.
+type RebalanceStatus struct {
+	ClusterImbalance
+	// PendingMoveShards is the number of shard moves that are running.
+	PendingMoveShards int `json:"pendingMoveShards"`
+	// TodoMoveShards is the number of shard moves that have not been started yet.
+	TodoMoveShards int `json:"todoMoveShards"`
+}
+
+// ClusterImbalance describes how unevenly shards and leaders are distributed over the DBServers.
+type ClusterImbalance struct {
+	// Leader contains the imbalance of shard leaders.
+	Leader struct {
+		WeightUsed   []float64 `json:"weightUsed,omitempty"`
+		TargetWeight []float64 `json:"targetWeight,omitempty"`
+		NumberShards []int     `json:"numberShards,omitempty"`
+		LeaderDupl   []int     `json:"leaderDupl,omitempty"`
+		TotalWeight  float64   `json:"totalWeight"`
+		Imbalance    float64   `json:"imbalance"`
+		TotalShards  int       `json:"totalShards"`
+	} `json:"leader"`
+	// Shards contains the imbalance of all shards.
+	Shards struct {
+		SizeUsed     []float64 `json:"sizeUsed,omitempty"`
+		TargetSize   []float64 `json:"targetSize,omitempty"`
+		NumberShards []int     `json:"numberShards,omitempty"`
+		TotalUsed    float64   `json:"totalUsed"`
+		TotalShards  int       `json:"totalShards"`
+		Imbalance    float64   `json:"imbalance"`
+	} `json:"shards"`
+}
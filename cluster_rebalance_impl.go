@@ -0,0 +1,137 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package driver
+
+import (
+	"context"
+)
+
+// rebalanceRequest is the request body of the rebalance endpoints.
+type rebalanceRequest struct {
+	Version int `json:"version"`
+	*RebalanceOptions
+	Moves []RebalanceMove `json:"moves,omitempty"`
+}
+
+// Job returns the status of the agency job with the given ID.
+func (c *cluster) Job(ctx context.Context, jobID string) (ClusterJob, error) {
+	req, err := c.conn.NewRequest("GET", "_admin/cluster/queryAgencyJob")
+	if err != nil {
+		return ClusterJob{}, WithStack(err)
+	}
+	req.SetQuery("id", jobID)
+	applyContextSettings(ctx, req)
+	resp, err := c.conn.Do(ctx, req)
+	if err != nil {
+		return ClusterJob{}, WithStack(err)
+	}
+	if err := resp.CheckStatus(200); err != nil {
+		return ClusterJob{}, WithStack(err)
+	}
+	var result ClusterJob
+	if err := resp.ParseBody("", &result); err != nil {
+		return ClusterJob{}, WithStack(err)
+	}
+	return result, nil
+}
+
+// RebalanceStatus returns the current imbalance of the cluster.
+func (c *cluster) RebalanceStatus(ctx context.Context) (RebalanceStatus, error) {
+	req, err := c.conn.NewRequest("GET", "_admin/cluster/rebalance")
+	if err != nil {
+		return RebalanceStatus{}, WithStack(err)
+	}
+	applyContextSettings(ctx, req)
+	resp, err := c.conn.Do(ctx, req)
+	if err != nil {
+		return RebalanceStatus{}, WithStack(err)
+	}
+	if err := resp.CheckStatus(200); err != nil {
+		return RebalanceStatus{}, WithStack(connFeatureVersionError(ctx, c.conn, err, "rebalancing", "3.10"))
+	}
+	var result RebalanceStatus
+	if err := resp.ParseBody("result", &result); err != nil {
+		return RebalanceStatus{}, WithStack(err)
+	}
+	return result, nil
+}
+
+// ComputeRebalancePlan computes a plan of shard moves that rebalances the cluster, without executing it.
+func (c *cluster) ComputeRebalancePlan(ctx context.Context, opts *RebalanceOptions) (RebalancePlan, error) {
+	return c.rebalance(ctx, "POST", opts)
+}
+
+// Rebalance computes a plan of shard moves that rebalances the cluster and executes it.
+func (c *cluster) Rebalance(ctx context.Context, opts *RebalanceOptions) (RebalancePlan, error) {
+	return c.rebalance(ctx, "PUT", opts)
+}
+
+// rebalance computes a rebalance plan, which is executed by the server when the PUT method is used.
+func (c *cluster) rebalance(ctx context.Context, method string, opts *RebalanceOptions) (RebalancePlan, error) {
+	req, err := c.conn.NewRequest(method, "_admin/cluster/rebalance")
+	if err != nil {
+		return RebalancePlan{}, WithStack(err)
+	}
+	input := rebalanceRequest{
+		Version:          1,
+		RebalanceOptions: opts,
+	}
+	if _, err := req.SetBody(input); err != nil {
+		return RebalancePlan{}, WithStack(err)
+	}
+	applyContextSettings(ctx, req)
+	resp, err := c.conn.Do(ctx, req)
+	if err != nil {
+		return RebalancePlan{}, WithStack(err)
+	}
+	if err := resp.CheckStatus(200, 202); err != nil {
+		return RebalancePlan{}, WithStack(connFeatureVersionError(ctx, c.conn, err, "rebalancing", "3.10"))
+	}
+	var result RebalancePlan
+	if err := resp.ParseBody("result", &result); err != nil {
+		return RebalancePlan{}, WithStack(err)
+	}
+	return result, nil
+}
+
+// ExecuteRebalancePlan executes the given shard moves, e.g. of a plan computed by ComputeRebalancePlan.
+func (c *cluster) ExecuteRebalancePlan(ctx context.Context, moves []RebalanceMove) error {
+	req, err := c.conn.NewRequest("POST", "_admin/cluster/rebalance/execute")
+	if err != nil {
+		return WithStack(err)
+	}
+	input := rebalanceRequest{
+		Version: 1,
+		Moves:   moves,
+	}
+	if _, err := req.SetBody(input); err != nil {
+		return WithStack(err)
+	}
+	applyContextSettings(ctx, req)
+	resp, err := c.conn.Do(ctx, req)
+	if err != nil {
+		return WithStack(err)
+	}
+	if err := resp.CheckStatus(200, 202); err != nil {
+		return WithStack(connFeatureVersionError(ctx, c.conn, err, "rebalancing", "3.10"))
+	}
+	return nil
+}
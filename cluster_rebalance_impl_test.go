@@ -0,0 +1,56 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package driver
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestRebalanceRequestJSON(t *testing.T) {
+	moveLeaders := true
+	data, err := json.Marshal(rebalanceRequest{
+		Version: 1,
+		RebalanceOptions: &RebalanceOptions{
+			MaximumNumberOfMoves: 10,
+			MoveLeaders:          &moveLeaders,
+		},
+	})
+	if err != nil {
+		t.Fatalf("Marshal failed: %s", err)
+	}
+	expected := `{"version":1,"maximumNumberOfMoves":10,"moveLeaders":true}`
+	if string(data) != expected {
+		t.Errorf("Expected %s, got %s", expected, data)
+	}
+
+	data, err = json.Marshal(rebalanceRequest{
+		Version: 1,
+		Moves:   []RebalanceMove{{From: "PRMR-1", To: "PRMR-2", Shard: "s1", Collection: "100"}},
+	})
+	if err != nil {
+		t.Fatalf("Marshal failed: %s", err)
+	}
+	expected = `{"version":1,"moves":[{"from":"PRMR-1","to":"PRMR-2","shard":"s1","collection":"100","isLeader":false}]}`
+	if string(data) != expected {
+		t.Errorf("Expected %s, got %s", expected, data)
+	}
+}
@@ -49,12 +49,22 @@ type Collection interface {
 	// in a collection has changed since the last revision check.
 	Revision(ctx context.Context) (string, error)
 
+	// Checksum calculates a checksum of the collection.
+	// If withRevisions is set, the revision IDs of the documents are included in the checksum.
+	// If withData is set, the user-defined document attributes are included in the checksum.
+	Checksum(ctx context.Context, withRevisions bool, withData bool) (CollectionChecksum, error)
+
 	// Properties fetches extended information about the collection.
 	Properties(ctx context.Context) (CollectionProperties, error)
 
 	// SetProperties changes properties of the collection.
 	SetProperties(ctx context.Context, options SetCollectionPropertiesOptions) error
 
+	// Shards fetches the shards of the collection together with its properties.
+	// If details is set, the servers responsible for each shard are returned as well.
+	// Only available in cluster setup.
+	Shards(ctx context.Context, details bool) (CollectionShards, error)
+
 	// Load the collection into memory.
 	Load(ctx context.Context) error
 
@@ -66,8 +76,23 @@ type Collection interface {
 	Remove(ctx context.Context) error
 
 	// Truncate removes all documents from the collection, but leaves the indexes intact.
+	// Use WithWaitForSync to wait until the removal has been synced to disk,
+	// and WithCompact to control the compaction of the underlying storage afterwards.
 	Truncate(ctx context.Context) error
 
+	// Compact compacts the data of the collection to reclaim disk space.
+	// This call needs ArangoDB 3.6 and up.
+	Compact(ctx context.Context) error
+
+	// LoadIndexesIntoMemory loads the indexes of the collection into memory.
+	// It returns true if the indexes were loaded.
+	LoadIndexesIntoMemory(ctx context.Context) (bool, error)
+
+	// Edges returns a cursor over all edges in this (edge) collection that are connected to the given vertex
+	// in the given direction. An empty direction is treated as EdgeDirectionAny.
+	// Note that the returned Cursor must always be closed.
+	Edges(ctx context.Context, vertex DocumentID, direction EdgeDirection) (Cursor, error)
+
 	// All index functions
 	CollectionIndexes
 
@@ -104,12 +129,19 @@ type CollectionProperties struct {
 	// CacheEnabled set cacheEnabled option in collection properties
 	CacheEnabled bool `json:"cacheEnabled,omitempty"`
 	KeyOptions   struct {
-		// Type specifies the type of the key generator. The currently available generators are traditional and autoincrement.
+		// Type specifies the type of the key generator. The currently available generators are traditional, autoincrement,
+		// uuid and padded.
 		Type KeyGeneratorType `json:"type,omitempty"`
 		// AllowUserKeys; if set to true, then it is allowed to supply own key values in the _key attribute of a document.
 		// If set to false, then the key generator is solely responsible for generating keys and supplying own key values in
 		// the _key attribute of documents is considered an error.
 		AllowUserKeys bool `json:"allowUserKeys,omitempty"`
+		// Increment is the increment value of the autoincrement key generator.
+		Increment int `json:"increment,omitempty"`
+		// Offset is the initial offset value of the autoincrement key generator.
+		Offset int `json:"offset,omitempty"`
+		// LastValue is the last key value generated by the key generator.
+		LastValue uint64 `json:"lastValue,omitempty"`
 	} `json:"keyOptions,omitempty"`
 	// NumberOfShards is the number of shards of the collection.
 	// Only available in cluster setup.
@@ -145,6 +177,9 @@ type CollectionProperties struct {
 	SyncByRevision bool `json:"syncByRevision,omitempty"`
 	// Schema for collection validation
 	Schema *CollectionSchemaOptions `json:"schema,omitempty"`
+	// ComputedValues lists attributes that are calculated on write.
+	// Available from 3.10 arangod version.
+	ComputedValues []ComputedValue `json:"computedValues,omitempty"`
 }
 
 const (
@@ -175,6 +210,19 @@ type SetCollectionPropertiesOptions struct {
 	CacheEnabled *bool `json:"cacheEnabled,omitempty"`
 	// Schema for collection validation
 	Schema *CollectionSchemaOptions `json:"schema,omitempty"`
+	// ComputedValues lists attributes that are calculated on write.
+	// Available from 3.10 arangod version.
+	ComputedValues []ComputedValue `json:"computedValues,omitempty"`
+}
+
+// CollectionShards contains the properties and shards of a collection.
+type CollectionShards struct {
+	// Properties contains the properties of the collection.
+	Properties CollectionProperties `json:"properties"`
+	// Shards maps the IDs of the shards of the collection to the servers responsible for them.
+	// The first server is the leader, the others are followers.
+	// The server lists are only filled when details were requested.
+	Shards map[ShardID][]ServerID `json:"shards,omitempty"`
 }
 
 // CollectionStatus indicates the status of a collection.
@@ -260,5 +308,39 @@ type CollectionStatistics struct {
 			// The memory used for storing the revisions of this collection in the storage engine (in bytes). This figure does not include the document data but only mappings from document revision ids to storage engine datafile positions.
 			Size int64 `json:"size,omitempty"`
 		} `json:"revisions"`
+		// The total size of the documents in bytes. Only available with the RocksDB storage engine.
+		DocumentsSize int64 `json:"documentsSize,omitempty"`
+		// Whether the document cache is enabled for the collection. Only available with the RocksDB storage engine.
+		CacheInUse bool `json:"cacheInUse,omitempty"`
+		// The total memory allocated for the document cache in bytes. Only available with the RocksDB storage engine.
+		CacheSize int64 `json:"cacheSize,omitempty"`
+		// The memory used in the document cache in bytes. Only available with the RocksDB storage engine.
+		CacheUsage int64 `json:"cacheUsage,omitempty"`
+		// Engine contains storage engine specific details.
+		// Only returned when the context is configured using WithDetails.
+		Engine *CollectionStatisticsEngine `json:"engine,omitempty"`
 	} `json:"figures"`
 }
+
+// CollectionStatisticsEngine contains storage engine specific statistics of a collection.
+type CollectionStatisticsEngine struct {
+	// The number of documents in the collection.
+	Documents int64 `json:"documents,omitempty"`
+	// The indexes of the collection.
+	Indexes []struct {
+		// The type of the index.
+		Type string `json:"type,omitempty"`
+		// The ID of the index.
+		ID int64 `json:"id,omitempty"`
+		// The number of entries in the index.
+		Count int64 `json:"count,omitempty"`
+	} `json:"indexes,omitempty"`
+}
+
+// CollectionChecksum contains the checksum of a collection.
+type CollectionChecksum struct {
+	// Checksum is the calculated checksum.
+	Checksum string `json:"checksum,omitempty"`
+	// Revision is the revision ID of the collection.
+	Revision string `json:"revision,omitempty"`
+}
@@ -25,6 +25,7 @@ package driver
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"path"
 	"reflect"
 )
@@ -48,13 +49,51 @@ func (c *collection) DocumentExists(ctx context.Context, key string) (bool, erro
 	return found, nil
 }
 
+// DocumentsExist checks for every given key if a document with that key exists in the collection.
+func (c *collection) DocumentsExist(ctx context.Context, keys []string) ([]bool, error) {
+	if keys == nil {
+		return nil, WithStack(InvalidArgumentError{Message: "keys nil"})
+	}
+	for _, key := range keys {
+		if err := validateKey(key); err != nil {
+			return nil, WithStack(err)
+		}
+	}
+	bindVars := map[string]interface{}{
+		"@collection": c.name,
+		"keys":        keys,
+	}
+	cursor, err := c.db.Query(ctx, "FOR key IN @keys RETURN DOCUMENT(@@collection, key) != null", bindVars)
+	if err != nil {
+		return nil, WithStack(err)
+	}
+	defer cursor.Close()
+	result := make([]bool, 0, len(keys))
+	for cursor.HasMore() {
+		var found bool
+		if _, err := cursor.ReadDocument(ctx, &found); err != nil {
+			return nil, WithStack(err)
+		}
+		result = append(result, found)
+	}
+	return result, nil
+}
+
 // ReadDocument reads a single document with given key from the collection.
 // The document data is stored into result, the document meta data is returned.
 // If no document exists with given key, a NotFoundError is returned.
+// To only read some attributes of the document, prepare a context with `WithProjection`.
 func (c *collection) ReadDocument(ctx context.Context, key string, result interface{}) (DocumentMeta, error) {
 	if err := validateKey(key); err != nil {
 		return DocumentMeta{}, WithStack(err)
 	}
+	if attributes, ok := projectionFromContext(ctx); ok {
+		meta, err := c.readDocumentProjection(ctx, key, attributes, result)
+		if err != nil {
+			return DocumentMeta{}, WithStack(err)
+		}
+		return meta, nil
+	}
 	escapedKey := pathEscape(key)
 	req, err := c.conn.NewRequest("GET", path.Join(c.relPath("document"), escapedKey))
 	if err != nil {
@@ -86,6 +125,54 @@ func (c *collection) ReadDocument(ctx context.Context, key string, result interf
 	return meta, nil
 }
 
+// readDocumentProjection reads the given top-level attributes of the document with given key
+// using an AQL query.
+// Errors are returned like the document API does, including the revision check of WithRevision.
+func (c *collection) readDocumentProjection(ctx context.Context, key string, attributes []string, result interface{}) (DocumentMeta, error) {
+	query := "FOR d IN @@collection FILTER d._key == @key LIMIT 1 RETURN KEEP(d, APPEND(@attributes, [\"_key\", \"_id\", \"_rev\"]))"
+	bindVars := map[string]interface{}{
+		"@collection": c.name,
+		"key":         key,
+		"attributes":  append([]string{}, attributes...),
+	}
+	cursor, err := c.db.Query(ctx, query, bindVars)
+	if err != nil {
+		return DocumentMeta{}, WithStack(err)
+	}
+	defer cursor.Close()
+	if !cursor.HasMore() {
+		return DocumentMeta{}, WithStack(ArangoError{
+			HasError:     true,
+			Code:         http.StatusNotFound,
+			ErrorNum:     ErrArangoDocumentNotFound,
+			ErrorMessage: "document not found",
+		})
+	}
+	var raw RawObject
+	if _, err := cursor.ReadDocument(ctx, &raw); err != nil {
+		return DocumentMeta{}, WithStack(err)
+	}
+	var meta DocumentMeta
+	if err := c.conn.Unmarshal(raw, &meta); err != nil {
+		return DocumentMeta{}, WithStack(err)
+	}
+	// Apply the If-Match condition configured using WithRevision, like the document API does
+	if rev, ok := ctx.Value(keyRevision).(string); ok && rev != "" && rev != meta.Rev {
+		return DocumentMeta{}, WithStack(ArangoError{
+			HasError:     true,
+			Code:         http.StatusPreconditionFailed,
+			ErrorNum:     ErrArangoConflict,
+			ErrorMessage: "conflict, _rev values do not match",
+		})
+	}
+	if result != nil {
+		if err := c.conn.Unmarshal(raw, result); err != nil {
+			return meta, WithStack(err)
+		}
+	}
+	return meta, nil
+}
+
 // ReadDocuments reads multiple documents with given keys from the collection.
 // The documents data is stored into elements of the given results slice,
 // the documents meta data is returned.
@@ -208,6 +295,7 @@ func (c *collection) CreateDocuments(ctx context.Context, documents interface{})
 		return nil, nil, WithStack(err)
 	}
 	cs := applyContextSettings(ctx, req)
+	disableServerSilent(req, cs)
 	resp, err := c.conn.Do(ctx, req)
 	if err != nil {
 		return nil, nil, WithStack(err)
@@ -215,15 +303,15 @@ func (c *collection) CreateDocuments(ctx context.Context, documents interface{})
 	if err := resp.CheckStatus(201, 202); err != nil {
 		return nil, nil, WithStack(err)
 	}
-	if cs.Silent {
-		// Empty response, we're done
-		return nil, nil, nil
-	}
 	// Parse response array
 	metas, errs, err := parseResponseArray(resp, documentCount, cs, nil)
 	if err != nil {
 		return nil, nil, WithStack(err)
 	}
+	if cs.Silent {
+		// Only report errors
+		return nil, errs, nil
+	}
 	return metas, errs, nil
 }
 
@@ -310,6 +398,7 @@ func (c *collection) UpdateDocuments(ctx context.Context, keys []string, updates
 		return nil, nil, WithStack(err)
 	}
 	cs := applyContextSettings(ctx, req)
+	disableServerSilent(req, cs)
 	mergeArray, err := createMergeArray(keys, cs.Revisions)
 	if err != nil {
 		return nil, nil, WithStack(err)
@@ -324,15 +413,15 @@ func (c *collection) UpdateDocuments(ctx context.Context, keys []string, updates
 	if err := resp.CheckStatus(201, 202); err != nil {
 		return nil, nil, WithStack(err)
 	}
-	if cs.Silent {
-		// Empty response, we're done
-		return nil, nil, nil
-	}
 	// Parse response array
 	metas, errs, err := parseResponseArray(resp, updateCount, cs, nil)
 	if err != nil {
 		return nil, nil, WithStack(err)
 	}
+	if cs.Silent {
+		// Only report errors
+		return nil, errs, nil
+	}
 	return metas, errs, nil
 }
 
@@ -419,6 +508,7 @@ func (c *collection) ReplaceDocuments(ctx context.Context, keys []string, docume
 		return nil, nil, WithStack(err)
 	}
 	cs := applyContextSettings(ctx, req)
+	disableServerSilent(req, cs)
 	mergeArray, err := createMergeArray(keys, cs.Revisions)
 	if err != nil {
 		return nil, nil, WithStack(err)
@@ -433,18 +523,68 @@ func (c *collection) ReplaceDocuments(ctx context.Context, keys []string, docume
 	if err := resp.CheckStatus(201, 202); err != nil {
 		return nil, nil, WithStack(err)
 	}
-	if cs.Silent {
-		// Empty response, we're done
-		return nil, nil, nil
-	}
 	// Parse response array
 	metas, errs, err := parseResponseArray(resp, documentCount, cs, nil)
 	if err != nil {
 		return nil, nil, WithStack(err)
 	}
+	if cs.Silent {
+		// Only report errors
+		return nil, errs, nil
+	}
 	return metas, errs, nil
 }
 
+// UpsertDocument inserts the insert document when no document in the collection matches all attributes
+// of the search document, otherwise it updates the matching document with the update document.
+// The document meta data is returned, together with true when a document was inserted and false when it was updated.
+// To return the NEW document, prepare a context with `WithReturnNew`.
+// To return the OLD document (of an update), prepare a context with `WithReturnOld`.
+// To wait until the document has been synced to disk, prepare a context with `WithWaitForSync`.
+func (c *collection) UpsertDocument(ctx context.Context, search, insert, update interface{}) (DocumentMeta, bool, error) {
+	if search == nil || insert == nil || update == nil {
+		return DocumentMeta{}, false, WithStack(InvalidArgumentError{Message: "search, insert and update documents must not be nil"})
+	}
+	var returnNew, returnOld interface{}
+	waitForSync := false
+	if ctx != nil {
+		returnNew = ctx.Value(keyReturnNew)
+		returnOld = ctx.Value(keyReturnOld)
+		if v, ok := ctx.Value(keyWaitForSync).(bool); ok {
+			waitForSync = v
+		}
+	}
+	query := "UPSERT @search INSERT @insert UPDATE @update IN @@collection OPTIONS { waitForSync: @waitForSync } " +
+		"RETURN { _key: NEW._key, _id: NEW._id, _rev: NEW._rev, inserted: OLD == null, " +
+		"new: @returnNew ? NEW : null, old: @returnOld ? OLD : null }"
+	bindVars := map[string]interface{}{
+		"search":      search,
+		"insert":      insert,
+		"update":      update,
+		"@collection": c.name,
+		"waitForSync": waitForSync,
+		"returnNew":   returnNew != nil,
+		"returnOld":   returnOld != nil,
+	}
+	cursor, err := c.db.Query(ctx, query, bindVars)
+	if err != nil {
+		return DocumentMeta{}, false, WithStack(err)
+	}
+	defer cursor.Close()
+
+	// New and Old hold the result targets (if any), so the documents are decoded into them directly.
+	result := struct {
+		DocumentMeta
+		Inserted bool        `json:"inserted"`
+		New      interface{} `json:"new,omitempty"`
+		Old      interface{} `json:"old,omitempty"`
+	}{New: returnNew, Old: returnOld}
+	if _, err := cursor.ReadDocument(ctx, &result); err != nil {
+		return DocumentMeta{}, false, WithStack(err)
+	}
+	return result.DocumentMeta, result.Inserted, nil
+}
+
 // RemoveDocument removes a single document with given key from the collection.
 // The document meta data is returned.
 // To return the OLD document, prepare a context with `WithReturnOld`.
@@ -502,6 +642,7 @@ func (c *collection) RemoveDocuments(ctx context.Context, keys []string) (Docume
 		return nil, nil, WithStack(err)
 	}
 	cs := applyContextSettings(ctx, req)
+	disableServerSilent(req, cs)
 	metaArray, err := createMergeArray(keys, cs.Revisions)
 	if err != nil {
 		return nil, nil, WithStack(err)
@@ -516,15 +657,15 @@ func (c *collection) RemoveDocuments(ctx context.Context, keys []string) (Docume
 	if err := resp.CheckStatus(200, 202); err != nil {
 		return nil, nil, WithStack(err)
 	}
-	if cs.Silent {
-		// Empty response, we're done
-		return nil, nil, nil
-	}
 	// Parse response array
 	metas, errs, err := parseResponseArray(resp, keyCount, cs, nil)
 	if err != nil {
 		return nil, nil, WithStack(err)
 	}
+	if cs.Silent {
+		// Only report errors
+		return nil, errs, nil
+	}
 	return metas, errs, nil
 }
 
@@ -570,7 +711,7 @@ func (c *collection) ImportDocuments(ctx context.Context, documents interface{},
 		return ImportDocumentStatistics{}, WithStack(err)
 	}
 	cs := applyContextSettings(ctx, req)
-	resp, err := c.conn.Do(ctx, req)
+	resp, err := c.conn.Do(withStreamingResponse(ctx), req)
 	if err != nil {
 		return ImportDocumentStatistics{}, WithStack(err)
 	}
@@ -591,6 +732,15 @@ func (c *collection) ImportDocuments(ctx context.Context, documents interface{},
 	return data, nil
 }
 
+// disableServerSilent makes the server return the result of every document of a multi-document request.
+// In silent mode the server only returns the errors that occurred, which cannot be mapped to the
+// input documents, so for multi-document requests silent mode is handled by the driver instead.
+func disableServerSilent(req Request, cs contextSettings) {
+	if cs.Silent {
+		req.SetQuery("silent", "false")
+	}
+}
+
 // createMergeArray returns an array of metadata maps with `_key` and/or `_rev` elements.
 func createMergeArray(keys, revs []string) ([]map[string]interface{}, error) {
 	if keys == nil && revs == nil {
@@ -26,12 +26,24 @@ import "context"
 
 // CollectionDocuments provides access to the documents in a single collection.
 type CollectionDocuments interface {
+	DocumentReader
+	DocumentWriter
+}
+
+// DocumentReader provides read access to the documents in a single collection.
+// Code that only reads documents can depend on (and mock) this interface instead of Collection.
+type DocumentReader interface {
 	// DocumentExists checks if a document with given key exists in the collection.
 	DocumentExists(ctx context.Context, key string) (bool, error)
 
+	// DocumentsExist checks for every given key if a document with that key exists in the collection.
+	// The returned slice contains an element for every key, in the same order as the keys.
+	DocumentsExist(ctx context.Context, keys []string) ([]bool, error)
+
 	// ReadDocument reads a single document with given key from the collection.
 	// The document data is stored into result, the document meta data is returned.
 	// If no document exists with given key, a NotFoundError is returned.
+	// To only read some top-level attributes of the document, prepare a context with `WithProjection`.
 	ReadDocument(ctx context.Context, key string, result interface{}) (DocumentMeta, error)
 
 	// ReadDocuments reads multiple documents with given keys from the collection.
@@ -39,7 +51,11 @@ type CollectionDocuments interface {
 	// the documents meta data is returned.
 	// If no document exists with a given key, a NotFoundError is returned at its errors index.
 	ReadDocuments(ctx context.Context, keys []string, results interface{}) (DocumentMetaSlice, ErrorSlice, error)
+}
 
+// DocumentWriter provides write access to the documents in a single collection.
+// Code that only modifies documents can depend on (and mock) this interface instead of Collection.
+type DocumentWriter interface {
 	// CreateDocument creates a single document in the collection.
 	// The document data is loaded from the given document, the document meta data is returned.
 	// If the document data already contains a `_key` field, this will be used as key of the new document,
@@ -95,6 +111,15 @@ type CollectionDocuments interface {
 	// If keys is nil, each element in the documents slice must contain a `_key` field.
 	ReplaceDocuments(ctx context.Context, keys []string, documents interface{}) (DocumentMetaSlice, ErrorSlice, error)
 
+	// UpsertDocument inserts the insert document when no document in the collection matches all attributes
+	// of the search document, otherwise it updates the matching document with the update document.
+	// The document meta data is returned, together with true when a document was inserted and false when it was updated.
+	// The search document should match at most one document, e.g. by using attributes covered by a unique index.
+	// To return the NEW document, prepare a context with `WithReturnNew`.
+	// To return the OLD document (of an update), prepare a context with `WithReturnOld`.
+	// To wait until the document has been synced to disk, prepare a context with `WithWaitForSync`.
+	UpsertDocument(ctx context.Context, search, insert, update interface{}) (DocumentMeta, bool, error)
+
 	// RemoveDocument removes a single document with given key from the collection.
 	// The document meta data is returned.
 	// To return the OLD document, prepare a context with `WithReturnOld`.
@@ -26,6 +26,7 @@ import (
 	"context"
 	"encoding/json"
 	"path"
+	"strconv"
 )
 
 // newCollection creates a new Collection implementation.
@@ -113,6 +114,7 @@ func (c *collection) Statistics(ctx context.Context) (CollectionStatistics, erro
 	if err != nil {
 		return CollectionStatistics{}, WithStack(err)
 	}
+	applyContextSettings(ctx, req)
 	resp, err := c.conn.Do(ctx, req)
 	if err != nil {
 		return CollectionStatistics{}, WithStack(err)
@@ -151,6 +153,28 @@ func (c *collection) Revision(ctx context.Context) (string, error) {
 	return data.Revision, nil
 }
 
+// Checksum calculates a checksum of the collection.
+func (c *collection) Checksum(ctx context.Context, withRevisions bool, withData bool) (CollectionChecksum, error) {
+	req, err := c.conn.NewRequest("GET", path.Join(c.relPath("collection"), "checksum"))
+	if err != nil {
+		return CollectionChecksum{}, WithStack(err)
+	}
+	req.SetQuery("withRevisions", strconv.FormatBool(withRevisions))
+	req.SetQuery("withData", strconv.FormatBool(withData))
+	resp, err := c.conn.Do(ctx, req)
+	if err != nil {
+		return CollectionChecksum{}, WithStack(err)
+	}
+	if err := resp.CheckStatus(200); err != nil {
+		return CollectionChecksum{}, WithStack(err)
+	}
+	var data CollectionChecksum
+	if err := resp.ParseBody("", &data); err != nil {
+		return CollectionChecksum{}, WithStack(err)
+	}
+	return data, nil
+}
+
 // Properties fetches extended information about the collection.
 func (c *collection) Properties(ctx context.Context) (CollectionProperties, error) {
 	req, err := c.conn.NewRequest("GET", path.Join(c.relPath("collection"), "properties"))
@@ -190,6 +214,44 @@ func (c *collection) SetProperties(ctx context.Context, options SetCollectionPro
 	return nil
 }
 
+// Shards fetches the shards of the collection together with its properties.
+func (c *collection) Shards(ctx context.Context, details bool) (CollectionShards, error) {
+	req, err := c.conn.NewRequest("GET", path.Join(c.relPath("collection"), "shards"))
+	if err != nil {
+		return CollectionShards{}, WithStack(err)
+	}
+	req.SetQuery("details", strconv.FormatBool(details))
+	resp, err := c.conn.Do(ctx, req)
+	if err != nil {
+		return CollectionShards{}, WithStack(err)
+	}
+	if err := resp.CheckStatus(200); err != nil {
+		return CollectionShards{}, WithStack(err)
+	}
+	var props collectionPropertiesInternal
+	if err := resp.ParseBody("", &props); err != nil {
+		return CollectionShards{}, WithStack(err)
+	}
+	result := CollectionShards{
+		Properties: props.asExternal(),
+	}
+	if details {
+		if err := resp.ParseBody("shards", &result.Shards); err != nil {
+			return CollectionShards{}, WithStack(err)
+		}
+	} else {
+		var shards []ShardID
+		if err := resp.ParseBody("shards", &shards); err != nil {
+			return CollectionShards{}, WithStack(err)
+		}
+		result.Shards = make(map[ShardID][]ServerID, len(shards))
+		for _, shard := range shards {
+			result.Shards[shard] = nil
+		}
+	}
+	return result, nil
+}
+
 // Load the collection into memory.
 func (c *collection) Load(ctx context.Context) error {
 	req, err := c.conn.NewRequest("PUT", path.Join(c.relPath("collection"), "load"))
@@ -255,6 +317,7 @@ func (c *collection) Truncate(ctx context.Context) error {
 	if err != nil {
 		return WithStack(err)
 	}
+	applyContextSettings(ctx, req)
 	resp, err := c.conn.Do(ctx, req)
 	if err != nil {
 		return WithStack(err)
@@ -265,6 +328,66 @@ func (c *collection) Truncate(ctx context.Context) error {
 	return nil
 }
 
+// Compact compacts the data of the collection to reclaim disk space.
+func (c *collection) Compact(ctx context.Context) error {
+	req, err := c.conn.NewRequest("PUT", path.Join(c.relPath("collection"), "compact"))
+	if err != nil {
+		return WithStack(err)
+	}
+	applyContextSettings(ctx, req)
+	resp, err := c.conn.Do(ctx, req)
+	if err != nil {
+		return WithStack(err)
+	}
+	if err := resp.CheckStatus(200); err != nil {
+		return WithStack(connFeatureVersionError(ctx, c.conn, err, "collection compaction", "3.6"))
+	}
+	return nil
+}
+
+// LoadIndexesIntoMemory loads the indexes of the collection into memory.
+func (c *collection) LoadIndexesIntoMemory(ctx context.Context) (bool, error) {
+	req, err := c.conn.NewRequest("PUT", path.Join(c.relPath("collection"), "loadIndexesIntoMemory"))
+	if err != nil {
+		return false, WithStack(err)
+	}
+	applyContextSettings(ctx, req)
+	resp, err := c.conn.Do(ctx, req)
+	if err != nil {
+		return false, WithStack(err)
+	}
+	if err := resp.CheckStatus(200); err != nil {
+		return false, WithStack(err)
+	}
+	var data struct {
+		Result bool `json:"result"`
+	}
+	if err := resp.ParseBody("", &data); err != nil {
+		return false, WithStack(err)
+	}
+	return data.Result, nil
+}
+
+// Edges returns a cursor over all edges in this (edge) collection that are connected to the given vertex.
+func (c *collection) Edges(ctx context.Context, vertex DocumentID, direction EdgeDirection) (Cursor, error) {
+	if err := vertex.Validate(); err != nil {
+		return nil, WithStack(InvalidArgumentError{Message: err.Error()})
+	}
+	filter, err := direction.edgesFilter()
+	if err != nil {
+		return nil, WithStack(err)
+	}
+	bindVars := map[string]interface{}{
+		"@collection": c.name,
+		"vertex":      vertex.String(),
+	}
+	cursor, err := c.db.Query(ctx, "FOR e IN @@collection FILTER "+filter+" RETURN e", bindVars)
+	if err != nil {
+		return nil, WithStack(err)
+	}
+	return cursor, nil
+}
+
 type collectionPropertiesInternal struct {
 	CollectionInfo
 	WaitForSync  bool  `json:"waitForSync,omitempty"`
@@ -274,6 +397,9 @@ type collectionPropertiesInternal struct {
 	KeyOptions   struct {
 		Type          KeyGeneratorType `json:"type,omitempty"`
 		AllowUserKeys bool             `json:"allowUserKeys,omitempty"`
+		Increment     int              `json:"increment,omitempty"`
+		Offset        int              `json:"offset,omitempty"`
+		LastValue     uint64           `json:"lastValue,omitempty"`
 	} `json:"keyOptions,omitempty"`
 	NumberOfShards    int               `json:"numberOfShards,omitempty"`
 	ShardKeys         []string          `json:"shardKeys,omitempty"`
@@ -289,6 +415,7 @@ type collectionPropertiesInternal struct {
 	UsesRevisionsAsDocumentIds bool                     `json:"usesRevisionsAsDocumentIds,omitempty"`
 	SyncByRevision             bool                     `json:"syncByRevision,omitempty"`
 	Schema                     *CollectionSchemaOptions `json:"schema,omitempty"`
+	ComputedValues             []ComputedValue          `json:"computedValues,omitempty"`
 }
 
 func (p *collectionPropertiesInternal) asExternal() CollectionProperties {
@@ -310,25 +437,30 @@ func (p *collectionPropertiesInternal) asExternal() CollectionProperties {
 		UsesRevisionsAsDocumentIds: p.UsesRevisionsAsDocumentIds,
 		SyncByRevision:             p.SyncByRevision,
 		Schema:                     p.Schema,
+		ComputedValues:             p.ComputedValues,
 	}
 }
 
 func (p *CollectionProperties) asInternal() collectionPropertiesInternal {
 	return collectionPropertiesInternal{
-		CollectionInfo:       p.CollectionInfo,
-		WaitForSync:          p.WaitForSync,
-		DoCompact:            p.DoCompact,
-		JournalSize:          p.JournalSize,
-		CacheEnabled:         p.CacheEnabled,
-		KeyOptions:           p.KeyOptions,
-		NumberOfShards:       p.NumberOfShards,
-		ShardKeys:            p.ShardKeys,
-		ReplicationFactor:    replicationFactor(p.ReplicationFactor),
-		MinReplicationFactor: p.MinReplicationFactor,
-		WriteConcern:         p.WriteConcern,
-		SmartJoinAttribute:   p.SmartJoinAttribute,
-		ShardingStrategy:     p.ShardingStrategy,
-		Schema:               p.Schema,
+		CollectionInfo:             p.CollectionInfo,
+		WaitForSync:                p.WaitForSync,
+		DoCompact:                  p.DoCompact,
+		JournalSize:                p.JournalSize,
+		CacheEnabled:               p.CacheEnabled,
+		KeyOptions:                 p.KeyOptions,
+		NumberOfShards:             p.NumberOfShards,
+		ShardKeys:                  p.ShardKeys,
+		ReplicationFactor:          replicationFactor(p.ReplicationFactor),
+		MinReplicationFactor:       p.MinReplicationFactor,
+		WriteConcern:               p.WriteConcern,
+		SmartJoinAttribute:         p.SmartJoinAttribute,
+		ShardingStrategy:           p.ShardingStrategy,
+		DistributeShardsLike:       p.DistributeShardsLike,
+		UsesRevisionsAsDocumentIds: p.UsesRevisionsAsDocumentIds,
+		SyncByRevision:             p.SyncByRevision,
+		Schema:                     p.Schema,
+		ComputedValues:             p.ComputedValues,
 	}
 }
 
@@ -346,8 +478,11 @@ func (p *CollectionProperties) fromInternal(i *collectionPropertiesInternal) {
 	p.WriteConcern = i.WriteConcern
 	p.SmartJoinAttribute = i.SmartJoinAttribute
 	p.ShardingStrategy = i.ShardingStrategy
+	p.DistributeShardsLike = i.DistributeShardsLike
 	p.UsesRevisionsAsDocumentIds = i.UsesRevisionsAsDocumentIds
 	p.SyncByRevision = i.SyncByRevision
+	p.Schema = i.Schema
+	p.ComputedValues = i.ComputedValues
 }
 
 // MarshalJSON converts CollectionProperties into json
@@ -376,6 +511,8 @@ type setCollectionPropertiesOptionsInternal struct {
 	// Available from 3.6 arangod version.
 	WriteConcern int                      `json:"writeConcern,omitempty"`
 	Schema       *CollectionSchemaOptions `json:"schema,omitempty"`
+	// Available from 3.10 arangod version.
+	ComputedValues []ComputedValue `json:"computedValues,omitempty"`
 }
 
 func (p *SetCollectionPropertiesOptions) asInternal() setCollectionPropertiesOptionsInternal {
@@ -387,6 +524,7 @@ func (p *SetCollectionPropertiesOptions) asInternal() setCollectionPropertiesOpt
 		MinReplicationFactor: p.MinReplicationFactor,
 		WriteConcern:         p.WriteConcern,
 		Schema:               p.Schema,
+		ComputedValues:       p.ComputedValues,
 	}
 }
 
@@ -398,6 +536,7 @@ func (p *SetCollectionPropertiesOptions) fromInternal(i *setCollectionProperties
 	p.MinReplicationFactor = i.MinReplicationFactor
 	p.WriteConcern = i.WriteConcern
 	p.Schema = i.Schema
+	p.ComputedValues = i.ComputedValues
 }
 
 // MarshalJSON converts SetCollectionPropertiesOptions into json
@@ -0,0 +1,100 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package driver
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestCollectionPropertiesJSON(t *testing.T) {
+	props := CollectionProperties{
+		WaitForSync:                true,
+		CacheEnabled:               true,
+		ReplicationFactor:          ReplicationFactorSatellite,
+		WriteConcern:               2,
+		DistributeShardsLike:       "other",
+		UsesRevisionsAsDocumentIds: true,
+		SyncByRevision:             true,
+		Schema: &CollectionSchemaOptions{
+			Rule:    map[string]interface{}{"type": "object"},
+			Level:   CollectionSchemaLevelStrict,
+			Message: "invalid",
+		},
+	}
+	data, err := json.Marshal(&props)
+	if err != nil {
+		t.Fatalf("Marshal failed: %s", err)
+	}
+	var result CollectionProperties
+	if err := json.Unmarshal(data, &result); err != nil {
+		t.Fatalf("Unmarshal failed: %s", err)
+	}
+	if !reflect.DeepEqual(props, result) {
+		t.Errorf("Expected %+v, got %+v", props, result)
+	}
+}
+
+func TestNarrowCollectionInterfaces(t *testing.T) {
+	var col Collection = &collection{}
+	var _ DocumentReader = col
+	var _ DocumentWriter = col
+	var _ CollectionIndexes = col
+	var _ DatabaseQueryExecutor = &database{}
+}
+
+func TestParseIndexTypedOptions(t *testing.T) {
+	col := &collection{name: "c", db: &database{name: "db"}}
+	parse := func(data string) Index {
+		idx, err := col.parseIndex(func(field string, result interface{}) error {
+			return json.Unmarshal([]byte(data), result)
+		})
+		if err != nil {
+			t.Fatalf("parseIndex of %s failed: %s", data, err)
+		}
+		return idx
+	}
+
+	idx := parse(`{"id":"c/1","type":"persistent","fields":["a"],"unique":true,"sparse":true,"deduplicate":true}`)
+	if !idx.Unique() || !idx.Sparse() || !idx.Deduplicate() || !reflect.DeepEqual(idx.Fields(), []string{"a"}) {
+		t.Errorf("Unexpected persistent index options")
+	}
+	if !idx.Estimates() {
+		t.Error("Expected estimates to default to true")
+	}
+	if idx := parse(`{"id":"c/6","type":"persistent","fields":["a"],"estimates":false}`); idx.Estimates() {
+		t.Error("Expected estimates to be false")
+	}
+	if idx := parse(`{"id":"c/2","type":"ttl","fields":["t"],"expireAfter":60}`); idx.ExpireAfter() != 60 {
+		t.Errorf("Expected expireAfter 60, got %d", idx.ExpireAfter())
+	}
+	if idx := parse(`{"id":"c/3","type":"geo","fields":["l"],"geoJson":true}`); !idx.GeoJSON() {
+		t.Error("Expected geoJson to be true")
+	}
+	if idx := parse(`{"id":"c/4","type":"fulltext","fields":["x"],"minLength":3}`); idx.MinLength() != 3 {
+		t.Errorf("Expected minLength 3, got %d", idx.MinLength())
+	}
+	idx = parse(`{"id":"c/5","type":"zkd","fields":["x","y"],"fieldValueTypes":"double"}`)
+	if idx.Type() != ZKDIndex || idx.FieldValueTypes() != "double" {
+		t.Errorf("Expected zkd index with double values, got %s with '%s'", idx.Type(), idx.FieldValueTypes())
+	}
+}
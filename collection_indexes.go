@@ -34,8 +34,18 @@ type CollectionIndexes interface {
 	IndexExists(ctx context.Context, name string) (bool, error)
 
 	// Indexes returns a list of all indexes in the collection.
+	// Use a context prepared with `WithIndexStats` to include the statistics of every index.
 	Indexes(ctx context.Context) ([]Index, error)
 
+	// IndexByName opens a connection to an existing index within the collection, looking it up by
+	// its user provided name (see Index.UserName) instead of its collection specific ID.
+	// If no index with given user name exists, an NotFoundError is returned.
+	IndexByName(ctx context.Context, name string) (Index, error)
+
+	// DeleteIndexByName removes the index with given user provided name from the collection.
+	// If no index with given user name exists, an NotFoundError is returned.
+	DeleteIndexByName(ctx context.Context, name string) error
+
 	// EnsureFullTextIndex creates a fulltext index in the collection, if it does not already exist.
 	// Fields is a slice of attribute names. Currently, the slice is limited to exactly one attribute.
 	// The index is returned, together with a boolean indicating if the index was newly created (true) or pre-existing (false).
@@ -70,6 +80,12 @@ type CollectionIndexes interface {
 	// EnsureTTLIndex creates a TLL collection, if it does not already exist.
 	// The index is returned, together with a boolean indicating if the index was newly created (true) or pre-existing (false).
 	EnsureTTLIndex(ctx context.Context, field string, expireAfter int, options *EnsureTTLIndexOptions) (Index, bool, error)
+
+	// EnsureInvertedIndex creates an inverted index in the collection, if it does not already exist.
+	// Inverted indexes can be used by views of type search-alias.
+	// The index is returned, together with a boolean indicating if the index was newly created (true) or pre-existing (false).
+	// This function requires ArangoDB 3.10 or higher.
+	EnsureInvertedIndex(ctx context.Context, options *InvertedIndexOptions) (Index, bool, error)
 }
 
 // EnsureFullTextIndexOptions contains specific options for creating a full text index.
@@ -92,6 +108,10 @@ type EnsureGeoIndexOptions struct {
 	InBackground bool
 	// Name optional user defined name used for hints in AQL queries
 	Name string
+	// LegacyPolygons determines if the index uses the polygon semantics of ArangoDB versions before 3.10.
+	// Indexes created with 3.10 or later use the new semantics unless this option is set to true.
+	// This option requires ArangoDB 3.10.
+	LegacyPolygons bool
 }
 
 // EnsureHashIndexOptions contains specific options for creating a hash index.
@@ -120,6 +140,16 @@ type EnsurePersistentIndexOptions struct {
 	InBackground bool
 	// Name optional user defined name used for hints in AQL queries
 	Name string
+	// StoredValues is a slice of additional attribute paths that are stored in the index,
+	// so queries can be covered by the index without looking up the documents.
+	// This option requires ArangoDB 3.10.
+	StoredValues []string
+	// CacheEnabled if true will enable an in-memory cache for the index values.
+	// This option requires ArangoDB 3.10.
+	CacheEnabled bool
+	// Estimates can be set to false to disable the maintenance of selectivity estimates for the index.
+	// If nil, the server default (true) is used.
+	Estimates *bool
 }
 
 // EnsureSkipListIndexOptions contains specific options for creating a skip-list index.
@@ -145,3 +175,56 @@ type EnsureTTLIndexOptions struct {
 	// Name optional user defined name used for hints in AQL queries
 	Name string
 }
+
+// InvertedIndexOptions provides specific options for creating an inverted index.
+type InvertedIndexOptions struct {
+	// Name optional user defined name used for hints in AQL queries
+	Name string `json:"name,omitempty"`
+	// InBackground if true will not hold an exclusive collection lock for the entire index creation period.
+	InBackground bool `json:"inBackground,omitempty"`
+	// Parallelism is the number of threads to use for indexing the fields.
+	Parallelism int `json:"parallelism,omitempty"`
+	// PrimarySort describes how the index data is sorted.
+	PrimarySort *InvertedIndexPrimarySort `json:"primarySort,omitempty"`
+	// StoredValues defines attribute paths which are stored in the index, in addition to the ones in PrimarySort.
+	StoredValues []StoredValue `json:"storedValues,omitempty"`
+	// Analyzer is the name of the analyzer used for all fields that do not specify their own analyzer.
+	// Defaults to "identity".
+	Analyzer string `json:"analyzer,omitempty"`
+	// Features is a list of analyzer features to enable for the default analyzer.
+	Features []ArangoSearchAnalyzerFeature `json:"features,omitempty"`
+	// IncludeAllFields if true will index all fields of the documents, not just the ones listed in Fields.
+	IncludeAllFields bool `json:"includeAllFields,omitempty"`
+	// TrackListPositions if true will track the positions of array values.
+	TrackListPositions bool `json:"trackListPositions,omitempty"`
+	// SearchField if true will process array values as if they were separate values.
+	SearchField bool `json:"searchField,omitempty"`
+	// Fields is a list of fields that are indexed.
+	Fields []InvertedIndexField `json:"fields,omitempty"`
+}
+
+// InvertedIndexPrimarySort describes how the data of an inverted index is sorted.
+type InvertedIndexPrimarySort struct {
+	// Fields describes how individual fields are sorted.
+	Fields []ArangoSearchPrimarySortEntry `json:"fields,omitempty"`
+	// Compression defines how to compress the primary sort data.
+	Compression PrimarySortCompression `json:"compression,omitempty"`
+}
+
+// InvertedIndexField describes how a single field is indexed by an inverted index.
+type InvertedIndexField struct {
+	// Name is the attribute path of the field.
+	Name string `json:"name"`
+	// Analyzer is the name of the analyzer used for this field. Defaults to the analyzer of the index.
+	Analyzer string `json:"analyzer,omitempty"`
+	// Features is a list of analyzer features to enable for this field.
+	Features []ArangoSearchAnalyzerFeature `json:"features,omitempty"`
+	// IncludeAllFields if true will index all sub-attributes of this field.
+	IncludeAllFields bool `json:"includeAllFields,omitempty"`
+	// TrackListPositions if true will track the positions of array values of this field.
+	TrackListPositions bool `json:"trackListPositions,omitempty"`
+	// SearchField if true will process array values of this field as if they were separate values.
+	SearchField bool `json:"searchField,omitempty"`
+	// Nested is a list of sub-attributes of this field that are indexed as nested objects (Enterprise Edition only).
+	Nested []InvertedIndexField `json:"nested,omitempty"`
+}
@@ -24,31 +24,39 @@ package driver
 
 import (
 	"context"
+	"net/http"
 	"path"
 )
 
 type indexData struct {
-	ID           string   `json:"id,omitempty"`
-	Type         string   `json:"type"`
-	Fields       []string `json:"fields,omitempty"`
-	Unique       *bool    `json:"unique,omitempty"`
-	Deduplicate  *bool    `json:"deduplicate,omitempty"`
-	Sparse       *bool    `json:"sparse,omitempty"`
-	GeoJSON      *bool    `json:"geoJson,omitempty"`
-	InBackground *bool    `json:"inBackground,omitempty"`
-	MinLength    int      `json:"minLength,omitempty"`
-	ExpireAfter  int      `json:"expireAfter,omitempty"`
-	Name         string   `json:"name,omitempty"`
+	ID                  string        `json:"id,omitempty"`
+	Type                string        `json:"type"`
+	Fields              []string      `json:"fields,omitempty"`
+	Unique              *bool         `json:"unique,omitempty"`
+	Deduplicate         *bool         `json:"deduplicate,omitempty"`
+	Sparse              *bool         `json:"sparse,omitempty"`
+	GeoJSON             *bool         `json:"geoJson,omitempty"`
+	LegacyPolygons      *bool         `json:"legacyPolygons,omitempty"`
+	InBackground        *bool         `json:"inBackground,omitempty"`
+	MinLength           int           `json:"minLength,omitempty"`
+	ExpireAfter         int           `json:"expireAfter,omitempty"`
+	FieldValueTypes     string        `json:"fieldValueTypes,omitempty"`
+	Name                string        `json:"name,omitempty"`
+	StoredValues        []string      `json:"storedValues,omitempty"`
+	CacheEnabled        *bool         `json:"cacheEnabled,omitempty"`
+	Estimates           *bool         `json:"estimates,omitempty"`
+	SelectivityEstimate float64       `json:"selectivityEstimate,omitempty"`
+	Figures             *IndexFigures `json:"figures,omitempty"`
 }
 
-type genericIndexData struct {
+type invertedIndexData struct {
 	ID   string `json:"id,omitempty"`
 	Type string `json:"type"`
-	Name string `json:"name,omitempty"`
+	InvertedIndexOptions
 }
 
 type indexListResponse struct {
-	Indexes []genericIndexData `json:"indexes,omitempty"`
+	Indexes []RawObject `json:"indexes,omitempty"`
 }
 
 // Index opens a connection to an existing index within the collection.
@@ -65,11 +73,7 @@ func (c *collection) Index(ctx context.Context, name string) (Index, error) {
 	if err := resp.CheckStatus(200); err != nil {
 		return nil, WithStack(err)
 	}
-	var data indexData
-	if err := resp.ParseBody("", &data); err != nil {
-		return nil, WithStack(err)
-	}
-	idx, err := newIndex(data.ID, data.Type, data.Name, c)
+	idx, err := c.parseIndex(resp.ParseBody)
 	if err != nil {
 		return nil, WithStack(err)
 	}
@@ -102,6 +106,7 @@ func (c *collection) Indexes(ctx context.Context) ([]Index, error) {
 		return nil, WithStack(err)
 	}
 	req.SetQuery("collection", c.name)
+	applyContextSettings(ctx, req)
 	resp, err := c.conn.Do(ctx, req)
 	if err != nil {
 		return nil, WithStack(err)
@@ -115,7 +120,10 @@ func (c *collection) Indexes(ctx context.Context) ([]Index, error) {
 	}
 	result := make([]Index, 0, len(data.Indexes))
 	for _, x := range data.Indexes {
-		idx, err := newIndex(x.ID, x.Type, x.Name, c)
+		raw := x
+		idx, err := c.parseIndex(func(field string, result interface{}) error {
+			return c.conn.Unmarshal(raw, result)
+		})
 		if err != nil {
 			return nil, WithStack(err)
 		}
@@ -124,6 +132,69 @@ func (c *collection) Indexes(ctx context.Context) ([]Index, error) {
 	return result, nil
 }
 
+// parseIndex creates an Index from the index data that is unmarshalled by the given function.
+// The type of the index is inspected first, since the data of inverted indexes has a different layout.
+func (c *collection) parseIndex(unmarshal func(field string, result interface{}) error) (Index, error) {
+	var header struct {
+		Type string `json:"type"`
+	}
+	if err := unmarshal("", &header); err != nil {
+		return nil, WithStack(err)
+	}
+	if header.Type == string(InvertedIndex) {
+		var data invertedIndexData
+		if err := unmarshal("", &data); err != nil {
+			return nil, WithStack(err)
+		}
+		idx, err := newInvertedIndex(data, c)
+		if err != nil {
+			return nil, WithStack(err)
+		}
+		return idx, nil
+	}
+	var data indexData
+	if err := unmarshal("", &data); err != nil {
+		return nil, WithStack(err)
+	}
+	idx, err := newIndex(data, c)
+	if err != nil {
+		return nil, WithStack(err)
+	}
+	return idx, nil
+}
+
+// IndexByName opens a connection to an existing index within the collection, looking it up by
+// its user provided name.
+// If no index with given user name exists, an NotFoundError is returned.
+func (c *collection) IndexByName(ctx context.Context, name string) (Index, error) {
+	if name == "" {
+		return nil, WithStack(InvalidArgumentError{Message: "name is empty"})
+	}
+	idxs, err := c.Indexes(ctx)
+	if err != nil {
+		return nil, WithStack(err)
+	}
+	for _, idx := range idxs {
+		if idx.UserName() == name {
+			return idx, nil
+		}
+	}
+	return nil, WithStack(newArangoError(http.StatusNotFound, ErrArangoIndexNotFound, "index not found"))
+}
+
+// DeleteIndexByName removes the index with given user provided name from the collection.
+// If no index with given user name exists, an NotFoundError is returned.
+func (c *collection) DeleteIndexByName(ctx context.Context, name string) error {
+	idx, err := c.IndexByName(ctx, name)
+	if err != nil {
+		return WithStack(err)
+	}
+	if err := idx.Remove(ctx); err != nil {
+		return WithStack(err)
+	}
+	return nil
+}
+
 // EnsureFullTextIndex creates a fulltext index in the collection, if it does not already exist.
 //
 // Fields is a slice of attribute names. Currently, the slice is limited to exactly one attribute.
@@ -164,6 +235,9 @@ func (c *collection) EnsureGeoIndex(ctx context.Context, fields []string, option
 		input.InBackground = &options.InBackground
 		input.Name = options.Name
 		input.GeoJSON = &options.GeoJSON
+		if options.LegacyPolygons {
+			input.LegacyPolygons = &options.LegacyPolygons
+		}
 	}
 	idx, created, err := c.ensureIndex(ctx, input)
 	if err != nil {
@@ -210,6 +284,11 @@ func (c *collection) EnsurePersistentIndex(ctx context.Context, fields []string,
 		input.Name = options.Name
 		input.Unique = &options.Unique
 		input.Sparse = &options.Sparse
+		input.StoredValues = options.StoredValues
+		if options.CacheEnabled {
+			input.CacheEnabled = &options.CacheEnabled
+		}
+		input.Estimates = options.Estimates
 	}
 	idx, created, err := c.ensureIndex(ctx, input)
 	if err != nil {
@@ -262,10 +341,27 @@ func (c *collection) EnsureTTLIndex(ctx context.Context, field string, expireAft
 	return idx, created, nil
 }
 
+// EnsureInvertedIndex creates an inverted index in the collection, if it does not already exist.
+// The index is returned, together with a boolean indicating if the index was newly created (true) or pre-existing (false).
+func (c *collection) EnsureInvertedIndex(ctx context.Context, options *InvertedIndexOptions) (Index, bool, error) {
+	if options == nil {
+		return nil, false, WithStack(InvalidArgumentError{Message: "options is nil"})
+	}
+	input := invertedIndexData{
+		Type:                 string(InvertedIndex),
+		InvertedIndexOptions: *options,
+	}
+	idx, created, err := c.ensureIndex(ctx, input)
+	if err != nil {
+		return nil, false, WithStack(err)
+	}
+	return idx, created, nil
+}
+
 // ensureIndex creates a persistent index in the collection, if it does not already exist.
 // Fields is a slice of attribute paths.
 // The index is returned, together with a boolean indicating if the index was newly created (true) or pre-existing (false).
-func (c *collection) ensureIndex(ctx context.Context, options indexData) (Index, bool, error) {
+func (c *collection) ensureIndex(ctx context.Context, options interface{}) (Index, bool, error) {
 	req, err := c.conn.NewRequest("POST", path.Join(c.db.relPath(), "_api/index"))
 	if err != nil {
 		return nil, false, WithStack(err)
@@ -282,11 +378,7 @@ func (c *collection) ensureIndex(ctx context.Context, options indexData) (Index,
 		return nil, false, WithStack(err)
 	}
 	created := resp.StatusCode() == 201
-	var data indexData
-	if err := resp.ParseBody("", &data); err != nil {
-		return nil, false, WithStack(err)
-	}
-	idx, err := newIndex(data.ID, data.Type, data.Name, c)
+	idx, err := c.parseIndex(resp.ParseBody)
 	if err != nil {
 		return nil, false, WithStack(err)
 	}
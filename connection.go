@@ -120,6 +120,10 @@ type Response interface {
 	// If no such header is found, an empty string is returned.
 	// On nested Response's, this function will always return an empty string.
 	Header(key string) string
+	// RawBody returns the raw (protocol specific) encoded body of the response.
+	// When the body was decoded directly from the network stream (see WithStreamingResponse), it is encoded again.
+	// On nested Response's, this function returns the encoded body of the nested element.
+	RawBody() []byte
 	// ParseBody performs protocol specific unmarshalling of the response data into the given result.
 	// If the given field is non-empty, the contents of that field will be parsed into the given result.
 	// This can only be used for requests that return a single object.
@@ -56,12 +56,27 @@ const (
 	keyConfigured               ContextKey = "arangodb-configured"
 	keyFollowLeaderRedirect     ContextKey = "arangodb-followLeaderRedirect"
 	keyDBServerID               ContextKey = "arangodb-dbserverID"
+	keyServerID                 ContextKey = "arangodb-serverID"
 	keyBatchID                  ContextKey = "arangodb-batchID"
 	keyJobIDResponse            ContextKey = "arangodb-jobIDResponse"
 	keyAllowDirtyReads          ContextKey = "arangodb-allowDirtyReads"
 	keyTransactionID            ContextKey = "arangodb-transactionID"
 	keyOverwriteMode            ContextKey = "arangodb-overwriteMode"
 	keyOverwrite                ContextKey = "arangodb-overwrite"
+	keyIndexStats               ContextKey = "arangodb-indexStats"
+	keyDropCollections          ContextKey = "arangodb-dropCollections"
+	keyRefillIndexCaches        ContextKey = "arangodb-refillIndexCaches"
+	keyCompact                  ContextKey = "arangodb-compact"
+	keyStreamingResponse        ContextKey = "arangodb-streamingResponse"
+	keyQueueTimeout             ContextKey = "arangodb-queueTimeout"
+	keyQueueTime                ContextKey = "arangodb-queueTime"
+	keyAsync                    ContextKey = "arangodb-async"
+	keyAsyncID                  ContextKey = "arangodb-asyncID"
+	keyProjection               ContextKey = "arangodb-projection"
+	keyRetrySafe                ContextKey = "arangodb-retrySafe"
+	keyTransactionEndpoints     ContextKey = "arangodb-transactionEndpoints"
+	keyRequestAttempt           ContextKey = "arangodb-requestAttempt"
+	keyStickySession            ContextKey = "arangodb-stickySession"
 )
 
 type OverwriteMode string
@@ -97,7 +112,7 @@ func WithReturnOld(parent context.Context, result interface{}) context.Context {
 	return context.WithValue(contextOrBackground(parent), keyReturnOld, result)
 }
 
-// WithDetails is used to configure a context to make Client.Version return additional details.
+// WithDetails is used to configure a context to make Client.Version and Collection.Statistics return additional details.
 // You can pass a single (optional) boolean. If that is set to false, you explicitly ask to not provide details.
 func WithDetails(parent context.Context, value ...bool) context.Context {
 	v := true
@@ -115,6 +130,13 @@ func WithEndpoint(parent context.Context, endpoint string) context.Context {
 	return context.WithValue(contextOrBackground(parent), keyEndpoint, endpoint)
 }
 
+// WithStickySession is used to configure a context so that all requests made with it are sent to the same server,
+// when the cluster connection uses the sticky load balancing strategy. Requests of contexts prepared with the
+// same key go to the same server for as long as the list of servers does not change.
+func WithStickySession(parent context.Context, key string) context.Context {
+	return context.WithValue(contextOrBackground(parent), keyStickySession, key)
+}
+
 // WithKeepNull is used to configure a context to make update functions keep null fields (value==true)
 // or remove fields with null values (value==false).
 func WithKeepNull(parent context.Context, value bool) context.Context {
@@ -128,9 +150,23 @@ func WithMergeObjects(parent context.Context, value bool) context.Context {
 	return context.WithValue(contextOrBackground(parent), keyMergeObjects, value)
 }
 
+// WithRefillIndexCaches is used to configure a context to make document create, update, replace and remove functions
+// refill the in-memory index caches (e.g. of edge indexes) affected by the operation.
+// You can pass a single (optional) boolean. If that is set to false, you explicitly ask to not refill the caches.
+// Available from 3.10 arangod version.
+func WithRefillIndexCaches(parent context.Context, value ...bool) context.Context {
+	v := true
+	if len(value) == 1 {
+		v = value[0]
+	}
+	return context.WithValue(contextOrBackground(parent), keyRefillIndexCaches, v)
+}
+
 // WithSilent is used to configure a context to make functions return an empty result (silent==true),
 // instead of a metadata result (silent==false, default).
 // You can pass a single (optional) boolean. If that is set to false, you explicitly ask to return metadata result.
+// Multi-document functions still return the errors of the individual documents (at their index in the errors slice),
+// only the metadata slice is empty.
 func WithSilent(parent context.Context, value ...bool) context.Context {
 	v := true
 	if len(value) == 1 {
@@ -170,6 +206,36 @@ func WithResponse(parent context.Context, value *Response) context.Context {
 	return context.WithValue(contextOrBackground(parent), keyResponse, value)
 }
 
+// WithStreamingResponse is used to configure a context that will make the connection decode JSON response
+// bodies directly from the network stream, instead of buffering the entire body first.
+// This reduces peak memory usage for large responses such as cursor batches.
+// It is ignored when used together with WithRawResponse and by connections that do not support it.
+// Cursors and document imports use streaming by default. Pass false to explicitly disable it.
+func WithStreamingResponse(parent context.Context, value ...bool) context.Context {
+	v := true
+	if len(value) == 1 {
+		v = value[0]
+	}
+	return context.WithValue(contextOrBackground(parent), keyStreamingResponse, v)
+}
+
+// withRetrySafe marks the requests performed with the returned context as safe (or unsafe) to repeat
+// after they have been written, overriding the default that is derived from the request method.
+// Requests that move server-side state forward (e.g. fetching the next batch of a cursor) must be marked unsafe.
+func withRetrySafe(parent context.Context, safe bool) context.Context {
+	return context.WithValue(contextOrBackground(parent), keyRetrySafe, safe)
+}
+
+// withStreamingResponse enables streaming responses in the given context, unless
+// the caller has explicitly configured it.
+func withStreamingResponse(parent context.Context) context.Context {
+	parent = contextOrBackground(parent)
+	if parent.Value(keyStreamingResponse) != nil {
+		return parent
+	}
+	return WithStreamingResponse(parent)
+}
+
 // WithImportDetails is used to configure a context that will make import document requests return
 // details about documents that could not be imported.
 func WithImportDetails(parent context.Context, value *[]string) context.Context {
@@ -230,6 +296,12 @@ func WithDBServerID(parent context.Context, id string) context.Context {
 	return context.WithValue(contextOrBackground(parent), keyDBServerID, id)
 }
 
+// WithServerID is used to configure a context that forwards a request (e.g. of ClientLog) from a coordinator
+// to the server with the given ID within a cluster.
+func WithServerID(parent context.Context, id string) context.Context {
+	return context.WithValue(contextOrBackground(parent), keyServerID, id)
+}
+
 // WithBatchID is used to configure a context that includes an ID of a Batch.
 // This is used in replication functions.
 func WithBatchID(parent context.Context, id string) context.Context {
@@ -243,7 +315,41 @@ func WithJobIDResponse(parent context.Context, jobID *string) context.Context {
 	return context.WithValue(contextOrBackground(parent), keyJobIDResponse, jobID)
 }
 
-// WithTransactionID is used to bind a request to a specific transaction
+// WithAsync is used to configure a context that makes the server execute requests asynchronously.
+// Such requests return an AsyncJobInProgressError containing the id of the job (see IsAsyncJobInProgress).
+// The result of the job is fetched by performing the same request with a context configured using WithAsyncID.
+// This is only supported by clients created using NewClient.
+func WithAsync(parent context.Context) context.Context {
+	return context.WithValue(contextOrBackground(parent), keyAsync, true)
+}
+
+// WithAsyncID is used to configure a context that fetches the result of the async job with the given id
+// instead of performing the request again.
+// If the job has not finished yet, an AsyncJobInProgressError is returned.
+func WithAsyncID(parent context.Context, jobID string) context.Context {
+	return context.WithValue(contextOrBackground(parent), keyAsyncID, jobID)
+}
+
+// WithProjection is used to configure a context to make ReadDocument only fetch the given top-level attributes
+// of the document (and its _key, _id and _rev), instead of the entire document.
+// The document is then read using an AQL query that returns KEEP(doc, attributes).
+// A revision configured using WithRevision is checked, returning a PreconditionFailed error when it does not match.
+func WithProjection(parent context.Context, attributes ...string) context.Context {
+	return context.WithValue(contextOrBackground(parent), keyProjection, attributes)
+}
+
+// projectionFromContext returns the attributes configured using WithProjection.
+func projectionFromContext(ctx context.Context) ([]string, bool) {
+	if ctx == nil {
+		return nil, false
+	}
+	attributes, ok := ctx.Value(keyProjection).([]string)
+	return attributes, ok
+}
+
+// WithTransactionID is used to bind a request to a specific transaction.
+// In a cluster, the request is sent to the coordinator that began the transaction,
+// unless an endpoint is configured using WithEndpoint.
 func WithTransactionID(parent context.Context, tid TransactionID) context.Context {
 	return context.WithValue(contextOrBackground(parent), keyTransactionID, tid)
 }
@@ -258,6 +364,39 @@ func WithOverwrite(parent context.Context) context.Context {
 	return context.WithValue(contextOrBackground(parent), keyOverwrite, true)
 }
 
+// WithIndexStats is used to configure a context to make Collection.Indexes return
+// statistics (see Index.Figures) of every index.
+// You can pass a single (optional) boolean. If that is set to false, you explicitly ask to not return statistics.
+func WithIndexStats(parent context.Context, value ...bool) context.Context {
+	v := true
+	if len(value) == 1 {
+		v = value[0]
+	}
+	return context.WithValue(contextOrBackground(parent), keyIndexStats, v)
+}
+
+// WithCompact is used to configure a context to make Collection.Truncate compact the underlying storage
+// after removing all documents.
+// You can pass a single (optional) boolean. If that is set to false, you explicitly ask to not compact.
+func WithCompact(parent context.Context, value ...bool) context.Context {
+	v := true
+	if len(value) == 1 {
+		v = value[0]
+	}
+	return context.WithValue(contextOrBackground(parent), keyCompact, v)
+}
+
+// WithDropCollections is used to configure a context to make Graph.Remove and Graph.RemoveEdgeCollection
+// also drop the affected collections, as long as they are not used in other graphs.
+// You can pass a single (optional) boolean. If that is set to false, you explicitly ask to keep the collections.
+func WithDropCollections(parent context.Context, value ...bool) context.Context {
+	v := true
+	if len(value) == 1 {
+		v = value[0]
+	}
+	return context.WithValue(contextOrBackground(parent), keyDropCollections, v)
+}
+
 type contextSettings struct {
 	Silent                   bool
 	WaitForSync              bool
@@ -286,14 +425,17 @@ type contextSettings struct {
 func loadContextResponseValues(cs contextSettings, resp Response) {
 	// Parse potential dirty read
 	if cs.DirtyReadFlag != nil {
-		if dirtyRead := resp.Header("X-Arango-Potential-Dirty-Read"); dirtyRead != "" {
-			*cs.DirtyReadFlag = true // The documentation does not say anything about the actual value (dirtyRead == "true")
-		} else {
-			*cs.DirtyReadFlag = false
-		}
+		*cs.DirtyReadFlag = isPotentialDirtyRead(resp)
 	}
 }
 
+// isPotentialDirtyRead returns true when the given response was served by a follower
+// and may therefore contain a dirty read.
+func isPotentialDirtyRead(resp Response) bool {
+	// The documentation does not say anything about the actual value (dirtyRead == "true")
+	return resp.Header("X-Arango-Potential-Dirty-Read") != ""
+}
+
 // setDirtyReadFlagIfRequired is a helper function that sets the bool reference for allowDirtyReads to the
 // specified value, if required and reference is not nil.
 func setDirtyReadFlagIfRequired(ctx context.Context, wasDirty bool) {
@@ -317,6 +459,24 @@ func applyContextSettings(ctx context.Context, req Request) contextSettings {
 			req.SetQuery("details", strconv.FormatBool(details))
 		}
 	}
+	// IndexStats
+	if v := ctx.Value(keyIndexStats); v != nil {
+		if withStats, ok := v.(bool); ok {
+			req.SetQuery("withStats", strconv.FormatBool(withStats))
+		}
+	}
+	// Compact
+	if v := ctx.Value(keyCompact); v != nil {
+		if compact, ok := v.(bool); ok {
+			req.SetQuery("compact", strconv.FormatBool(compact))
+		}
+	}
+	// DropCollections
+	if v := ctx.Value(keyDropCollections); v != nil {
+		if dropCollections, ok := v.(bool); ok {
+			req.SetQuery("dropCollections", strconv.FormatBool(dropCollections))
+		}
+	}
 	// KeepNull
 	if v := ctx.Value(keyKeepNull); v != nil {
 		if keepNull, ok := v.(bool); ok {
@@ -329,6 +489,12 @@ func applyContextSettings(ctx context.Context, req Request) contextSettings {
 			req.SetQuery("mergeObjects", strconv.FormatBool(mergeObjects))
 		}
 	}
+	// RefillIndexCaches
+	if v := ctx.Value(keyRefillIndexCaches); v != nil {
+		if refillIndexCaches, ok := v.(bool); ok {
+			req.SetQuery("refillIndexCaches", strconv.FormatBool(refillIndexCaches))
+		}
+	}
 	// Silent
 	if v := ctx.Value(keySilent); v != nil {
 		if silent, ok := v.(bool); ok {
@@ -434,6 +600,12 @@ func applyContextSettings(ctx context.Context, req Request) contextSettings {
 			result.DBServerID = id
 		}
 	}
+	// ServerID
+	if v := ctx.Value(keyServerID); v != nil {
+		if id, ok := v.(string); ok {
+			req.SetQuery("serverId", id)
+		}
+	}
 	// BatchID
 	if v := ctx.Value(keyBatchID); v != nil {
 		if id, ok := v.(string); ok {
@@ -47,6 +47,47 @@ type QueryStatistics interface {
 	FullCount() int64
 	// Execution time of the query (wall-clock time). value will be set from the outside
 	ExecutionTime() time.Duration
+	// The maximum memory usage of the query while it was running (in bytes).
+	// Available from 3.8 arangod version.
+	PeakMemoryUsage() int64
+	// The number of intermediate commits performed by the query.
+	// Available from 3.8 arangod version.
+	IntermediateCommits() int64
+	// The runtime statistics of every execution node.
+	// Only available when the query was profiled with `WithQueryProfile(ctx, 2)`.
+	Nodes() []QueryNodeStatistics
+}
+
+// QueryWarning is a warning raised during the execution of a query.
+type QueryWarning struct {
+	// Code is the error number of the warning.
+	Code int `json:"code"`
+	// Message is the description of the warning.
+	Message string `json:"message"`
+}
+
+// CursorExtra contains additional information about the query that created a cursor.
+type CursorExtra interface {
+	// GetStatistics returns the query execution statistics.
+	GetStatistics() QueryStatistics
+	// GetWarnings returns the warnings raised during the execution of the query.
+	GetWarnings() []QueryWarning
+	// GetProfile returns the duration of every execution phase of the query.
+	// Only available when the query was profiled with `WithQueryProfile`, nil otherwise.
+	GetProfile() QueryProfile
+	// GetPlan returns the execution plan of the query.
+	// Only available when the query was profiled with `WithQueryProfile(ctx, 2)`, nil otherwise.
+	GetPlan() *ExplainQueryPlan
+}
+
+// CursorDocument is a single document received from Cursor.Documents.
+type CursorDocument struct {
+	// Err is set when fetching the documents from the server failed.
+	// No more documents are received after an error.
+	Err error
+
+	raw    *RawObject
+	cursor *cursor
 }
 
 // Cursor is returned from a query, used to iterate over a list of documents.
@@ -64,13 +105,46 @@ type Cursor interface {
 	//       then the returned DocumentMeta will be empty.
 	ReadDocument(ctx context.Context, result interface{}) (DocumentMeta, error)
 
+	// RetryReadDocument reads the document of the last ReadDocument call once more.
+	// If that call failed while fetching the next batch from the server (e.g. because the connection dropped),
+	// the batch is requested again using its batch ID, so no documents are lost.
+	// Otherwise the previously read document is returned again.
+	// The cursor must have been created with a context that was prepared with `WithQueryAllowRetry`,
+	// otherwise an InvalidArgumentError is returned.
+	RetryReadDocument(ctx context.Context, result interface{}) (DocumentMeta, error)
+
+	// ReadAll reads all remaining documents from the cursor into the slice pointed to by result
+	// (e.g. `*[]MyDocument`), fetching further batches from the server as needed.
+	// The documents are appended to the slice. Once all documents have been read, or reading fails,
+	// the cursor is closed.
+	ReadAll(ctx context.Context, result interface{}) error
+
+	// Documents returns a channel that receives all remaining documents of the cursor.
+	// While the documents of a batch are being consumed, the next batch is fetched from the server in the background.
+	// If fetching a batch fails, a CursorDocument with Err set is sent and the channel is closed.
+	// The channel is also closed when the given context is canceled or the cursor is closed,
+	// so abandoning the channel and closing the cursor does not leak the background goroutine.
+	// ReadDocument, RetryReadDocument and ReadAll must not be used while the channel is open.
+	// Once it is closed because the context was canceled, reading can be continued with these functions
+	// or another Documents call; a batch that was already fetched in the background is not lost.
+	// The cursor must still be closed by the caller.
+	Documents(ctx context.Context) <-chan CursorDocument
+
 	// Count returns the total number of result documents available.
 	// A valid return value is only available when the cursor has been created with a context that was
 	// prepared with `WithQueryCount` and not with `WithQueryStream`.
 	Count() int64
 
+	// Cached returns true if the result of the query was served from the query results cache.
+	// See `WithQueryCache`.
+	Cached() bool
+
 	// Statistics returns the query execution statistics for this cursor.
 	// This might not be valid if the cursor has been created with a context that was
 	// prepared with `WithQueryStream`
 	Statistics() QueryStatistics
+
+	// Extra returns additional information about the query, such as statistics and warnings.
+	// Like Statistics, this is updated with every batch that is fetched from the server.
+	Extra() CursorExtra
 }
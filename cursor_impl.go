@@ -25,6 +25,7 @@ package driver
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"path"
 	"reflect"
 	"sync"
@@ -33,16 +34,20 @@ import (
 )
 
 // newCursor creates a new Cursor implementation.
-func newCursor(data cursorData, endpoint string, db *database, allowDirtyReads bool) (Cursor, error) {
+// wasDirtyRead specifies whether the first batch (in data) was a potential dirty read.
+func newCursor(data cursorData, endpoint string, db *database, allowDirtyReads, wasDirtyRead, allowRetry bool) (Cursor, error) {
 	if db == nil {
 		return nil, WithStack(InvalidArgumentError{Message: "db is nil"})
 	}
 	return &cursor{
-		cursorData:      data,
-		endpoint:        endpoint,
-		db:              db,
-		conn:            db.conn,
-		allowDirtyReads: allowDirtyReads,
+		cursorData:       data,
+		endpoint:         endpoint,
+		db:               db,
+		conn:             db.conn,
+		allowDirtyReads:  allowDirtyReads,
+		lastReadWasDirty: allowDirtyReads && wasDirtyRead,
+		allowRetry:       allowRetry,
+		done:             make(chan struct{}),
 	}, nil
 }
 
@@ -56,6 +61,23 @@ type cursor struct {
 	closeMutex       sync.Mutex
 	allowDirtyReads  bool
 	lastReadWasDirty bool
+	allowRetry       bool
+	fetchFailed      bool
+	// mutex guards the cursor state (cursorData, resultIndex, fetchFailed & lastReadWasDirty),
+	// which is updated in the background by Documents.
+	mutex sync.Mutex
+	// done is closed when the cursor is closed, stopping Documents.
+	done     chan struct{}
+	doneOnce sync.Once
+	// prefetch receives the next batch requested by a Documents call that stopped before using it.
+	prefetch chan cursorFetch
+}
+
+// cursorFetch is the result of fetching the next batch of a cursor.
+type cursorFetch struct {
+	data         cursorData
+	wasDirtyRead bool
+	err          error
 }
 
 type cursorStats struct {
@@ -73,6 +95,19 @@ type cursorStats struct {
 	FullCountInt int64 `json:"fullCount,omitempty"`
 	// Query execution time (wall-clock time). value will be set from the outside
 	ExecutionTimeInt float64 `json:"executionTime,omitempty"`
+	// The maximum memory usage of the query while it was running.
+	PeakMemoryUsageInt int64 `json:"peakMemoryUsage,omitempty"`
+	// The number of intermediate commits performed by the query.
+	IntermediateCommitsInt int64 `json:"intermediateCommits,omitempty"`
+	// The runtime statistics of every execution node (only available with profile level 2).
+	NodesInt []QueryNodeStatistics `json:"nodes,omitempty"`
+}
+
+type cursorExtra struct {
+	Stats    cursorStats       `json:"stats,omitempty"`
+	Warnings []QueryWarning    `json:"warnings,omitempty"`
+	Profile  QueryProfile      `json:"profile,omitempty"`
+	Plan     *ExplainQueryPlan `json:"plan,omitempty"`
 }
 
 type cursorData struct {
@@ -80,9 +115,10 @@ type cursorData struct {
 	ID      string       `json:"id"`                // id of temporary cursor created on the server (optional, see above)
 	Result  []*RawObject `json:"result,omitempty"`  // an array of result documents (might be empty if query has no results)
 	HasMore bool         `json:"hasMore,omitempty"` // A boolean indicator whether there are more results available for the cursor on the server
-	Extra   struct {
-		Stats cursorStats `json:"stats,omitempty"`
-	} `json:"extra"`
+	Cached  bool         `json:"cached,omitempty"`  // A boolean indicator whether the query result was served from the query cache
+	// id of the next batch of the cursor (only available if the query was executed with the allowRetry option set)
+	NextBatchID string      `json:"nextBatchId,omitempty"`
+	Extra       cursorExtra `json:"extra"`
 }
 
 // relPath creates the relative path to this cursor (`_db/<db-name>/_api/cursor`)
@@ -92,6 +128,8 @@ func (c *cursor) relPath() string {
 
 // Name returns the name of the collection.
 func (c *cursor) HasMore() bool {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
 	return c.resultIndex < len(c.Result) || c.cursorData.HasMore
 }
 
@@ -99,9 +137,43 @@ func (c *cursor) HasMore() bool {
 // A valid return value is only available when the cursor has been created with a context that was
 // prepare with `WithQueryCount`.
 func (c *cursor) Count() int64 {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
 	return c.cursorData.Count
 }
 
+// ReadAll reads all remaining documents from the cursor into the slice pointed to by result.
+// The cursor is closed afterwards, also when reading fails.
+func (c *cursor) ReadAll(ctx context.Context, result interface{}) (err error) {
+	defer func() {
+		// Free the server cursor, keeping the first error
+		if cerr := c.Close(); cerr != nil && err == nil {
+			err = WithStack(cerr)
+		}
+	}()
+	resultVal := reflect.ValueOf(result)
+	if resultVal.Kind() != reflect.Ptr || resultVal.IsNil() || resultVal.Elem().Kind() != reflect.Slice {
+		return WithStack(InvalidArgumentError{Message: fmt.Sprintf("result must be a pointer to a slice, got %T", result)})
+	}
+	sliceVal := resultVal.Elem()
+	elemType := sliceVal.Type().Elem()
+	for c.HasMore() {
+		elem := reflect.New(elemType)
+		if _, err := c.ReadDocument(ctx, elem.Interface()); err != nil {
+			return WithStack(err)
+		}
+		sliceVal.Set(reflect.Append(sliceVal, elem.Elem()))
+	}
+	return nil
+}
+
+// Cached returns true if the result of the query was served from the query results cache.
+func (c *cursor) Cached() bool {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.cursorData.Cached
+}
+
 // Close deletes the cursor and frees the resources associated with it.
 func (c *cursor) Close() error {
 	if c == nil {
@@ -113,12 +185,21 @@ func (c *cursor) Close() error {
 	}
 	c.closeMutex.Lock()
 	defer c.closeMutex.Unlock()
+	// Stop Documents (if running)
+	c.doneOnce.Do(func() {
+		if c.done != nil {
+			close(c.done)
+		}
+	})
 	if c.closed == 0 {
-		if c.cursorData.ID != "" {
+		c.mutex.Lock()
+		id := c.cursorData.ID
+		c.mutex.Unlock()
+		if id != "" {
 			// Force use of initial endpoint
 			ctx := WithEndpoint(nil, c.endpoint)
 
-			req, err := c.conn.NewRequest("DELETE", path.Join(c.relPath(), c.cursorData.ID))
+			req, err := c.conn.NewRequest("DELETE", path.Join(c.relPath(), id))
 			if err != nil {
 				return WithStack(err)
 			}
@@ -139,54 +220,83 @@ func (c *cursor) Close() error {
 // The document data is stored into result, the document meta data is returned.
 // If the cursor has no more documents, a NoMoreDocuments error is returned.
 func (c *cursor) ReadDocument(ctx context.Context, result interface{}) (DocumentMeta, error) {
+	return c.readDocument(ctx, result)
+}
+
+// RetryReadDocument reads the document of the last ReadDocument call once more.
+// If that call failed while fetching the next batch, the batch is requested again.
+func (c *cursor) RetryReadDocument(ctx context.Context, result interface{}) (DocumentMeta, error) {
+	if !c.allowRetry {
+		return DocumentMeta{}, WithStack(InvalidArgumentError{Message: "cursor was not created with allowRetry"})
+	}
+	c.mutex.Lock()
+	if !c.fetchFailed && c.resultIndex > 0 {
+		c.resultIndex--
+	}
+	c.mutex.Unlock()
+	return c.readDocument(ctx, result)
+}
+
+// readDocument reads the next document from the cursor, fetching the next batch when needed.
+func (c *cursor) readDocument(ctx context.Context, result interface{}) (DocumentMeta, error) {
 	// Force use of initial endpoint
 	ctx = WithEndpoint(ctx, c.endpoint)
 
-	if c.resultIndex >= len(c.Result) && c.cursorData.HasMore {
-		// This is required since we are interested if this was a dirty read
-		// but we do not want to trash the users bool reference.
-		var wasDirtyRead bool
-		fetchctx := ctx
-		if c.allowDirtyReads {
-			fetchctx = WithAllowDirtyReads(ctx, &wasDirtyRead)
-		}
+	c.mutex.Lock()
+	fetch := c.resultIndex >= len(c.Result) && c.cursorData.HasMore
+	c.mutex.Unlock()
+	if fetch {
+		var result cursorFetch
+		c.mutex.Lock()
+		pending := c.prefetch
+		c.prefetch = nil
+		c.mutex.Unlock()
+		if pending != nil {
+			// Use the batch prefetched by Documents, the server cursor has already moved past it.
+			select {
+			case result = <-pending:
+			case <-ctx.Done():
+				c.mutex.Lock()
+				c.prefetch = pending
+				c.mutex.Unlock()
+				return DocumentMeta{}, WithStack(ctx.Err())
+			}
+		} else {
+			// This is required since we are interested if this was a dirty read
+			// but we do not want to trash the users bool reference.
+			fetchctx := ctx
+			if c.allowDirtyReads {
+				fetchctx = WithAllowDirtyReads(ctx, &result.wasDirtyRead)
+			}
 
-		// Fetch next batch
-		req, err := c.conn.NewRequest("PUT", path.Join(c.relPath(), c.cursorData.ID))
-		if err != nil {
-			return DocumentMeta{}, WithStack(err)
-		}
-		cs := applyContextSettings(fetchctx, req)
-		resp, err := c.conn.Do(fetchctx, req)
-		if err != nil {
-			return DocumentMeta{}, WithStack(err)
-		}
-		if err := resp.CheckStatus(200); err != nil {
-			return DocumentMeta{}, WithStack(err)
+			// Fetch next batch
+			result.data, result.err = c.fetchNextBatch(fetchctx)
 		}
-		loadContextResponseValues(cs, resp)
-		var data cursorData
-		if err := resp.ParseBody("", &data); err != nil {
+		if err := c.applyFetch(result); err != nil {
 			return DocumentMeta{}, WithStack(err)
 		}
-		c.cursorData = data
-		c.resultIndex = 0
-		c.lastReadWasDirty = wasDirtyRead
 	}
 	// ReadDocument should act as if it would actually do a read
 	// hence update the bool reference
+	c.mutex.Lock()
 	if c.allowDirtyReads {
 		setDirtyReadFlagIfRequired(ctx, c.lastReadWasDirty)
 	}
-
 	index := c.resultIndex
 	if index >= len(c.Result) {
 		// Out of data
+		c.mutex.Unlock()
 		return DocumentMeta{}, WithStack(NoMoreDocumentsError{})
 	}
 	c.resultIndex++
+	raw := c.Result[index]
+	c.mutex.Unlock()
+	return c.decodeResult(raw, result)
+}
+
+// decodeResult decodes a single result document into result and returns its metadata.
+func (c *cursor) decodeResult(resultPtr *RawObject, result interface{}) (DocumentMeta, error) {
 	var meta DocumentMeta
-	resultPtr := c.Result[index]
 	if resultPtr == nil {
 		// Got NULL result
 		rv := reflect.ValueOf(result)
@@ -207,13 +317,181 @@ func (c *cursor) ReadDocument(ctx context.Context, result interface{}) (Document
 	return meta, nil
 }
 
+// Documents returns a channel that receives all remaining documents of the cursor,
+// prefetching the next batch in the background.
+// The background goroutine stops when the given context is canceled or the cursor is closed.
+// A batch that was prefetched but not yet delivered is kept, so reading can be continued later.
+func (c *cursor) Documents(ctx context.Context) <-chan CursorDocument {
+	ctx = contextOrBackground(ctx)
+	out := make(chan CursorDocument)
+	go func() {
+		defer close(out)
+		// Force use of initial endpoint
+		ctx := WithEndpoint(ctx, c.endpoint)
+		var next chan cursorFetch
+		defer func() {
+			if next != nil {
+				// Keep the pending batch for the next read
+				c.mutex.Lock()
+				c.prefetch = next
+				c.mutex.Unlock()
+			}
+		}()
+		for {
+			c.mutex.Lock()
+			results := c.Result[c.resultIndex:]
+			hasMore := c.cursorData.HasMore
+			next = c.prefetch
+			c.prefetch = nil
+			c.mutex.Unlock()
+			if hasMore && next == nil {
+				// Fetch next batch while the current one is being consumed
+				next = make(chan cursorFetch, 1)
+				go func(next chan cursorFetch) {
+					var result cursorFetch
+					fetchctx := ctx
+					if c.allowDirtyReads {
+						fetchctx = WithAllowDirtyReads(ctx, &result.wasDirtyRead)
+					}
+					result.data, result.err = c.fetchNextBatch(fetchctx)
+					next <- result
+				}(next)
+			}
+			for _, raw := range results {
+				select {
+				case out <- CursorDocument{raw: raw, cursor: c}:
+					c.mutex.Lock()
+					c.resultIndex++
+					c.mutex.Unlock()
+				case <-ctx.Done():
+					return
+				case <-c.done:
+					return
+				}
+			}
+			if next == nil {
+				return
+			}
+			var result cursorFetch
+			select {
+			case result = <-next:
+				next = nil
+			case <-ctx.Done():
+				return
+			case <-c.done:
+				return
+			}
+			if err := c.applyFetch(result); err != nil {
+				select {
+				case out <- CursorDocument{Err: WithStack(err)}:
+				case <-ctx.Done():
+				case <-c.done:
+				}
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// applyFetch makes the fetched batch the current batch of the cursor.
+// If fetching failed, the error is returned and the cursor state is left unchanged.
+func (c *cursor) applyFetch(result cursorFetch) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if result.err != nil {
+		c.fetchFailed = true
+		return result.err
+	}
+	c.fetchFailed = false
+	c.cursorData = result.data
+	c.resultIndex = 0
+	c.lastReadWasDirty = result.wasDirtyRead
+	return nil
+}
+
+// Read decodes the document into result and returns its metadata.
+// If Err is set, it is returned.
+func (d CursorDocument) Read(result interface{}) (DocumentMeta, error) {
+	if d.Err != nil {
+		return DocumentMeta{}, d.Err
+	}
+	if d.cursor == nil {
+		return DocumentMeta{}, WithStack(NoMoreDocumentsError{})
+	}
+	return d.cursor.decodeResult(d.raw, result)
+}
+
+// fetchNextBatch requests the next batch of the cursor from the server.
+// When the cursor was created with allowRetry, the batch is requested by its ID,
+// which makes it safe to request it again when a previous attempt failed.
+func (c *cursor) fetchNextBatch(ctx context.Context) (cursorData, error) {
+	c.mutex.Lock()
+	id, nextBatchID := c.cursorData.ID, c.cursorData.NextBatchID
+	c.mutex.Unlock()
+	method, p := "PUT", path.Join(c.relPath(), id)
+	if nextBatchID != "" {
+		method, p = "POST", path.Join(p, nextBatchID)
+	}
+	req, err := c.conn.NewRequest(method, p)
+	if err != nil {
+		return cursorData{}, WithStack(err)
+	}
+	cs := applyContextSettings(ctx, req)
+	// Reading the next batch advances the cursor, so a written request must not be repeated
+	// (that would skip a batch), unless the batch is explicitly identified by its ID.
+	ctx = withRetrySafe(ctx, nextBatchID != "")
+	resp, err := c.conn.Do(withStreamingResponse(ctx), req)
+	if err != nil {
+		return cursorData{}, WithStack(err)
+	}
+	if err := resp.CheckStatus(200); err != nil {
+		return cursorData{}, WithStack(err)
+	}
+	loadContextResponseValues(cs, resp)
+	var data cursorData
+	if err := resp.ParseBody("", &data); err != nil {
+		return cursorData{}, WithStack(err)
+	}
+	return data, nil
+}
+
 // Return execution statistics for this cursor. This might not
 // be valid if the cursor has been created with a context that was
 // prepared with `WithStream`
 func (c *cursor) Statistics() QueryStatistics {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
 	return c.cursorData.Extra.Stats
 }
 
+// Extra returns additional information about the query, such as statistics and warnings.
+func (c *cursor) Extra() CursorExtra {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.cursorData.Extra
+}
+
+// GetStatistics returns the query execution statistics.
+func (ce cursorExtra) GetStatistics() QueryStatistics {
+	return ce.Stats
+}
+
+// GetWarnings returns the warnings raised during the execution of the query.
+func (ce cursorExtra) GetWarnings() []QueryWarning {
+	return ce.Warnings
+}
+
+// GetProfile returns the duration of every execution phase of the query.
+func (ce cursorExtra) GetProfile() QueryProfile {
+	return ce.Profile
+}
+
+// GetPlan returns the execution plan of the query.
+func (ce cursorExtra) GetPlan() *ExplainQueryPlan {
+	return ce.Plan
+}
+
 // the total number of data-modification operations successfully executed.
 func (cs cursorStats) WritesExecuted() int64 {
 	return cs.WritesExecutedInt
@@ -249,5 +527,20 @@ func (cs cursorStats) FullCount() int64 {
 
 // query execution time (wall-clock time). value will be set from the outside
 func (cs cursorStats) ExecutionTime() time.Duration {
-	return time.Duration(cs.ExecutionTimeInt) * time.Second
+	return time.Duration(cs.ExecutionTimeInt * float64(time.Second))
+}
+
+// The maximum memory usage of the query while it was running (in bytes).
+func (cs cursorStats) PeakMemoryUsage() int64 {
+	return cs.PeakMemoryUsageInt
+}
+
+// The number of intermediate commits performed by the query.
+func (cs cursorStats) IntermediateCommits() int64 {
+	return cs.IntermediateCommitsInt
+}
+
+// The runtime statistics of every execution node.
+func (cs cursorStats) Nodes() []QueryNodeStatistics {
+	return cs.NodesInt
 }
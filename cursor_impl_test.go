@@ -0,0 +1,254 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package driver
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestCursorStatsExecutionTime(t *testing.T) {
+	tests := map[float64]time.Duration{ // Input : Expected-Output
+		0:      0,
+		0.0025: 2500 * time.Microsecond,
+		1.5:    1500 * time.Millisecond,
+		3:      3 * time.Second,
+	}
+	for input, expected := range tests {
+		stats := cursorStats{ExecutionTimeInt: input}
+		if result := stats.ExecutionTime(); result != expected {
+			t.Errorf("Unexpected result for %v; got %s, expected %s", input, result, expected)
+		}
+	}
+}
+
+func TestCursorInitialDirtyRead(t *testing.T) {
+	for _, wasDirtyRead := range []bool{true, false} {
+		c, err := newCursor(cursorData{Result: []*RawObject{nil}}, "", &database{}, true, wasDirtyRead, false)
+		if err != nil {
+			t.Fatalf("Expected success, got %s", err)
+		}
+		dirty := !wasDirtyRead
+		var doc interface{}
+		if _, err := c.ReadDocument(WithAllowDirtyReads(nil, &dirty), &doc); err != nil {
+			t.Fatalf("Expected success, got %s", err)
+		}
+		if dirty != wasDirtyRead {
+			t.Errorf("Unexpected dirty read flag; got %v, expected %v", dirty, wasDirtyRead)
+		}
+	}
+}
+
+type testCursorResponse struct {
+	Response
+	data  cursorData
+	dirty bool
+}
+
+func (r *testCursorResponse) CheckStatus(validStatusCodes ...int) error { return nil }
+
+func (r *testCursorResponse) Header(key string) string {
+	if r.dirty && key == "X-Arango-Potential-Dirty-Read" {
+		return "true"
+	}
+	return ""
+}
+
+func (r *testCursorResponse) ParseBody(field string, result interface{}) error {
+	*result.(*cursorData) = r.data
+	return nil
+}
+
+type testCursorConnection struct {
+	Connection
+	mutex   sync.Mutex
+	batches []cursorData
+	deletes int
+}
+
+type testCursorRequest struct {
+	testRetryRequest
+}
+
+func (r *testCursorRequest) SetHeader(key, value string) Request { return r }
+
+func (c *testCursorConnection) NewRequest(method, path string) (Request, error) {
+	return &testCursorRequest{testRetryRequest{method: method}}, nil
+}
+
+func (c *testCursorConnection) Unmarshal(data RawObject, result interface{}) error {
+	return json.Unmarshal(data, result)
+}
+
+func (c *testCursorConnection) Do(ctx context.Context, req Request) (Response, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if req.Method() == "DELETE" {
+		c.deletes++
+		return &testCursorResponse{}, nil
+	}
+	if len(c.batches) == 0 {
+		// Endless cursor
+		return &testCursorResponse{data: cursorData{ID: "1", HasMore: true, Result: []*RawObject{nil}}}, nil
+	}
+	data := c.batches[0]
+	c.batches = c.batches[1:]
+	return &testCursorResponse{data: data, dirty: true}, nil
+}
+
+func TestCursorDocumentsAbandoned(t *testing.T) {
+	goroutines := runtime.NumGoroutine()
+	conn := &testCursorConnection{}
+	c, err := newCursor(cursorData{ID: "1", HasMore: true, Result: []*RawObject{nil, nil}}, "", &database{conn: conn}, false, false, false)
+	if err != nil {
+		t.Fatalf("Expected success, got %s", err)
+	}
+	docs := c.Documents(context.Background())
+	if doc := <-docs; doc.Err != nil {
+		t.Fatalf("Expected success, got %s", doc.Err)
+	}
+	// Cursor state may be read while the documents are fetched in the background
+	for i := 0; i < 10; i++ {
+		c.HasMore()
+		c.Count()
+	}
+	// Abandon the channel
+	if err := c.Close(); err != nil {
+		t.Fatalf("Expected success, got %s", err)
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for runtime.NumGoroutine() > goroutines {
+		if time.Now().After(deadline) {
+			t.Fatalf("Expected Documents goroutine to stop after Close, got %d goroutines, expected %d", runtime.NumGoroutine(), goroutines)
+		}
+		time.Sleep(time.Millisecond)
+	}
+	if conn.deletes != 1 {
+		t.Errorf("Expected cursor to be deleted once, got %d", conn.deletes)
+	}
+}
+
+func TestCursorDocumentsDirtyRead(t *testing.T) {
+	conn := &testCursorConnection{batches: []cursorData{{ID: "1", Result: []*RawObject{nil}}}}
+	c, err := newCursor(cursorData{ID: "1", HasMore: true, Result: []*RawObject{nil}}, "", &database{conn: conn}, true, false, false)
+	if err != nil {
+		t.Fatalf("Expected success, got %s", err)
+	}
+	count := 0
+	for doc := range c.Documents(context.Background()) {
+		if doc.Err != nil {
+			t.Fatalf("Expected success, got %s", doc.Err)
+		}
+		count++
+	}
+	if count != 2 {
+		t.Errorf("Expected 2 documents, got %d", count)
+	}
+	var dirty bool
+	var doc interface{}
+	if _, err := c.ReadDocument(WithAllowDirtyReads(nil, &dirty), &doc); !IsNoMoreDocuments(err) {
+		t.Fatalf("Expected NoMoreDocumentsError, got %v", err)
+	}
+	if !dirty {
+		t.Error("Expected last batch fetched by Documents to be reported as a dirty read")
+	}
+}
+
+func TestCursorDocumentsCanceledPrefetch(t *testing.T) {
+	raw := func(values ...string) []*RawObject {
+		result := make([]*RawObject, len(values))
+		for i, v := range values {
+			r := RawObject(v)
+			result[i] = &r
+		}
+		return result
+	}
+	conn := &testCursorConnection{batches: []cursorData{
+		{ID: "1", HasMore: true, Result: raw("3", "4")},
+		{ID: "1", Result: raw("5")},
+	}}
+	c, err := newCursor(cursorData{ID: "1", HasMore: true, Result: raw("1", "2")}, "", &database{conn: conn}, false, false, false)
+	if err != nil {
+		t.Fatalf("Expected success, got %s", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	var values []int
+	docs := c.Documents(ctx)
+	doc := <-docs
+	var v int
+	if _, err := doc.Read(&v); err != nil {
+		t.Fatalf("Expected success, got %s", err)
+	}
+	values = append(values, v)
+	// Wait until the next batch has been prefetched, then stop consuming
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		conn.mutex.Lock()
+		fetched := len(conn.batches) == 1
+		conn.mutex.Unlock()
+		if fetched {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("Expected next batch to be prefetched")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	cancel()
+	for doc := range docs {
+		if _, err := doc.Read(&v); err != nil {
+			t.Fatalf("Expected success, got %s", err)
+		}
+		values = append(values, v)
+	}
+
+	// Continue reading, the prefetched batch must not be skipped
+	for c.HasMore() {
+		if _, err := c.ReadDocument(context.Background(), &v); err != nil {
+			t.Fatalf("Expected success, got %s", err)
+		}
+		values = append(values, v)
+	}
+	if expected := []int{1, 2, 3, 4, 5}; !reflect.DeepEqual(values, expected) {
+		t.Errorf("Expected %v, got %v", expected, values)
+	}
+}
+
+func TestCursorReadAllClosesOnError(t *testing.T) {
+	r := RawObject(`"not a number"`)
+	conn := &testCursorConnection{}
+	c, err := newCursor(cursorData{ID: "1", HasMore: true, Result: []*RawObject{&r}}, "", &database{conn: conn}, false, false, false)
+	if err != nil {
+		t.Fatalf("Expected success, got %s", err)
+	}
+	var result []int
+	if err := c.ReadAll(context.Background(), &result); err == nil {
+		t.Fatal("Expected error")
+	}
+	if conn.deletes != 1 {
+		t.Errorf("Expected cursor to be deleted once, got %d", conn.deletes)
+	}
+}
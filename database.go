@@ -58,6 +58,25 @@ type Database interface {
 	// ArangoSearch Analyzers API
 	DatabaseArangoSearchAnalyzers
 
+	// Running queries & query cache functions
+	DatabaseQueries
+
+	// User-defined AQL functions
+	DatabaseAQLFunctions
+
+	// Server-side tasks
+	DatabaseTasks
+
+	// Query, ValidateQuery & ExplainQuery
+	DatabaseQueryExecutor
+
+	// Transaction performs a javascript transaction. The result of the transaction function is returned.
+	Transaction(ctx context.Context, action string, options *TransactionOptions) (interface{}, error)
+}
+
+// DatabaseQueryExecutor provides functions to run AQL queries.
+// Code that only runs queries can depend on (and mock) this interface instead of Database.
+type DatabaseQueryExecutor interface {
 	// Query performs an AQL query, returning a cursor used to iterate over the returned documents.
 	// Note that the returned Cursor must always be closed to avoid holding on to resources in the server while they are no longer needed.
 	Query(ctx context.Context, query string, bindVars map[string]interface{}) (Cursor, error)
@@ -67,8 +86,10 @@ type Database interface {
 	// The query is not executed.
 	ValidateQuery(ctx context.Context, query string) error
 
-	// Transaction performs a javascript transaction. The result of the transaction function is returned.
-	Transaction(ctx context.Context, action string, options *TransactionOptions) (interface{}, error)
+	// ExplainQuery explains an AQL query and returns information about its execution plan(s),
+	// such as the estimated cost and the optimizer rules that were applied.
+	// The query is not executed.
+	ExplainQuery(ctx context.Context, query string, bindVars map[string]interface{}, opts *ExplainQueryOptions) (ExplainQueryResult, error)
 }
 
 // DatabaseInfo contains information about a database
@@ -81,7 +102,8 @@ type DatabaseInfo struct {
 	Path string `json:"path,omitempty"`
 	// If true then the database is the _system database.
 	IsSystem bool `json:"isSystem,omitempty"`
-	// Default replication factor for collections in database
+	// Default replication factor for collections in database.
+	// ReplicationFactorSatellite if satellite collections are the default.
 	ReplicationFactor int `json:"replicationFactor,omitempty"`
 	// Default write concern for collections in database
 	WriteConcern int `json:"writeConcern,omitempty"`
@@ -0,0 +1,65 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package driver
+
+import "context"
+
+// DatabaseAQLFunctions provides access to the user-defined AQL functions of a database.
+type DatabaseAQLFunctions interface {
+	// CreateAQLFunction registers a user-defined AQL function with given (fully qualified) name,
+	// e.g. `myfunctions::temperature::celsiustofahrenheit`, and JavaScript code.
+	// If a function with the same name already exists, it is replaced.
+	// Returns true if the function was newly created, false if it was replaced.
+	CreateAQLFunction(ctx context.Context, name, code string, options *CreateAQLFunctionOptions) (bool, error)
+
+	// AQLFunctions returns all user-defined AQL functions whose name starts with given namespace prefix.
+	// If the prefix is empty, all user-defined AQL functions are returned.
+	AQLFunctions(ctx context.Context, prefix string) ([]AQLFunction, error)
+
+	// RemoveAQLFunction removes the user-defined AQL function with given name.
+	// Returns the number of removed functions.
+	// If no function with given name exists, a NotFoundError is returned.
+	RemoveAQLFunction(ctx context.Context, name string, options *RemoveAQLFunctionOptions) (int, error)
+}
+
+// CreateAQLFunctionOptions contains options for creating a user-defined AQL function.
+type CreateAQLFunctionOptions struct {
+	// IsDeterministic indicates whether the function results are fully deterministic
+	// (function return value solely depends on the input value and return value is the same for repeated calls with same input).
+	IsDeterministic bool `json:"isDeterministic,omitempty"`
+}
+
+// RemoveAQLFunctionOptions contains options for removing user-defined AQL functions.
+type RemoveAQLFunctionOptions struct {
+	// If Group is set, the given name is treated as a namespace prefix,
+	// and all functions in the namespace will be removed.
+	Group bool
+}
+
+// AQLFunction describes a user-defined AQL function.
+type AQLFunction struct {
+	// Name is the fully qualified name of the function.
+	Name string `json:"name"`
+	// Code is the JavaScript source code of the function.
+	Code string `json:"code"`
+	// IsDeterministic indicates whether the function results are fully deterministic.
+	IsDeterministic bool `json:"isDeterministic"`
+}
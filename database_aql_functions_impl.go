@@ -0,0 +1,109 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package driver
+
+import (
+	"context"
+	"path"
+)
+
+type createAQLFunctionRequest struct {
+	Name string `json:"name"`
+	Code string `json:"code"`
+	CreateAQLFunctionOptions
+}
+
+// CreateAQLFunction registers a user-defined AQL function with given name and JavaScript code.
+func (d *database) CreateAQLFunction(ctx context.Context, name, code string, options *CreateAQLFunctionOptions) (bool, error) {
+	input := createAQLFunctionRequest{
+		Name: name,
+		Code: code,
+	}
+	if options != nil {
+		input.CreateAQLFunctionOptions = *options
+	}
+	req, err := d.conn.NewRequest("POST", path.Join(d.relPath(), "_api/aqlfunction"))
+	if err != nil {
+		return false, WithStack(err)
+	}
+	if _, err := req.SetBody(input); err != nil {
+		return false, WithStack(err)
+	}
+	applyContextSettings(ctx, req)
+	resp, err := d.conn.Do(ctx, req)
+	if err != nil {
+		return false, WithStack(err)
+	}
+	if err := resp.CheckStatus(200, 201); err != nil {
+		return false, WithStack(err)
+	}
+	return resp.StatusCode() == 201, nil
+}
+
+// AQLFunctions returns all user-defined AQL functions whose name starts with given namespace prefix.
+func (d *database) AQLFunctions(ctx context.Context, prefix string) ([]AQLFunction, error) {
+	req, err := d.conn.NewRequest("GET", path.Join(d.relPath(), "_api/aqlfunction"))
+	if err != nil {
+		return nil, WithStack(err)
+	}
+	if prefix != "" {
+		req.SetQuery("namespace", prefix)
+	}
+	applyContextSettings(ctx, req)
+	resp, err := d.conn.Do(ctx, req)
+	if err != nil {
+		return nil, WithStack(err)
+	}
+	if err := resp.CheckStatus(200); err != nil {
+		return nil, WithStack(err)
+	}
+	var data []AQLFunction
+	if err := resp.ParseBody("result", &data); err != nil {
+		return nil, WithStack(err)
+	}
+	return data, nil
+}
+
+// RemoveAQLFunction removes the user-defined AQL function with given name.
+func (d *database) RemoveAQLFunction(ctx context.Context, name string, options *RemoveAQLFunctionOptions) (int, error) {
+	req, err := d.conn.NewRequest("DELETE", path.Join(d.relPath(), "_api/aqlfunction", pathEscape(name)))
+	if err != nil {
+		return 0, WithStack(err)
+	}
+	if options != nil && options.Group {
+		req.SetQuery("group", "true")
+	}
+	applyContextSettings(ctx, req)
+	resp, err := d.conn.Do(ctx, req)
+	if err != nil {
+		return 0, WithStack(err)
+	}
+	if err := resp.CheckStatus(200); err != nil {
+		return 0, WithStack(err)
+	}
+	var data struct {
+		DeletedCount int `json:"deletedCount"`
+	}
+	if err := resp.ParseBody("", &data); err != nil {
+		return 0, WithStack(err)
+	}
+	return data.DeletedCount, nil
+}
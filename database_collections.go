@@ -51,6 +51,8 @@ type CreateCollectionOptions struct {
 	// Replication between them is synchronous, that is, every write operation to the "leader" copy will be replicated to all "follower" replicas,
 	// before the write operation is reported successful. If a server fails, this is detected automatically
 	// and one of the servers holding copies take over, usually without an error being reported.
+	// Use ReplicationFactorSatellite to create a satellite collection, which is replicated to all DBServers.
+	// Satellite collections require ArangoDB Enterprise Edition.
 	ReplicationFactor int `json:"replicationFactor,omitempty"`
 	// Deprecated: use 'WriteConcern' instead
 	MinReplicationFactor int `json:"minReplicationFactor,omitempty"`
@@ -95,7 +97,9 @@ type CreateCollectionOptions struct {
 	IndexBuckets int `json:"indexBuckets,omitempty"`
 	// Specifies how keys in the collection are created.
 	KeyOptions *CollectionKeyOptions `json:"keyOptions,omitempty"`
-	// This field is used for internal purposes only. DO NOT USE.
+	// DistributeShardsLike is the name of another collection whose sharding is used for this collection.
+	// The shards of both collections are placed on the same DBServers, which allows co-located joins.
+	// NumberOfShards, ReplicationFactor and ShardKeys are taken from the other collection.
 	DistributeShardsLike string `json:"distributeShardsLike,omitempty"`
 	// Set to create a smart edge or vertex collection.
 	// This requires ArangoDB Enterprise Edition.
@@ -115,6 +119,9 @@ type CreateCollectionOptions struct {
 	ShardingStrategy ShardingStrategy `json:"shardingStrategy,omitempty"`
 	// Schema for collection validation
 	Schema *CollectionSchemaOptions `json:"schema,omitempty"`
+	// ComputedValues lists attributes that are calculated on write.
+	// Available from 3.10 arangod version.
+	ComputedValues []ComputedValue `json:"computedValues,omitempty"`
 }
 
 // Init translate deprecated fields into current one for backward compatibility
@@ -147,7 +154,8 @@ type CollectionKeyOptions struct {
 	// If set to false, then the key generator will solely be responsible for generating keys and supplying own
 	// key values in the _key attribute of documents is considered an error.
 	AllowUserKeysPtr *bool `json:"allowUserKeys,omitempty"`
-	// Specifies the type of the key generator. The currently available generators are traditional and autoincrement.
+	// Specifies the type of the key generator. The currently available generators are traditional, autoincrement,
+	// uuid and padded.
 	Type KeyGeneratorType `json:"type,omitempty"`
 	// increment value for autoincrement key generator. Not used for other key generator types.
 	Increment int `json:"increment,omitempty"`
@@ -174,6 +182,10 @@ type KeyGeneratorType string
 const (
 	KeyGeneratorTraditional   = KeyGeneratorType("traditional")
 	KeyGeneratorAutoIncrement = KeyGeneratorType("autoincrement")
+	// KeyGeneratorUUID generates universally unique 128 bit keys.
+	KeyGeneratorUUID = KeyGeneratorType("uuid")
+	// KeyGeneratorPadded generates keys of a fixed length in ascending lexicographical order.
+	KeyGeneratorPadded = KeyGeneratorType("padded")
 )
 
 // ShardingStrategy describes the sharding strategy of a collection
@@ -0,0 +1,53 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package driver
+
+// ComputeOn is an operation on which a computed value is calculated.
+type ComputeOn string
+
+const (
+	// ComputeOnInsert computes the value on insert operations.
+	ComputeOnInsert ComputeOn = "insert"
+	// ComputeOnUpdate computes the value on update operations.
+	ComputeOnUpdate ComputeOn = "update"
+	// ComputeOnReplace computes the value on replace operations.
+	ComputeOnReplace ComputeOn = "replace"
+)
+
+// ComputedValue describes an attribute of documents that is calculated by an AQL expression on write.
+// Available from 3.10 arangod version.
+type ComputedValue struct {
+	// Name of the target attribute of the computed value.
+	Name string `json:"name"`
+	// Expression is an AQL RETURN operation with an expression that computes the value.
+	// The document is available as `@doc`.
+	Expression string `json:"expression"`
+	// Overwrite specifies whether the computed value overwrites an existing attribute value.
+	Overwrite bool `json:"overwrite"`
+	// ComputeOn lists the operations on which the value is computed.
+	// Defaults to all operations.
+	ComputeOn []ComputeOn `json:"computeOn,omitempty"`
+	// KeepNull specifies whether the target attribute is set to null if the expression evaluates to null.
+	// If false, the attribute is not set. Defaults to true.
+	KeepNull *bool `json:"keepNull,omitempty"`
+	// FailOnWarning specifies whether a write operation fails if the expression produces a warning.
+	FailOnWarning *bool `json:"failOnWarning,omitempty"`
+}
@@ -124,6 +124,7 @@ type createCollectionOptionsInternal struct {
 	SmartJoinAttribute   string                   `json:"smartJoinAttribute,omitempty"`
 	ShardingStrategy     ShardingStrategy         `json:"shardingStrategy,omitempty"`
 	Schema               *CollectionSchemaOptions `json:"schema,omitempty"`
+	ComputedValues       []ComputedValue          `json:"computedValues,omitempty"`
 }
 
 // CreateCollection creates a new collection with given name and options, and opens a connection to it.
@@ -198,6 +199,7 @@ func (p *createCollectionOptionsInternal) fromExternal(i *CreateCollectionOption
 	p.SmartJoinAttribute = i.SmartJoinAttribute
 	p.ShardingStrategy = i.ShardingStrategy
 	p.Schema = i.Schema
+	p.ComputedValues = i.ComputedValues
 }
 
 // // MarshalJSON converts CreateCollectionOptions into json
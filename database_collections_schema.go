@@ -26,21 +26,32 @@ import (
 	"encoding/json"
 )
 
+// CollectionSchemaLevel controls when documents are validated against the schema of a collection.
 type CollectionSchemaLevel string
 
 const (
-	CollectionSchemaLevelNone     CollectionSchemaLevel = "none"
-	CollectionSchemaLevelNew      CollectionSchemaLevel = "new"
+	// CollectionSchemaLevelNone disables validation.
+	CollectionSchemaLevelNone CollectionSchemaLevel = "none"
+	// CollectionSchemaLevelNew validates only newly inserted documents.
+	CollectionSchemaLevelNew CollectionSchemaLevel = "new"
+	// CollectionSchemaLevelModerate validates new and modified documents, unless the modified document was already invalid.
 	CollectionSchemaLevelModerate CollectionSchemaLevel = "moderate"
-	CollectionSchemaLevelStrict   CollectionSchemaLevel = "strict"
+	// CollectionSchemaLevelStrict validates all new and modified documents.
+	CollectionSchemaLevelStrict CollectionSchemaLevel = "strict"
 )
 
+// CollectionSchemaOptions contains the JSON Schema used to validate documents of a collection.
+// Available from 3.7 arangod version.
 type CollectionSchemaOptions struct {
-	Rule    interface{}           `json:"rule,omitempty"`
-	Level   CollectionSchemaLevel `json:"level,omitempty"`
-	Message string                `json:"message,omitempty"`
+	// Rule is the JSON Schema object documents are validated against.
+	Rule interface{} `json:"rule,omitempty"`
+	// Level controls when documents are validated.
+	Level CollectionSchemaLevel `json:"level,omitempty"`
+	// Message is the error message returned when a document does not match the schema.
+	Message string `json:"message,omitempty"`
 }
 
+// LoadRule sets the rule of the schema from the given JSON Schema document.
 func (d *CollectionSchemaOptions) LoadRule(data []byte) error {
 	var rule interface{}
 
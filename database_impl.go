@@ -24,6 +24,7 @@ package driver
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 	"path"
 )
@@ -140,18 +141,20 @@ func (d *database) Query(ctx context.Context, query string, bindVars map[string]
 		return nil, WithStack(err)
 	}
 	cs := applyContextSettings(ctx, req)
-	resp, err := d.conn.Do(ctx, req)
+	resp, err := d.conn.Do(withStreamingResponse(ctx), req)
 	if err != nil {
 		return nil, WithStack(err)
 	}
 	if err := resp.CheckStatus(201); err != nil {
 		return nil, WithStack(err)
 	}
+	// load context response values
+	loadContextResponseValues(cs, resp)
 	var data cursorData
 	if err := resp.ParseBody("", &data); err != nil {
 		return nil, WithStack(err)
 	}
-	col, err := newCursor(data, resp.Endpoint(), d, cs.AllowDirtyReads)
+	col, err := newCursor(data, resp.Endpoint(), d, cs.AllowDirtyReads, isPotentialDirtyRead(resp), input.Options.AllowRetry)
 	if err != nil {
 		return nil, WithStack(err)
 	}
@@ -182,6 +185,36 @@ func (d *database) ValidateQuery(ctx context.Context, query string) error {
 	return nil
 }
 
+// ExplainQuery explains an AQL query and returns information about its execution plan(s).
+// The query is not executed.
+func (d *database) ExplainQuery(ctx context.Context, query string, bindVars map[string]interface{}, opts *ExplainQueryOptions) (ExplainQueryResult, error) {
+	req, err := d.conn.NewRequest("POST", path.Join(d.relPath(), "_api/explain"))
+	if err != nil {
+		return ExplainQueryResult{}, WithStack(err)
+	}
+	input := explainQueryRequest{
+		Query:    query,
+		BindVars: bindVars,
+		Options:  opts,
+	}
+	if _, err := req.SetBody(input); err != nil {
+		return ExplainQueryResult{}, WithStack(err)
+	}
+	applyContextSettings(ctx, req)
+	resp, err := d.conn.Do(ctx, req)
+	if err != nil {
+		return ExplainQueryResult{}, WithStack(err)
+	}
+	if err := resp.CheckStatus(200); err != nil {
+		return ExplainQueryResult{}, WithStack(err)
+	}
+	var data ExplainQueryResult
+	if err := resp.ParseBody("", &data); err != nil {
+		return ExplainQueryResult{}, WithStack(err)
+	}
+	return data, nil
+}
+
 func (d *database) Transaction(ctx context.Context, action string, options *TransactionOptions) (interface{}, error) {
 	req, err := d.conn.NewRequest("POST", path.Join(d.relPath(), "_api/transaction"))
 	if err != nil {
@@ -216,3 +249,42 @@ func (d *database) Transaction(ctx context.Context, action string, options *Tran
 
 	return output.Result, nil
 }
+
+type databaseInfoInternal struct {
+	ID                string            `json:"id,omitempty"`
+	Name              string            `json:"name,omitempty"`
+	Path              string            `json:"path,omitempty"`
+	IsSystem          bool              `json:"isSystem,omitempty"`
+	ReplicationFactor replicationFactor `json:"replicationFactor,omitempty"`
+	WriteConcern      int               `json:"writeConcern,omitempty"`
+	Sharding          DatabaseSharding  `json:"sharding,omitempty"`
+}
+
+// MarshalJSON converts DatabaseInfo into json
+func (p *DatabaseInfo) MarshalJSON() ([]byte, error) {
+	return json.Marshal(databaseInfoInternal{
+		ID:                p.ID,
+		Name:              p.Name,
+		Path:              p.Path,
+		IsSystem:          p.IsSystem,
+		ReplicationFactor: replicationFactor(p.ReplicationFactor),
+		WriteConcern:      p.WriteConcern,
+		Sharding:          p.Sharding,
+	})
+}
+
+// UnmarshalJSON loads DatabaseInfo from json
+func (p *DatabaseInfo) UnmarshalJSON(d []byte) error {
+	var internal databaseInfoInternal
+	if err := json.Unmarshal(d, &internal); err != nil {
+		return err
+	}
+	p.ID = internal.ID
+	p.Name = internal.Name
+	p.Path = internal.Path
+	p.IsSystem = internal.IsSystem
+	p.ReplicationFactor = int(internal.ReplicationFactor)
+	p.WriteConcern = internal.WriteConcern
+	p.Sharding = internal.Sharding
+	return nil
+}
@@ -0,0 +1,56 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package driver
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestDatabaseInfoSatelliteReplicationFactor(t *testing.T) {
+	var info DatabaseInfo
+	if err := json.Unmarshal([]byte(`{"name":"db","replicationFactor":"satellite","writeConcern":1,"sharding":"single"}`), &info); err != nil {
+		t.Fatalf("Unmarshal failed: %s", err)
+	}
+	if info.ReplicationFactor != ReplicationFactorSatellite {
+		t.Errorf("Expected satellite replication factor, got %d", info.ReplicationFactor)
+	}
+	if info.Sharding != DatabaseShardingSingle {
+		t.Errorf("Expected single sharding, got %s", info.Sharding)
+	}
+}
+
+func TestCreateDatabaseOptionsJSON(t *testing.T) {
+	options := CreateDatabaseOptions{
+		Options: CreateDatabaseDefaultOptions{
+			ReplicationFactor: ReplicationFactorSatellite,
+			WriteConcern:      1,
+		},
+	}
+	data, err := json.Marshal(options)
+	if err != nil {
+		t.Fatalf("Marshal failed: %s", err)
+	}
+	expected := `{"options":{"replicationFactor":"satellite","writeConcern":1}}`
+	if string(data) != expected {
+		t.Errorf("Expected %s, got %s", expected, data)
+	}
+}
@@ -0,0 +1,105 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package driver
+
+import (
+	"context"
+	"time"
+)
+
+// DatabaseQueries provides access to the currently running and slow AQL queries of a database,
+// and to the AQL query results cache.
+type DatabaseQueries interface {
+	// ListRunningQueries returns a list of the AQL queries that are currently running in the database.
+	ListRunningQueries(ctx context.Context) ([]RunningQuery, error)
+
+	// ListSlowQueries returns a list of the last slow AQL queries of the database.
+	ListSlowQueries(ctx context.Context) ([]RunningQuery, error)
+
+	// ClearSlowQueries clears the list of slow AQL queries of the database.
+	ClearSlowQueries(ctx context.Context) error
+
+	// KillQuery kills a running AQL query with given ID.
+	// If the query does not exist (anymore), a NotFoundError is returned.
+	KillQuery(ctx context.Context, id string) error
+
+	// QueryCacheProperties returns the global properties of the AQL query results cache.
+	QueryCacheProperties(ctx context.Context) (QueryCacheProperties, error)
+
+	// SetQueryCacheProperties changes the global properties of the AQL query results cache.
+	// Only the fields that are set in props are changed. The resulting properties are returned.
+	SetQueryCacheProperties(ctx context.Context, props QueryCacheProperties) (QueryCacheProperties, error)
+
+	// ClearQueryCache clears the AQL query results cache of the database.
+	ClearQueryCache(ctx context.Context) error
+}
+
+// QueryCacheMode is the mode of operation of the AQL query results cache.
+type QueryCacheMode string
+
+const (
+	// QueryCacheModeOff disables the query results cache.
+	QueryCacheModeOff QueryCacheMode = "off"
+	// QueryCacheModeOn caches the results of all queries that are eligible for caching, unless the query
+	// was sent with `WithQueryCache(ctx, false)`.
+	QueryCacheModeOn QueryCacheMode = "on"
+	// QueryCacheModeDemand only caches the results of queries that were sent with `WithQueryCache(ctx, true)`.
+	QueryCacheModeDemand QueryCacheMode = "demand"
+)
+
+// QueryCacheProperties contains the global properties of the AQL query results cache.
+type QueryCacheProperties struct {
+	// Mode of operation of the query results cache.
+	Mode QueryCacheMode `json:"mode,omitempty"`
+	// MaxResults is the maximum number of query results that will be stored per database-specific cache.
+	MaxResults int64 `json:"maxResults,omitempty"`
+	// MaxResultsSize is the maximum cumulated size of query results that will be stored per database-specific cache (in bytes).
+	MaxResultsSize int64 `json:"maxResultsSize,omitempty"`
+	// MaxEntrySize is the maximum individual size of query results that will be stored per database-specific cache (in bytes).
+	MaxEntrySize int64 `json:"maxEntrySize,omitempty"`
+	// IncludeSystem indicates whether results of queries that involve system collections will be stored in the query results cache.
+	IncludeSystem *bool `json:"includeSystem,omitempty"`
+}
+
+// RunningQuery contains information about a running (or slow) AQL query.
+type RunningQuery struct {
+	// ID of the query.
+	ID string `json:"id,omitempty"`
+	// Database name the query is running in.
+	Database string `json:"database,omitempty"`
+	// User that started the query.
+	User string `json:"user,omitempty"`
+	// Query string (potentially truncated).
+	Query string `json:"query,omitempty"`
+	// Bind parameters used by the query.
+	BindVars map[string]interface{} `json:"bindVars,omitempty"`
+	// Started is the date and time when the query was started.
+	Started time.Time `json:"started,omitempty"`
+	// RunTime is the query's run time up to the point the list of queries was queried (in seconds).
+	RunTime float64 `json:"runTime,omitempty"`
+	// PeakMemoryUsage is the query's peak memory usage in bytes (in increments of 32KB).
+	PeakMemoryUsage int64 `json:"peakMemoryUsage,omitempty"`
+	// State is the query's current execution state.
+	// Slow queries always have state "finished".
+	State string `json:"state,omitempty"`
+	// Stream is true if the query uses a streaming cursor.
+	Stream bool `json:"stream,omitempty"`
+}
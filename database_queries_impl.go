@@ -0,0 +1,162 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package driver
+
+import (
+	"context"
+	"path"
+)
+
+// ListRunningQueries returns a list of the AQL queries that are currently running in the database.
+func (d *database) ListRunningQueries(ctx context.Context) ([]RunningQuery, error) {
+	return d.listQueries(ctx, "current")
+}
+
+// ListSlowQueries returns a list of the last slow AQL queries of the database.
+func (d *database) ListSlowQueries(ctx context.Context) ([]RunningQuery, error) {
+	return d.listQueries(ctx, "slow")
+}
+
+// listQueries fetches the list of queries at _api/query/<kind>.
+func (d *database) listQueries(ctx context.Context, kind string) ([]RunningQuery, error) {
+	req, err := d.conn.NewRequest("GET", path.Join(d.relPath(), "_api/query", kind))
+	if err != nil {
+		return nil, WithStack(err)
+	}
+	applyContextSettings(ctx, req)
+	resp, err := d.conn.Do(ctx, req)
+	if err != nil {
+		return nil, WithStack(err)
+	}
+	if err := resp.CheckStatus(200); err != nil {
+		return nil, WithStack(err)
+	}
+	elems, err := resp.ParseArrayBody()
+	if err != nil {
+		return nil, WithStack(err)
+	}
+	result := make([]RunningQuery, 0, len(elems))
+	for _, elem := range elems {
+		var q RunningQuery
+		if err := elem.ParseBody("", &q); err != nil {
+			return nil, WithStack(err)
+		}
+		result = append(result, q)
+	}
+	return result, nil
+}
+
+// ClearSlowQueries clears the list of slow AQL queries of the database.
+func (d *database) ClearSlowQueries(ctx context.Context) error {
+	req, err := d.conn.NewRequest("DELETE", path.Join(d.relPath(), "_api/query/slow"))
+	if err != nil {
+		return WithStack(err)
+	}
+	applyContextSettings(ctx, req)
+	resp, err := d.conn.Do(ctx, req)
+	if err != nil {
+		return WithStack(err)
+	}
+	if err := resp.CheckStatus(200); err != nil {
+		return WithStack(err)
+	}
+	return nil
+}
+
+// KillQuery kills a running AQL query with given ID.
+// If the query does not exist (anymore), a NotFoundError is returned.
+func (d *database) KillQuery(ctx context.Context, id string) error {
+	req, err := d.conn.NewRequest("DELETE", path.Join(d.relPath(), "_api/query", pathEscape(id)))
+	if err != nil {
+		return WithStack(err)
+	}
+	applyContextSettings(ctx, req)
+	resp, err := d.conn.Do(ctx, req)
+	if err != nil {
+		return WithStack(err)
+	}
+	if err := resp.CheckStatus(200); err != nil {
+		return WithStack(err)
+	}
+	return nil
+}
+
+// QueryCacheProperties returns the global properties of the AQL query results cache.
+func (d *database) QueryCacheProperties(ctx context.Context) (QueryCacheProperties, error) {
+	req, err := d.conn.NewRequest("GET", path.Join(d.relPath(), "_api/query-cache/properties"))
+	if err != nil {
+		return QueryCacheProperties{}, WithStack(err)
+	}
+	applyContextSettings(ctx, req)
+	resp, err := d.conn.Do(ctx, req)
+	if err != nil {
+		return QueryCacheProperties{}, WithStack(err)
+	}
+	if err := resp.CheckStatus(200); err != nil {
+		return QueryCacheProperties{}, WithStack(err)
+	}
+	var data QueryCacheProperties
+	if err := resp.ParseBody("", &data); err != nil {
+		return QueryCacheProperties{}, WithStack(err)
+	}
+	return data, nil
+}
+
+// SetQueryCacheProperties changes the global properties of the AQL query results cache.
+func (d *database) SetQueryCacheProperties(ctx context.Context, props QueryCacheProperties) (QueryCacheProperties, error) {
+	req, err := d.conn.NewRequest("PUT", path.Join(d.relPath(), "_api/query-cache/properties"))
+	if err != nil {
+		return QueryCacheProperties{}, WithStack(err)
+	}
+	if _, err := req.SetBody(props); err != nil {
+		return QueryCacheProperties{}, WithStack(err)
+	}
+	applyContextSettings(ctx, req)
+	resp, err := d.conn.Do(ctx, req)
+	if err != nil {
+		return QueryCacheProperties{}, WithStack(err)
+	}
+	if err := resp.CheckStatus(200); err != nil {
+		return QueryCacheProperties{}, WithStack(err)
+	}
+	var data QueryCacheProperties
+	if err := resp.ParseBody("", &data); err != nil {
+		return QueryCacheProperties{}, WithStack(err)
+	}
+	return data, nil
+}
+
+// ClearQueryCache clears the AQL query results cache of the database.
+func (d *database) ClearQueryCache(ctx context.Context) error {
+	req, err := d.conn.NewRequest("DELETE", path.Join(d.relPath(), "_api/query-cache"))
+	if err != nil {
+		return WithStack(err)
+	}
+	applyContextSettings(ctx, req)
+	resp, err := d.conn.Do(ctx, req)
+	if err != nil {
+		return WithStack(err)
+	}
+	if err := resp.CheckStatus(200); err != nil {
+		return WithStack(err)
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package driver
+
+import (
+	"context"
+	"time"
+)
+
+// DatabaseTasks provides access to the server-side tasks of a database.
+type DatabaseTasks interface {
+	// CreateTask creates a task that runs the given JavaScript command on the server,
+	// either once (after an optional offset) or periodically.
+	// If options.ID is set, the task is created with that ID.
+	CreateTask(ctx context.Context, options TaskOptions) (Task, error)
+
+	// Tasks returns all tasks of the server.
+	Tasks(ctx context.Context) ([]Task, error)
+
+	// Task returns the task with given ID.
+	// If no task with given ID exists, a NotFoundError is returned.
+	Task(ctx context.Context, id string) (Task, error)
+
+	// RemoveTask removes the task with given ID.
+	// If no task with given ID exists, a NotFoundError is returned.
+	RemoveTask(ctx context.Context, id string) error
+}
+
+// TaskOptions contains options for creating a task.
+type TaskOptions struct {
+	// ID of the task. If not set, the server generates an ID.
+	ID string
+	// Name of the task.
+	Name string
+	// Command is the JavaScript code to run, e.g. `function (params) { ... }`.
+	Command string
+	// Params is passed to the command when it runs.
+	Params interface{}
+	// Period is the interval at which the task runs.
+	// If not set, the task runs only once.
+	Period time.Duration
+	// Offset is the delay before the task runs for the first time.
+	Offset time.Duration
+}
+
+// TaskType is the type of a task.
+type TaskType string
+
+const (
+	// TaskTypePeriodic is a task that runs periodically.
+	TaskTypePeriodic = TaskType("periodic")
+	// TaskTypeTimed is a task that runs once.
+	TaskTypeTimed = TaskType("timed")
+)
+
+// Task describes a server-side task.
+type Task struct {
+	// ID of the task.
+	ID string
+	// Name of the task.
+	Name string
+	// Type of the task.
+	Type TaskType
+	// Command is the JavaScript code of the task.
+	Command string
+	// Period is the interval at which the task runs (zero for one-off tasks).
+	Period time.Duration
+	// Offset is the delay before the first run of the task.
+	Offset time.Duration
+	// Created is the time the task was created.
+	Created time.Time
+	// Database is the name of the database the task runs in.
+	Database string
+}
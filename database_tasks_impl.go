@@ -0,0 +1,154 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package driver
+
+import (
+	"context"
+	"math"
+	"path"
+	"time"
+)
+
+// taskInternal is the representation of a task used by the server.
+type taskInternal struct {
+	ID       string      `json:"id,omitempty"`
+	Name     string      `json:"name,omitempty"`
+	Type     TaskType    `json:"type,omitempty"`
+	Command  string      `json:"command,omitempty"`
+	Params   interface{} `json:"params,omitempty"`
+	Period   float64     `json:"period,omitempty"`
+	Offset   float64     `json:"offset,omitempty"`
+	Created  float64     `json:"created,omitempty"`
+	Database string      `json:"database,omitempty"`
+}
+
+// asExternal converts the server representation of a task into a Task.
+func (t taskInternal) asExternal() Task {
+	secs, frac := math.Modf(t.Created)
+	return Task{
+		ID:       t.ID,
+		Name:     t.Name,
+		Type:     t.Type,
+		Command:  t.Command,
+		Period:   time.Duration(t.Period * float64(time.Second)),
+		Offset:   time.Duration(t.Offset * float64(time.Second)),
+		Created:  time.Unix(int64(secs), int64(frac*float64(time.Second))),
+		Database: t.Database,
+	}
+}
+
+// CreateTask creates a task that runs the given JavaScript command on the server.
+func (d *database) CreateTask(ctx context.Context, options TaskOptions) (Task, error) {
+	input := taskInternal{
+		Name:    options.Name,
+		Command: options.Command,
+		Params:  options.Params,
+		Period:  options.Period.Seconds(),
+		Offset:  options.Offset.Seconds(),
+	}
+	method, p := "POST", path.Join(d.relPath(), "_api/tasks")
+	if options.ID != "" {
+		method, p = "PUT", path.Join(p, pathEscape(options.ID))
+	}
+	req, err := d.conn.NewRequest(method, p)
+	if err != nil {
+		return Task{}, WithStack(err)
+	}
+	if _, err := req.SetBody(input); err != nil {
+		return Task{}, WithStack(err)
+	}
+	applyContextSettings(ctx, req)
+	resp, err := d.conn.Do(ctx, req)
+	if err != nil {
+		return Task{}, WithStack(err)
+	}
+	if err := resp.CheckStatus(200); err != nil {
+		return Task{}, WithStack(err)
+	}
+	var data taskInternal
+	if err := resp.ParseBody("", &data); err != nil {
+		return Task{}, WithStack(err)
+	}
+	return data.asExternal(), nil
+}
+
+// Tasks returns all tasks of the server.
+func (d *database) Tasks(ctx context.Context) ([]Task, error) {
+	req, err := d.conn.NewRequest("GET", path.Join(d.relPath(), "_api/tasks"))
+	if err != nil {
+		return nil, WithStack(err)
+	}
+	applyContextSettings(ctx, req)
+	resp, err := d.conn.Do(ctx, req)
+	if err != nil {
+		return nil, WithStack(err)
+	}
+	if err := resp.CheckStatus(200); err != nil {
+		return nil, WithStack(err)
+	}
+	var data []taskInternal
+	if err := resp.ParseBody("", &data); err != nil {
+		return nil, WithStack(err)
+	}
+	result := make([]Task, 0, len(data))
+	for _, t := range data {
+		result = append(result, t.asExternal())
+	}
+	return result, nil
+}
+
+// Task returns the task with given ID.
+func (d *database) Task(ctx context.Context, id string) (Task, error) {
+	req, err := d.conn.NewRequest("GET", path.Join(d.relPath(), "_api/tasks", pathEscape(id)))
+	if err != nil {
+		return Task{}, WithStack(err)
+	}
+	applyContextSettings(ctx, req)
+	resp, err := d.conn.Do(ctx, req)
+	if err != nil {
+		return Task{}, WithStack(err)
+	}
+	if err := resp.CheckStatus(200); err != nil {
+		return Task{}, WithStack(err)
+	}
+	var data taskInternal
+	if err := resp.ParseBody("", &data); err != nil {
+		return Task{}, WithStack(err)
+	}
+	return data.asExternal(), nil
+}
+
+// RemoveTask removes the task with given ID.
+func (d *database) RemoveTask(ctx context.Context, id string) error {
+	req, err := d.conn.NewRequest("DELETE", path.Join(d.relPath(), "_api/tasks", pathEscape(id)))
+	if err != nil {
+		return WithStack(err)
+	}
+	applyContextSettings(ctx, req)
+	resp, err := d.conn.Do(ctx, req)
+	if err != nil {
+		return WithStack(err)
+	}
+	if err := resp.CheckStatus(200); err != nil {
+		return WithStack(err)
+	}
+	return nil
+}
@@ -66,6 +66,12 @@ type TransactionStatusRecord struct {
 	Status TransactionStatus
 }
 
+// TransactionInfo describes a running stream transaction
+type TransactionInfo struct {
+	ID    TransactionID     `json:"id"`
+	State TransactionStatus `json:"state"`
+}
+
 // DatabaseStreamingTransactions provides access to the Streaming Transactions API
 type DatabaseStreamingTransactions interface {
 	BeginTransaction(ctx context.Context, cols TransactionCollections, opts *BeginTransactionOptions) (TransactionID, error)
@@ -73,4 +79,7 @@ type DatabaseStreamingTransactions interface {
 	AbortTransaction(ctx context.Context, tid TransactionID, opts *AbortTransactionOptions) error
 
 	TransactionStatus(ctx context.Context, tid TransactionID) (TransactionStatusRecord, error)
+
+	// Transactions returns all currently running stream transactions of the database.
+	Transactions(ctx context.Context) ([]TransactionInfo, error)
 }
@@ -27,6 +27,52 @@ import (
 	"path"
 )
 
+// withTransactionEndpoints configures the given context to store the cache of transaction endpoints
+// of the client that performs the request into the given reference.
+func withTransactionEndpoints(parent context.Context, value **endpointCache) context.Context {
+	return context.WithValue(contextOrBackground(parent), keyTransactionEndpoints, value)
+}
+
+// newTransactionConnection creates a connection that sends requests bound to a stream transaction
+// (using WithTransactionID) to the endpoint of the coordinator that began the transaction.
+func newTransactionConnection(conn Connection, endpoints *endpointCache) Connection {
+	return &transactionConnection{Connection: conn, endpoints: endpoints}
+}
+
+// transactionConnection is a Connection that keeps track of the endpoints of the stream transactions
+// began by a single client.
+type transactionConnection struct {
+	Connection
+	endpoints *endpointCache
+}
+
+// Do performs a given request, returning its response.
+// Unless the context specifies an endpoint, a request bound to a stream transaction is sent
+// to the endpoint that began the transaction.
+func (c *transactionConnection) Do(ctx context.Context, req Request) (Response, error) {
+	if ctx != nil {
+		if ref, ok := ctx.Value(keyTransactionEndpoints).(**endpointCache); ok && ref != nil {
+			*ref = c.endpoints
+		}
+		if tid, ok := ctx.Value(keyTransactionID).(TransactionID); ok && tid != "" && ctx.Value(keyEndpoint) == nil {
+			if endpoint, found := c.endpoints.get(string(tid)); found {
+				ctx = WithEndpoint(ctx, endpoint)
+			}
+		}
+	}
+	return c.Connection.Do(ctx, req)
+}
+
+// SetAuthentication creates a copy of the connection with the given authentication.
+// The copy shares the transaction endpoints with this connection.
+func (c *transactionConnection) SetAuthentication(auth Authentication) (Connection, error) {
+	conn, err := c.Connection.SetAuthentication(auth)
+	if err != nil {
+		return nil, WithStack(err)
+	}
+	return newTransactionConnection(conn, c.endpoints), nil
+}
+
 type beginTransactionRequest struct {
 	WaitForSync        bool                   `json:"waitForSync,omitempty"`
 	AllowImplicit      bool                   `json:"allowImplicit,omitempty"`
@@ -45,12 +91,15 @@ func (d *database) BeginTransaction(ctx context.Context, cols TransactionCollect
 		reqBody.WaitForSync = opts.WaitForSync
 		reqBody.AllowImplicit = opts.AllowImplicit
 		reqBody.LockTimeout = opts.LockTimeout.Seconds()
+		reqBody.MaxTransactionSize = opts.MaxTransactionSize
 	}
 	reqBody.Collections = cols
 	if _, err := req.SetBody(reqBody); err != nil {
 		return "", WithStack(err)
 	}
-	resp, err := d.conn.Do(ctx, req)
+	applyContextSettings(ctx, req)
+	var endpoints *endpointCache
+	resp, err := d.conn.Do(withTransactionEndpoints(ctx, &endpoints), req)
 	if err != nil {
 		return "", WithStack(err)
 	}
@@ -63,6 +112,9 @@ func (d *database) BeginTransaction(ctx context.Context, cols TransactionCollect
 	if err := resp.ParseBody("result", &result); err != nil {
 		return "", WithStack(err)
 	}
+	if endpoint := resp.Endpoint(); endpoint != "" && endpoints != nil {
+		endpoints.set(string(result.TransactionID), endpoint)
+	}
 	return result.TransactionID, nil
 }
 
@@ -71,11 +123,23 @@ func (d *database) requestForTransaction(ctx context.Context, tid TransactionID,
 	if err != nil {
 		return TransactionStatusRecord{}, WithStack(err)
 	}
-	resp, err := d.conn.Do(ctx, req)
+	applyContextSettings(ctx, req)
+	ctx = context.WithValue(contextOrBackground(ctx), keyTransactionID, tid)
+	if method != "GET" {
+		// Committing or aborting a transaction must not be repeated once it has been written.
+		ctx = withRetrySafe(ctx, false)
+	}
+	var endpoints *endpointCache
+	resp, err := d.conn.Do(withTransactionEndpoints(ctx, &endpoints), req)
 	if err != nil {
 		return TransactionStatusRecord{}, WithStack(err)
 	}
-	if err := resp.CheckStatus(200); err != nil {
+	err = resp.CheckStatus(200)
+	if method != "GET" && endpoints != nil && (err == nil || IsNotFound(err)) {
+		// The transaction is finished (or unknown), its endpoint is no longer needed
+		endpoints.remove(string(tid))
+	}
+	if err != nil {
 		return TransactionStatusRecord{}, WithStack(err)
 	}
 	var result TransactionStatusRecord
@@ -98,3 +162,23 @@ func (d *database) AbortTransaction(ctx context.Context, tid TransactionID, opts
 func (d *database) TransactionStatus(ctx context.Context, tid TransactionID) (TransactionStatusRecord, error) {
 	return d.requestForTransaction(ctx, tid, "GET")
 }
+
+func (d *database) Transactions(ctx context.Context) ([]TransactionInfo, error) {
+	req, err := d.conn.NewRequest("GET", path.Join(d.relPath(), "_api/transaction"))
+	if err != nil {
+		return nil, WithStack(err)
+	}
+	applyContextSettings(ctx, req)
+	resp, err := d.conn.Do(ctx, req)
+	if err != nil {
+		return nil, WithStack(err)
+	}
+	if err := resp.CheckStatus(200); err != nil {
+		return nil, WithStack(err)
+	}
+	var result []TransactionInfo
+	if err := resp.ParseBody("transactions", &result); err != nil {
+		return nil, WithStack(err)
+	}
+	return result, nil
+}
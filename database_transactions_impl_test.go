@@ -0,0 +1,134 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package driver
+
+import (
+	"context"
+	"testing"
+)
+
+type testTransactionResponse struct {
+	Response
+	status int
+}
+
+func (r *testTransactionResponse) StatusCode() int  { return r.status }
+func (r *testTransactionResponse) Endpoint() string { return "http://coordinator1:8529" }
+
+func (r *testTransactionResponse) CheckStatus(validStatusCodes ...int) error {
+	for _, code := range validStatusCodes {
+		if code == r.status {
+			return nil
+		}
+	}
+	return newArangoError(r.status, 0, "unexpected status")
+}
+
+func (r *testTransactionResponse) ParseBody(field string, result interface{}) error {
+	if data, ok := result.(*struct {
+		TransactionID TransactionID `json:"id,omitempty"`
+	}); ok {
+		data.TransactionID = "tx1"
+	}
+	return nil
+}
+
+type testTransactionRequest struct {
+	testRetryRequest
+}
+
+func (r *testTransactionRequest) SetBody(body ...interface{}) (Request, error) { return r, nil }
+func (r *testTransactionRequest) SetHeader(key, value string) Request          { return r }
+
+type testTransactionConnection struct {
+	Connection
+	endpoints []interface{}
+}
+
+func (c *testTransactionConnection) NewRequest(method, path string) (Request, error) {
+	return &testTransactionRequest{testRetryRequest{method: method}}, nil
+}
+
+func (c *testTransactionConnection) Do(ctx context.Context, req Request) (Response, error) {
+	c.endpoints = append(c.endpoints, ctx.Value(keyEndpoint))
+	if req.Method() == "POST" {
+		return &testTransactionResponse{status: 201}, nil
+	}
+	return &testTransactionResponse{status: 200}, nil
+}
+
+func TestWithTransactionIDEndpoint(t *testing.T) {
+	conn := &testTransactionConnection{}
+	db := &database{name: "_system", conn: newTransactionConnection(conn, newEndpointCache(defaultEndpointCacheSize))}
+	tid, err := db.BeginTransaction(context.Background(), TransactionCollections{}, nil)
+	if err != nil {
+		t.Fatalf("BeginTransaction failed: %s", err)
+	}
+
+	db.conn.Do(WithTransactionID(context.Background(), tid), &testRetryRequest{})
+	db.conn.Do(WithTransactionID(WithEndpoint(context.Background(), "http://coordinator2:8529"), tid), &testRetryRequest{})
+	db.conn.Do(WithTransactionID(context.Background(), "unknown"), &testRetryRequest{})
+	if _, err := db.TransactionStatus(context.Background(), tid); err != nil {
+		t.Fatalf("TransactionStatus failed: %s", err)
+	}
+	if err := db.CommitTransaction(context.Background(), tid, nil); err != nil {
+		t.Fatalf("CommitTransaction failed: %s", err)
+	}
+	db.conn.Do(WithTransactionID(context.Background(), tid), &testRetryRequest{})
+
+	// The endpoints are scoped to the connection of the client that began the transaction
+	other := &testTransactionConnection{}
+	newTransactionConnection(other, newEndpointCache(defaultEndpointCacheSize)).Do(WithTransactionID(context.Background(), tid), &testRetryRequest{})
+
+	expected := []interface{}{nil, "http://coordinator1:8529", "http://coordinator2:8529", nil, "http://coordinator1:8529", "http://coordinator1:8529", nil}
+	if len(conn.endpoints) != len(expected) {
+		t.Fatalf("Expected %d requests, got %d", len(expected), len(conn.endpoints))
+	}
+	for i, endpoint := range conn.endpoints {
+		if endpoint != expected[i] {
+			t.Errorf("Unexpected endpoint of request %d; got %v, expected %v", i, endpoint, expected[i])
+		}
+	}
+	if len(other.endpoints) != 1 || other.endpoints[0] != nil {
+		t.Errorf("Expected no endpoint for a transaction of another client, got %v", other.endpoints)
+	}
+}
+
+func TestEndpointCacheEviction(t *testing.T) {
+	c := newEndpointCache(2)
+	c.set("a", "1")
+	c.set("b", "2")
+	c.remove("a")
+	c.set("a", "3")
+	c.set("c", "4")
+	if _, found := c.get("b"); found {
+		t.Error("Expected oldest entry to be evicted")
+	}
+	for id, expected := range map[string]string{"a": "3", "c": "4"} {
+		if endpoint, found := c.get(id); !found || endpoint != expected {
+			t.Errorf("Unexpected endpoint for %s; got %q, expected %q", id, endpoint, expected)
+		}
+	}
+	c.clear()
+	if _, found := c.get("a"); found {
+		t.Error("Expected no entries after clear")
+	}
+}
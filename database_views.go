@@ -41,6 +41,12 @@ type DatabaseViews interface {
 	// with given name and options, and opens a connection to it.
 	// If a view with given name already exists within the database, a ConflictError is returned.
 	CreateArangoSearchView(ctx context.Context, name string, options *ArangoSearchViewProperties) (ArangoSearchView, error)
+
+	// CreateArangoSearchAliasView creates a new view of type search-alias,
+	// with given name and options, and opens a connection to it.
+	// If a view with given name already exists within the database, a ConflictError is returned.
+	// This function requires ArangoDB 3.10 or higher.
+	CreateArangoSearchAliasView(ctx context.Context, name string, options *ArangoSearchAliasViewProperties) (ArangoSearchViewAlias, error)
 }
 
 // ViewType is the type of a view.
@@ -49,4 +55,6 @@ type ViewType string
 const (
 	// ViewTypeArangoSearch specifies an ArangoSearch view type.
 	ViewTypeArangoSearch = ViewType("arangosearch")
+	// ViewTypeArangoSearchAlias specifies an ArangoSearch view type that federates inverted indexes.
+	ViewTypeArangoSearchAlias = ViewType("search-alias")
 )
@@ -0,0 +1,42 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+/*
+Package dump implements logical dumps and restores of collections and databases.
+
+The data of a collection is written as JSON lines (one document per line), which is the format used
+by arangodump for `<collection>.data.json` files. The structure of a collection (its properties and
+indexes) is written in the format of arangodump `<collection>.structure.json` files.
+
+Dump a single collection to any io.Writer:
+
+	count, err := dump.DumpCollection(ctx, db, "books", w, nil)
+
+Restore it from an io.Reader, using 4 parallel import requests:
+
+	restored, err := dump.RestoreCollection(ctx, col, r, &dump.RestoreOptions{Parallelism: 4})
+
+When a restore fails, the returned count holds the number of documents that have been restored
+without gaps, so the restore can be resumed by passing that count in RestoreOptions.Skip.
+
+DumpDatabase and RestoreDatabase dump and restore all collections of a database to and from a directory
+that is compatible with arangodump and arangorestore.
+*/
+package dump
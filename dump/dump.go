@@ -0,0 +1,213 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package dump
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	driver "github.com/arangodb/go-driver"
+)
+
+const (
+	// defaultBatchSize is the default number of documents fetched or imported in a single request.
+	defaultBatchSize = 1000
+
+	structureFileSuffix = ".structure.json"
+	dataFileSuffix      = ".data.json"
+)
+
+// DumpOptions holds optional options that control a dump.
+type DumpOptions struct {
+	// BatchSize is the number of documents fetched from the server in a single request.
+	// If not set, 1000 documents are fetched per request.
+	BatchSize int
+	// IncludeSystemCollections, if set, makes DumpDatabase also dump system collections.
+	IncludeSystemCollections bool
+}
+
+// collectionStructure is the content of a `<collection>.structure.json` file.
+type collectionStructure struct {
+	Parameters map[string]interface{}   `json:"parameters"`
+	Indexes    []map[string]interface{} `json:"indexes"`
+}
+
+// DumpCollection writes all documents of the collection with given name to the given writer.
+// Every document is written as a single line of JSON.
+// Returns the number of documents written.
+func DumpCollection(ctx context.Context, db driver.DatabaseQueryExecutor, name string, w io.Writer, opts *DumpOptions) (int64, error) {
+	batchSize := defaultBatchSize
+	if opts != nil && opts.BatchSize > 0 {
+		batchSize = opts.BatchSize
+	}
+	queryCtx := driver.WithQueryStream(driver.WithQueryBatchSize(ctx, batchSize))
+	cursor, err := db.Query(queryCtx, "FOR d IN @@col RETURN d", map[string]interface{}{"@col": name})
+	if err != nil {
+		return 0, driver.WithStack(err)
+	}
+	defer cursor.Close()
+
+	bw := bufio.NewWriter(w)
+	var count int64
+	for {
+		var doc json.RawMessage
+		if _, err := cursor.ReadDocument(ctx, &doc); driver.IsNoMoreDocuments(err) {
+			break
+		} else if err != nil {
+			return count, driver.WithStack(err)
+		}
+		if _, err := bw.Write(doc); err != nil {
+			return count, driver.WithStack(err)
+		}
+		if err := bw.WriteByte('\n'); err != nil {
+			return count, driver.WithStack(err)
+		}
+		count++
+	}
+	if err := bw.Flush(); err != nil {
+		return count, driver.WithStack(err)
+	}
+	return count, nil
+}
+
+// DumpStructure writes the properties and indexes of the collection with given name to the given writer,
+// in the format of an arangodump `<collection>.structure.json` file.
+func DumpStructure(ctx context.Context, c driver.Client, db driver.Database, name string, w io.Writer) error {
+	conn := c.Connection()
+	var structure collectionStructure
+
+	// Fetch properties
+	req, err := conn.NewRequest("GET", path.Join(databasePath(db), "_api/collection", url.PathEscape(name), "properties"))
+	if err != nil {
+		return driver.WithStack(err)
+	}
+	resp, err := conn.Do(ctx, req)
+	if err != nil {
+		return driver.WithStack(err)
+	}
+	if err := resp.CheckStatus(200); err != nil {
+		return driver.WithStack(err)
+	}
+	if err := resp.ParseBody("", &structure.Parameters); err != nil {
+		return driver.WithStack(err)
+	}
+	delete(structure.Parameters, "error")
+	delete(structure.Parameters, "code")
+
+	// Fetch indexes
+	req, err = conn.NewRequest("GET", path.Join(databasePath(db), "_api/index"))
+	if err != nil {
+		return driver.WithStack(err)
+	}
+	req.SetQuery("collection", name)
+	resp, err = conn.Do(ctx, req)
+	if err != nil {
+		return driver.WithStack(err)
+	}
+	if err := resp.CheckStatus(200); err != nil {
+		return driver.WithStack(err)
+	}
+	var indexes []map[string]interface{}
+	if err := resp.ParseBody("indexes", &indexes); err != nil {
+		return driver.WithStack(err)
+	}
+	structure.Indexes = []map[string]interface{}{}
+	for _, idx := range indexes {
+		// Primary and edge indexes are created together with the collection
+		if t, _ := idx["type"].(string); t != "primary" && t != "edge" {
+			structure.Indexes = append(structure.Indexes, idx)
+		}
+	}
+
+	encoded, err := json.Marshal(structure)
+	if err != nil {
+		return driver.WithStack(err)
+	}
+	if _, err := w.Write(encoded); err != nil {
+		return driver.WithStack(err)
+	}
+	return nil
+}
+
+// DumpDatabase dumps the structure and data of all collections of the given database
+// into the given directory, using the file layout of arangodump.
+// The directory is created when it does not exist.
+func DumpDatabase(ctx context.Context, c driver.Client, db driver.Database, dir string, opts *DumpOptions) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return driver.WithStack(err)
+	}
+	cols, err := db.Collections(ctx)
+	if err != nil {
+		return driver.WithStack(err)
+	}
+	names := make([]string, 0, len(cols))
+	for _, col := range cols {
+		if strings.HasPrefix(col.Name(), "_") && (opts == nil || !opts.IncludeSystemCollections) {
+			continue
+		}
+		names = append(names, col.Name())
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if err := writeFile(filepath.Join(dir, name+structureFileSuffix), func(w io.Writer) error {
+			return DumpStructure(ctx, c, db, name, w)
+		}); err != nil {
+			return driver.WithStack(err)
+		}
+		if err := writeFile(filepath.Join(dir, name+dataFileSuffix), func(w io.Writer) error {
+			_, err := DumpCollection(ctx, db, name, w, opts)
+			return err
+		}); err != nil {
+			return driver.WithStack(err)
+		}
+	}
+	return nil
+}
+
+// writeFile creates the file with given name and writes its content using the given function.
+func writeFile(name string, write func(w io.Writer) error) error {
+	f, err := os.Create(name)
+	if err != nil {
+		return driver.WithStack(err)
+	}
+	if err := write(f); err != nil {
+		f.Close()
+		return driver.WithStack(err)
+	}
+	if err := f.Close(); err != nil {
+		return driver.WithStack(err)
+	}
+	return nil
+}
+
+// databasePath returns the path prefix of all requests for the given database.
+func databasePath(db driver.Database) string {
+	return path.Join("_db", url.PathEscape(db.Name()))
+}
@@ -0,0 +1,302 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package dump
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	driver "github.com/arangodb/go-driver"
+)
+
+// RestoreOptions holds optional options that control a restore.
+type RestoreOptions struct {
+	// BatchSize is the number of documents imported in a single request.
+	// If not set, 1000 documents are imported per request.
+	BatchSize int
+	// Parallelism is the number of import requests that are run in parallel.
+	// If not set, batches are imported one after the other.
+	Parallelism int
+	// Skip is the number of documents to skip at the start of the input.
+	// Use it to resume a restore that has failed before.
+	Skip int64
+	// OnDuplicate controls what happens when a document already exists.
+	// If not set, the restore fails on a duplicate document.
+	OnDuplicate driver.ImportOnDuplicate
+	// Progress, if set, is called every time documents have been restored.
+	// It is given the name of the collection and the number of documents (including skipped documents)
+	// that have been restored without gaps.
+	Progress func(collection string, restored int64)
+}
+
+// DatabaseRestoreOptions holds optional options that control the restore of a database.
+type DatabaseRestoreOptions struct {
+	RestoreOptions
+	// Overwrite, if set, drops existing collections before restoring them.
+	Overwrite bool
+	// Resume holds the number of documents (as reported by Progress) that have already been restored per collection.
+	// Collections in this map are expected to exist already; their first documents are skipped.
+	Resume map[string]int64
+}
+
+// restoreBatch is a set of documents that is imported in a single request.
+type restoreBatch struct {
+	seq  int
+	docs []json.RawMessage
+}
+
+// restoreProgress keeps track of the number of documents restored without gaps.
+type restoreProgress struct {
+	mutex      sync.Mutex
+	collection string
+	restored   int64
+	next       int
+	done       map[int]int
+	progress   func(collection string, restored int64)
+}
+
+// complete marks the batch with given sequence number (and size) as restored.
+func (p *restoreProgress) complete(seq, size int) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.done[seq] = size
+	advanced := false
+	for {
+		size, found := p.done[p.next]
+		if !found {
+			break
+		}
+		delete(p.done, p.next)
+		p.restored += int64(size)
+		p.next++
+		advanced = true
+	}
+	if advanced && p.progress != nil {
+		p.progress(p.collection, p.restored)
+	}
+}
+
+// RestoreCollection imports the documents read from the given reader into the given collection.
+// The input must contain one JSON document per line (as written by DumpCollection). Empty lines are ignored.
+// Returns the number of documents (including skipped documents) that have been restored without gaps.
+// In case of an error, this number can be passed in RestoreOptions.Skip to resume the restore.
+func RestoreCollection(ctx context.Context, col driver.Collection, r io.Reader, opts *RestoreOptions) (int64, error) {
+	var o RestoreOptions
+	if opts != nil {
+		o = *opts
+	}
+	if o.BatchSize <= 0 {
+		o.BatchSize = defaultBatchSize
+	}
+	if o.Parallelism <= 0 {
+		o.Parallelism = 1
+	}
+	importOptions := &driver.ImportDocumentOptions{
+		OnDuplicate: o.OnDuplicate,
+		Complete:    true,
+	}
+	progress := &restoreProgress{
+		collection: col.Name(),
+		restored:   o.Skip,
+		done:       make(map[int]int),
+		progress:   o.Progress,
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var errMutex sync.Mutex
+	var firstErr error
+	setErr := func(err error) {
+		errMutex.Lock()
+		defer errMutex.Unlock()
+		if firstErr == nil {
+			firstErr = err
+			cancel()
+		}
+	}
+
+	batches := make(chan restoreBatch)
+	wg := sync.WaitGroup{}
+	for i := 0; i < o.Parallelism; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for b := range batches {
+				if _, err := col.ImportDocuments(ctx, b.docs, importOptions); err != nil {
+					setErr(driver.WithStack(err))
+					continue
+				}
+				progress.complete(b.seq, len(b.docs))
+			}
+		}()
+	}
+
+	readErr := readBatches(ctx, r, o.Skip, o.BatchSize, batches)
+	close(batches)
+	wg.Wait()
+
+	if firstErr != nil {
+		return progress.restored, driver.WithStack(firstErr)
+	}
+	if readErr != nil {
+		return progress.restored, driver.WithStack(readErr)
+	}
+	return progress.restored, nil
+}
+
+// readBatches reads JSON lines from the given reader, skipping the first skip documents,
+// and sends them in batches of the given size to the given channel.
+func readBatches(ctx context.Context, r io.Reader, skip int64, batchSize int, batches chan<- restoreBatch) error {
+	br := bufio.NewReader(r)
+	seq := 0
+	var docs []json.RawMessage
+	send := func() error {
+		select {
+		case batches <- restoreBatch{seq: seq, docs: docs}:
+			seq++
+			docs = nil
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	for {
+		line, readErr := br.ReadBytes('\n')
+		if readErr != nil && readErr != io.EOF {
+			return driver.WithStack(readErr)
+		}
+		if line = bytes.TrimSpace(line); len(line) > 0 {
+			if skip > 0 {
+				skip--
+			} else {
+				docs = append(docs, json.RawMessage(line))
+				if len(docs) >= batchSize {
+					if err := send(); err != nil {
+						return driver.WithStack(err)
+					}
+				}
+			}
+		}
+		if readErr == io.EOF {
+			break
+		}
+	}
+	if len(docs) > 0 {
+		if err := send(); err != nil {
+			return driver.WithStack(err)
+		}
+	}
+	return nil
+}
+
+// RestoreDatabase restores all collections found in the given directory (as written by DumpDatabase or arangodump)
+// into the given database. Collections are created (including their indexes) from their structure files.
+func RestoreDatabase(ctx context.Context, c driver.Client, db driver.Database, dir string, opts *DatabaseRestoreOptions) error {
+	var o DatabaseRestoreOptions
+	if opts != nil {
+		o = *opts
+	}
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return driver.WithStack(err)
+	}
+	var names []string
+	for _, f := range files {
+		if name := f.Name(); strings.HasSuffix(name, structureFileSuffix) {
+			names = append(names, strings.TrimSuffix(name, structureFileSuffix))
+		}
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		structure, err := ioutil.ReadFile(filepath.Join(dir, name+structureFileSuffix))
+		if err != nil {
+			return driver.WithStack(err)
+		}
+		skip, resume := o.Resume[name]
+		if !resume {
+			if err := restoreStructure(ctx, c, db, "restore-collection", structure, o.Overwrite); err != nil {
+				return driver.WithStack(err)
+			}
+		}
+		col, err := db.Collection(ctx, name)
+		if err != nil {
+			return driver.WithStack(err)
+		}
+		if err := restoreData(ctx, col, filepath.Join(dir, name+dataFileSuffix), o.RestoreOptions, skip); err != nil {
+			return driver.WithStack(err)
+		}
+		if err := restoreStructure(ctx, c, db, "restore-indexes", structure, false); err != nil {
+			return driver.WithStack(err)
+		}
+	}
+	return nil
+}
+
+// restoreData restores the documents in the data file with given name into the given collection.
+// A missing data file is treated as an empty collection.
+func restoreData(ctx context.Context, col driver.Collection, fileName string, opts RestoreOptions, skip int64) error {
+	f, err := os.Open(fileName)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return driver.WithStack(err)
+	}
+	defer f.Close()
+	opts.Skip = skip
+	if _, err := RestoreCollection(ctx, col, f, &opts); err != nil {
+		return driver.WithStack(err)
+	}
+	return nil
+}
+
+// restoreStructure sends the given collection structure to the given replication restore API.
+func restoreStructure(ctx context.Context, c driver.Client, db driver.Database, api string, structure []byte, overwrite bool) error {
+	conn := c.Connection()
+	req, err := conn.NewRequest("PUT", path.Join(databasePath(db), "_api/replication", api))
+	if err != nil {
+		return driver.WithStack(err)
+	}
+	if overwrite {
+		req.SetQuery("overwrite", "true")
+	}
+	if _, err := req.SetBody(json.RawMessage(structure)); err != nil {
+		return driver.WithStack(err)
+	}
+	resp, err := conn.Do(ctx, req)
+	if err != nil {
+		return driver.WithStack(err)
+	}
+	if err := resp.CheckStatus(200); err != nil {
+		return driver.WithStack(err)
+	}
+	return nil
+}
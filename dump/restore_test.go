@@ -0,0 +1,105 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package dump
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+
+	driver "github.com/arangodb/go-driver"
+)
+
+// importCollection is a driver.Collection that records imported documents.
+type importCollection struct {
+	driver.Collection
+	mutex    sync.Mutex
+	imported []string
+	failAt   string
+}
+
+func (c *importCollection) Name() string { return "test" }
+
+func (c *importCollection) ImportDocuments(ctx context.Context, documents interface{}, options *driver.ImportDocumentOptions) (driver.ImportDocumentStatistics, error) {
+	docs := documents.([]json.RawMessage)
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	for _, d := range docs {
+		if string(d) == c.failAt {
+			return driver.ImportDocumentStatistics{}, errors.New("import failed")
+		}
+		c.imported = append(c.imported, string(d))
+	}
+	return driver.ImportDocumentStatistics{Created: int64(len(docs))}, nil
+}
+
+func TestRestoreCollection(t *testing.T) {
+	input := "{\"a\":1}\n\n{\"a\":2}\n{\"a\":3}\n{\"a\":4}\n{\"a\":5}"
+	col := &importCollection{}
+	var progress []int64
+	restored, err := RestoreCollection(context.Background(), col, strings.NewReader(input), &RestoreOptions{
+		BatchSize: 2,
+		Skip:      1,
+		Progress:  func(collection string, restored int64) { progress = append(progress, restored) },
+	})
+	if err != nil {
+		t.Fatalf("Expected success, got %s", err)
+	}
+	if restored != 5 {
+		t.Errorf("Expected 5 restored documents, got %d", restored)
+	}
+	if got := strings.Join(col.imported, ","); got != `{"a":2},{"a":3},{"a":4},{"a":5}` {
+		t.Errorf("Unexpected imported documents %s", got)
+	}
+	if len(progress) != 2 || progress[0] != 3 || progress[1] != 5 {
+		t.Errorf("Unexpected progress %v", progress)
+	}
+}
+
+func TestRestoreCollectionParallel(t *testing.T) {
+	var sb strings.Builder
+	for i := 0; i < 1000; i++ {
+		sb.WriteString("{}\n")
+	}
+	col := &importCollection{}
+	restored, err := RestoreCollection(context.Background(), col, strings.NewReader(sb.String()), &RestoreOptions{BatchSize: 7, Parallelism: 4})
+	if err != nil {
+		t.Fatalf("Expected success, got %s", err)
+	}
+	if restored != 1000 || len(col.imported) != 1000 {
+		t.Errorf("Expected 1000 restored documents, got %d (%d imported)", restored, len(col.imported))
+	}
+}
+
+func TestRestoreCollectionFailure(t *testing.T) {
+	input := "{\"a\":1}\n{\"a\":2}\n{\"a\":3}\n{\"a\":4}\n"
+	col := &importCollection{failAt: `{"a":3}`}
+	restored, err := RestoreCollection(context.Background(), col, strings.NewReader(input), &RestoreOptions{BatchSize: 2})
+	if err == nil {
+		t.Fatal("Expected failure, got success")
+	}
+	if restored != 2 {
+		t.Errorf("Expected 2 restored documents, got %d", restored)
+	}
+}
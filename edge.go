@@ -22,10 +22,75 @@
 
 package driver
 
+// EdgeDirection specifies the direction of the edges of a vertex.
+type EdgeDirection string
+
+const (
+	// EdgeDirectionIn selects the edges that have the vertex as `_to`.
+	EdgeDirectionIn EdgeDirection = "in"
+	// EdgeDirectionOut selects the edges that have the vertex as `_from`.
+	EdgeDirectionOut EdgeDirection = "out"
+	// EdgeDirectionAny selects the edges that have the vertex as `_from` or `_to`.
+	EdgeDirectionAny EdgeDirection = "any"
+)
+
+// edgesFilter returns the AQL filter condition on edge variable `e` for the given direction.
+func (d EdgeDirection) edgesFilter() (string, error) {
+	switch d {
+	case EdgeDirectionIn:
+		return "e._to == @vertex", nil
+	case EdgeDirectionOut:
+		return "e._from == @vertex", nil
+	case EdgeDirectionAny, "":
+		return "e._from == @vertex OR e._to == @vertex", nil
+	default:
+		return "", WithStack(InvalidArgumentError{Message: "unknown edge direction"})
+	}
+}
+
+// traversalDirection returns the AQL traversal direction keyword for the given direction.
+func (d EdgeDirection) traversalDirection() (string, error) {
+	switch d {
+	case EdgeDirectionIn:
+		return "INBOUND", nil
+	case EdgeDirectionOut:
+		return "OUTBOUND", nil
+	case EdgeDirectionAny, "":
+		return "ANY", nil
+	default:
+		return "", WithStack(InvalidArgumentError{Message: "unknown edge direction"})
+	}
+}
+
 // EdgeDocument is a minimal document for use in edge collection.
 // You can use this in your own edge document structures completely use your own.
 // If you use your own, make sure to include a `_from` and `_to` field.
+// To embed it in your own edge document structure, use:
+//
+//	type Friendship struct {
+//		driver.EdgeDocument
+//		Since int `json:"since"`
+//	}
 type EdgeDocument struct {
 	From DocumentID `json:"_from,omitempty"`
 	To   DocumentID `json:"_to,omitempty"`
 }
+
+// NewEdgeDocument creates a new edge document from the given `_from` and `_to` document IDs.
+func NewEdgeDocument(from, to DocumentID) EdgeDocument {
+	return EdgeDocument{
+		From: from,
+		To:   to,
+	}
+}
+
+// Validate validates the `_from` and `_to` document IDs of the edge document.
+func (e EdgeDocument) Validate() error {
+	if err := e.From.Validate(); err != nil {
+		return WithStack(InvalidArgumentError{Message: "invalid _from: " + err.Error()})
+	}
+	if err := e.To.Validate(); err != nil {
+		return WithStack(InvalidArgumentError{Message: "invalid _to: " + err.Error()})
+	}
+	return nil
+}
@@ -39,10 +39,27 @@ func (c *edgeCollection) DocumentExists(ctx context.Context, key string) (bool,
 	}
 }
 
+// DocumentsExist checks for every given key if a document with that key exists in the collection.
+func (c *edgeCollection) DocumentsExist(ctx context.Context, keys []string) ([]bool, error) {
+	result, err := c.rawCollection().DocumentsExist(ctx, keys)
+	if err != nil {
+		return nil, WithStack(err)
+	}
+	return result, nil
+}
+
 // ReadDocument reads a single document with given key from the collection.
 // The document data is stored into result, the document meta data is returned.
 // If no document exists with given key, a NotFoundError is returned.
 func (c *edgeCollection) ReadDocument(ctx context.Context, key string, result interface{}) (DocumentMeta, error) {
+	if _, ok := projectionFromContext(ctx); ok {
+		// Projections are not supported by the graph API, so read from the collection itself.
+		meta, err := c.rawCollection().ReadDocument(ctx, key, result)
+		if err != nil {
+			return DocumentMeta{}, WithStack(err)
+		}
+		return meta, nil
+	}
 	meta, _, err := c.readDocument(ctx, key, result)
 	if err != nil {
 		return DocumentMeta{}, WithStack(err)
@@ -121,12 +138,13 @@ func (c *edgeCollection) ReadDocuments(ctx context.Context, keys []string, resul
 		meta, cs, err := c.readDocument(ctx, key, result.Interface())
 		if cs.Silent {
 			silent = true
+			errs[i] = err
 		} else {
 			metas[i], errs[i] = meta, err
 		}
 	}
 	if silent {
-		return nil, nil, nil
+		return nil, errs, nil
 	}
 	return metas, errs, nil
 }
@@ -214,12 +232,13 @@ func (c *edgeCollection) CreateDocuments(ctx context.Context, documents interfac
 		meta, cs, err := c.createDocument(ctx, doc.Interface())
 		if cs.Silent {
 			silent = true
+			errs[i] = err
 		} else {
 			metas[i], errs[i] = meta, err
 		}
 	}
 	if silent {
-		return nil, nil, nil
+		return nil, errs, nil
 	}
 	return metas, errs, nil
 }
@@ -333,12 +352,13 @@ func (c *edgeCollection) UpdateDocuments(ctx context.Context, keys []string, upd
 		meta, cs, err := c.updateDocument(ctx, key, update.Interface())
 		if cs.Silent {
 			silent = true
+			errs[i] = err
 		} else {
 			metas[i], errs[i] = meta, err
 		}
 	}
 	if silent {
-		return nil, nil, nil
+		return nil, errs, nil
 	}
 	return metas, errs, nil
 }
@@ -452,16 +472,28 @@ func (c *edgeCollection) ReplaceDocuments(ctx context.Context, keys []string, do
 		meta, cs, err := c.replaceDocument(ctx, key, doc.Interface())
 		if cs.Silent {
 			silent = true
+			errs[i] = err
 		} else {
 			metas[i], errs[i] = meta, err
 		}
 	}
 	if silent {
-		return nil, nil, nil
+		return nil, errs, nil
 	}
 	return metas, errs, nil
 }
 
+// UpsertDocument inserts the insert document when no document in the collection matches all attributes
+// of the search document, otherwise it updates the matching document with the update document.
+// The document meta data is returned, together with true when a document was inserted and false when it was updated.
+func (c *edgeCollection) UpsertDocument(ctx context.Context, search, insert, update interface{}) (DocumentMeta, bool, error) {
+	meta, inserted, err := c.rawCollection().UpsertDocument(ctx, search, insert, update)
+	if err != nil {
+		return DocumentMeta{}, false, WithStack(err)
+	}
+	return meta, inserted, nil
+}
+
 // RemoveDocument removes a single document with given key from the collection.
 // The document meta data is returned.
 // To return the OLD document, prepare a context with `WithReturnOld`.
@@ -537,12 +569,13 @@ func (c *edgeCollection) RemoveDocuments(ctx context.Context, keys []string) (Do
 		meta, cs, err := c.removeDocument(ctx, key)
 		if cs.Silent {
 			silent = true
+			errs[i] = err
 		} else {
 			metas[i], errs[i] = meta, err
 		}
 	}
 	if silent {
-		return nil, nil, nil
+		return nil, errs, nil
 	}
 	return metas, errs, nil
 }
@@ -563,34 +596,70 @@ func (c *edgeCollection) ImportDocuments(ctx context.Context, documents interfac
 }
 
 // getKeyFromDocument looks for a `_key` document in the given document and returns it.
+// Anonymous embedded structs (e.g. DocumentMeta) and pointers to them are searched as well.
 func getKeyFromDocument(doc reflect.Value) (string, error) {
-	if doc.IsNil() {
-		return "", WithStack(InvalidArgumentError{Message: "Document is nil"})
-	}
-	if doc.Kind() == reflect.Ptr {
+	for doc.Kind() == reflect.Ptr || doc.Kind() == reflect.Interface {
+		if doc.IsNil() {
+			return "", WithStack(InvalidArgumentError{Message: "Document is nil"})
+		}
 		doc = doc.Elem()
 	}
 	switch doc.Kind() {
 	case reflect.Struct:
-		structType := doc.Type()
-		fieldCount := structType.NumField()
-		for i := 0; i < fieldCount; i++ {
-			f := structType.Field(i)
-			tagParts := strings.Split(f.Tag.Get("json"), ",")
-			if tagParts[0] == "_key" {
-				// We found the _key field
-				keyVal := doc.Field(i)
-				return keyVal.String(), nil
-			}
+		if key, found := getKeyFromStruct(doc); found {
+			return key, nil
 		}
 		return "", WithStack(InvalidArgumentError{Message: "Document contains no '_key' field"})
 	case reflect.Map:
+		if doc.IsNil() {
+			return "", WithStack(InvalidArgumentError{Message: "Document is nil"})
+		}
 		keyVal := doc.MapIndex(reflect.ValueOf("_key"))
-		if keyVal.IsNil() {
+		if !keyVal.IsValid() {
 			return "", WithStack(InvalidArgumentError{Message: "Document contains no '_key' entry"})
 		}
+		if keyVal.Kind() == reflect.Interface {
+			keyVal = keyVal.Elem()
+		}
+		if keyVal.Kind() != reflect.String {
+			return "", WithStack(InvalidArgumentError{Message: "Document contains a non-string '_key' entry"})
+		}
 		return keyVal.String(), nil
 	default:
 		return "", WithStack(InvalidArgumentError{Message: fmt.Sprintf("Document must be struct or map. Got %s", doc.Kind())})
 	}
 }
+
+// getKeyFromStruct looks for a `_key` field in the given struct, including the fields of
+// anonymous embedded structs (that are not renamed using a json tag), following the rules of encoding/json.
+func getKeyFromStruct(doc reflect.Value) (string, bool) {
+	structType := doc.Type()
+	var embedded []reflect.Value
+	for i := 0; i < structType.NumField(); i++ {
+		f := structType.Field(i)
+		name := strings.Split(f.Tag.Get("json"), ",")[0]
+		if name == "_key" {
+			// We found the _key field
+			return doc.Field(i).String(), true
+		}
+		if f.Anonymous && name == "" {
+			fieldVal := doc.Field(i)
+			if fieldVal.Kind() == reflect.Ptr {
+				if fieldVal.IsNil() {
+					continue
+				}
+				fieldVal = fieldVal.Elem()
+			}
+			if fieldVal.Kind() == reflect.Struct {
+				embedded = append(embedded, fieldVal)
+			}
+		}
+	}
+	// Fields of the struct itself take precedence over fields of embedded structs
+	for _, e := range embedded {
+		if key, found := getKeyFromStruct(e); found {
+			return key, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,75 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package driver
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGetKeyFromDocument(t *testing.T) {
+	type withKey struct {
+		Key string `json:"_key"`
+	}
+	type embeddedMeta struct {
+		DocumentMeta
+		Name string `json:"name"`
+	}
+	type embeddedPtr struct {
+		*DocumentMeta
+		Name string `json:"name"`
+	}
+	type nested struct {
+		embeddedMeta
+	}
+	type renamed struct {
+		DocumentMeta `json:"meta"`
+	}
+	tests := []struct {
+		Doc      interface{}
+		Expected string
+		Error    bool
+	}{
+		{Doc: withKey{Key: "a"}, Expected: "a"},
+		{Doc: &withKey{Key: "b"}, Expected: "b"},
+		{Doc: embeddedMeta{DocumentMeta: DocumentMeta{Key: "c"}}, Expected: "c"},
+		{Doc: &embeddedPtr{DocumentMeta: &DocumentMeta{Key: "d"}}, Expected: "d"},
+		{Doc: nested{embeddedMeta{DocumentMeta: DocumentMeta{Key: "e"}}}, Expected: "e"},
+		{Doc: map[string]interface{}{"_key": "f"}, Expected: "f"},
+		{Doc: embeddedPtr{}, Error: true},
+		{Doc: renamed{DocumentMeta{Key: "g"}}, Error: true},
+		{Doc: map[string]interface{}{}, Error: true},
+		{Doc: (*withKey)(nil), Error: true},
+		{Doc: "foo", Error: true},
+	}
+	for i, test := range tests {
+		key, err := getKeyFromDocument(reflect.ValueOf(test.Doc))
+		if test.Error {
+			if !IsInvalidArgument(err) {
+				t.Errorf("Test %d: expected InvalidArgumentError, got %v", i, err)
+			}
+		} else if err != nil {
+			t.Errorf("Test %d: expected success, got %s", i, err)
+		} else if key != test.Expected {
+			t.Errorf("Test %d: expected key '%s', got '%s'", i, test.Expected, key)
+		}
+	}
+}
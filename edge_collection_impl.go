@@ -109,6 +109,15 @@ func (c *edgeCollection) Revision(ctx context.Context) (string, error) {
 	return result, nil
 }
 
+// Checksum calculates a checksum of the collection.
+func (c *edgeCollection) Checksum(ctx context.Context, withRevisions bool, withData bool) (CollectionChecksum, error) {
+	result, err := c.rawCollection().Checksum(ctx, withRevisions, withData)
+	if err != nil {
+		return CollectionChecksum{}, WithStack(err)
+	}
+	return result, nil
+}
+
 // Properties fetches extended information about the collection.
 func (c *edgeCollection) Properties(ctx context.Context) (CollectionProperties, error) {
 	result, err := c.rawCollection().Properties(ctx)
@@ -126,6 +135,15 @@ func (c *edgeCollection) SetProperties(ctx context.Context, options SetCollectio
 	return nil
 }
 
+// Shards fetches the shards of the collection together with its properties.
+func (c *edgeCollection) Shards(ctx context.Context, details bool) (CollectionShards, error) {
+	result, err := c.rawCollection().Shards(ctx, details)
+	if err != nil {
+		return CollectionShards{}, WithStack(err)
+	}
+	return result, nil
+}
+
 // Load the collection into memory.
 func (c *edgeCollection) Load(ctx context.Context) error {
 	if err := c.rawCollection().Load(ctx); err != nil {
@@ -166,3 +184,29 @@ func (c *edgeCollection) Truncate(ctx context.Context) error {
 	}
 	return nil
 }
+
+// Compact compacts the data of the collection to reclaim disk space.
+func (c *edgeCollection) Compact(ctx context.Context) error {
+	if err := c.rawCollection().Compact(ctx); err != nil {
+		return WithStack(err)
+	}
+	return nil
+}
+
+// LoadIndexesIntoMemory loads the indexes of the collection into memory.
+func (c *edgeCollection) LoadIndexesIntoMemory(ctx context.Context) (bool, error) {
+	result, err := c.rawCollection().LoadIndexesIntoMemory(ctx)
+	if err != nil {
+		return false, WithStack(err)
+	}
+	return result, nil
+}
+
+// Edges returns a cursor over all edges in this (edge) collection that are connected to the given vertex.
+func (c *edgeCollection) Edges(ctx context.Context, vertex DocumentID, direction EdgeDirection) (Cursor, error) {
+	result, err := c.rawCollection().Edges(ctx, vertex, direction)
+	if err != nil {
+		return nil, WithStack(err)
+	}
+	return result, nil
+}
@@ -52,6 +52,26 @@ func (c *edgeCollection) Indexes(ctx context.Context) ([]Index, error) {
 	return result, nil
 }
 
+// IndexByName opens a connection to an existing index within the collection, looking it up by
+// its user provided name.
+// If no index with given user name exists, an NotFoundError is returned.
+func (c *edgeCollection) IndexByName(ctx context.Context, name string) (Index, error) {
+	result, err := c.rawCollection().IndexByName(ctx, name)
+	if err != nil {
+		return nil, WithStack(err)
+	}
+	return result, nil
+}
+
+// DeleteIndexByName removes the index with given user provided name from the collection.
+// If no index with given user name exists, an NotFoundError is returned.
+func (c *edgeCollection) DeleteIndexByName(ctx context.Context, name string) error {
+	if err := c.rawCollection().DeleteIndexByName(ctx, name); err != nil {
+		return WithStack(err)
+	}
+	return nil
+}
+
 // EnsureFullTextIndex creates a fulltext index in the collection, if it does not already exist.
 //
 // Fields is a slice of attribute names. Currently, the slice is limited to exactly one attribute.
@@ -124,3 +144,13 @@ func (c *edgeCollection) EnsureTTLIndex(ctx context.Context, field string, expir
 	}
 	return result, created, nil
 }
+
+// EnsureInvertedIndex creates an inverted index in the collection, if it does not already exist.
+// The index is returned, together with a boolean indicating if the index was newly created (true) or pre-existing (false).
+func (c *edgeCollection) EnsureInvertedIndex(ctx context.Context, options *InvertedIndexOptions) (Index, bool, error) {
+	result, created, err := c.rawCollection().EnsureInvertedIndex(ctx, options)
+	if err != nil {
+		return nil, false, WithStack(err)
+	}
+	return result, created, nil
+}
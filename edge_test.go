@@ -0,0 +1,48 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package driver
+
+import "testing"
+
+func TestParseDocumentID(t *testing.T) {
+	id, err := ParseDocumentID("persons/john")
+	if err != nil {
+		t.Fatalf("Expected success, got %s", err)
+	}
+	if id.Collection() != "persons" || id.Key() != "john" {
+		t.Errorf("Expected 'persons'/'john', got '%s'/'%s'", id.Collection(), id.Key())
+	}
+	for _, input := range []string{"", "persons", "persons/", "/john", "a/b/c"} {
+		if _, err := ParseDocumentID(input); !IsInvalidArgument(err) {
+			t.Errorf("Expected InvalidArgumentError for '%s', got %v", input, err)
+		}
+	}
+}
+
+func TestEdgeDocumentValidate(t *testing.T) {
+	edge := NewEdgeDocument(NewDocumentID("persons", "john"), NewDocumentID("persons", "jane"))
+	if err := edge.Validate(); err != nil {
+		t.Errorf("Expected success, got %s", err)
+	}
+	if err := NewEdgeDocument("persons/john", "").Validate(); !IsInvalidArgument(err) {
+		t.Errorf("Expected InvalidArgumentError, got %v", err)
+	}
+}
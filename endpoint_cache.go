@@ -0,0 +1,114 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package driver
+
+import (
+	"sync"
+	"time"
+)
+
+// defaultEndpointCacheSize is the maximum number of entries kept in an endpointCache.
+const defaultEndpointCacheSize = 1024
+
+// endpointCache maps the IDs of server side resources (such as stream transactions or async jobs)
+// to the endpoint of the coordinator that holds them.
+// A cache is scoped to a single client. When it is full, the oldest entries are evicted.
+type endpointCache struct {
+	mutex     sync.Mutex
+	maxSize   int
+	endpoints map[string]endpointCacheEntry
+	order     []string
+}
+
+// endpointCacheEntry is a single entry of an endpointCache.
+type endpointCacheEntry struct {
+	endpoint string
+	created  time.Time
+}
+
+// newEndpointCache creates a new endpointCache holding at most maxSize entries.
+func newEndpointCache(maxSize int) *endpointCache {
+	return &endpointCache{
+		maxSize:   maxSize,
+		endpoints: make(map[string]endpointCacheEntry),
+	}
+}
+
+// get returns the endpoint stored for the given ID.
+func (c *endpointCache) get(id string) (string, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	entry, found := c.endpoints[id]
+	return entry.endpoint, found
+}
+
+// set stores the endpoint for the given ID, evicting the oldest entries when the cache is full.
+func (c *endpointCache) set(id, endpoint string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if _, found := c.endpoints[id]; !found {
+		c.order = append(c.order, id)
+	}
+	c.endpoints[id] = endpointCacheEntry{endpoint: endpoint, created: time.Now()}
+	for len(c.endpoints) > c.maxSize && len(c.order) > 0 {
+		delete(c.endpoints, c.order[0])
+		c.order = c.order[1:]
+	}
+}
+
+// remove removes the entry for the given ID.
+func (c *endpointCache) remove(id string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if _, found := c.endpoints[id]; !found {
+		return
+	}
+	delete(c.endpoints, id)
+	for i, x := range c.order {
+		if x == id {
+			c.order = append(c.order[:i], c.order[i+1:]...)
+			break
+		}
+	}
+}
+
+// removeBefore removes all entries that were stored before the given time.
+func (c *endpointCache) removeBefore(t time.Time) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	order := c.order[:0]
+	for _, id := range c.order {
+		if c.endpoints[id].created.Before(t) {
+			delete(c.endpoints, id)
+		} else {
+			order = append(order, id)
+		}
+	}
+	c.order = order
+}
+
+// clear removes all entries.
+func (c *endpointCache) clear() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.endpoints = make(map[string]endpointCacheEntry)
+	c.order = nil
+}
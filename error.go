@@ -31,33 +31,6 @@ import (
 	"os"
 )
 
-const (
-	// general errors
-	ErrNotImplemented = 9
-	ErrForbidden      = 11
-	ErrDisabled       = 36
-
-	// HTTP error status codes
-	ErrHttpForbidden = 403
-	ErrHttpInternal  = 501
-
-	// Internal ArangoDB storage errors
-	ErrArangoReadOnly = 1004
-
-	// General ArangoDB storage errors
-	ErrArangoConflict                 = 1200
-	ErrArangoDocumentNotFound         = 1202
-	ErrArangoDataSourceNotFound       = 1203
-	ErrArangoUniqueConstraintViolated = 1210
-
-	// ArangoDB cluster errors
-	ErrClusterLeadershipChallengeOngoing = 1495
-	ErrClusterNotLeader                  = 1496
-
-	// User management errors
-	ErrUserDuplicate = 1702
-)
-
 // ArangoError is a Go error with arangodb specific error information.
 type ArangoError struct {
 	HasError     bool   `json:"error"`
@@ -84,6 +57,33 @@ func (ae ArangoError) Temporary() bool {
 	return ae.HasError && ae.Code == http.StatusServiceUnavailable
 }
 
+// Is returns true when the given target is an ArangoError with the same Code and ErrorNum.
+// A zero Code or ErrorNum in the target matches any value, which makes ArangoErrors usable
+// with errors.Is, e.g. errors.Is(err, driver.ArangoError{ErrorNum: driver.ErrArangoConflict}).
+func (ae ArangoError) Is(target error) bool {
+	t, ok := target.(ArangoError)
+	if !ok || !ae.HasError {
+		return false
+	}
+	return (t.Code == 0 || t.Code == ae.Code) && (t.ErrorNum == 0 || t.ErrorNum == ae.ErrorNum)
+}
+
+// The following ArangoErrors can be used as targets of errors.Is.
+var (
+	// ArangoErrorInvalidRequest matches ArangoErrors with code 400.
+	ArangoErrorInvalidRequest = ArangoError{HasError: true, Code: http.StatusBadRequest}
+	// ArangoErrorUnauthorized matches ArangoErrors with code 401.
+	ArangoErrorUnauthorized = ArangoError{HasError: true, Code: http.StatusUnauthorized}
+	// ArangoErrorForbidden matches ArangoErrors with code 403.
+	ArangoErrorForbidden = ArangoError{HasError: true, Code: http.StatusForbidden}
+	// ArangoErrorNotFound matches ArangoErrors with code 404.
+	ArangoErrorNotFound = ArangoError{HasError: true, Code: http.StatusNotFound}
+	// ArangoErrorConflict matches ArangoErrors with code 409.
+	ArangoErrorConflict = ArangoError{HasError: true, Code: http.StatusConflict}
+	// ArangoErrorPreconditionFailed matches ArangoErrors with code 412.
+	ArangoErrorPreconditionFailed = ArangoError{HasError: true, Code: http.StatusPreconditionFailed}
+)
+
 // newArangoError creates a new ArangoError with given values.
 func newArangoError(code, errorNum int, errorMessage string) error {
 	return ArangoError{
@@ -94,21 +94,38 @@ func newArangoError(code, errorNum int, errorMessage string) error {
 	}
 }
 
+// asArangoError returns the ArangoError the given error is (or is caused by).
+// Errors are unwrapped using Cause and their Unwrap method (as used by errors.Is & errors.As).
+func asArangoError(err error) (ArangoError, bool) {
+	for err != nil {
+		err = Cause(err)
+		if ae, ok := err.(ArangoError); ok {
+			return ae, true
+		}
+		u, ok := err.(interface{ Unwrap() error })
+		if !ok {
+			break
+		}
+		err = u.Unwrap()
+	}
+	return ArangoError{}, false
+}
+
 // IsArangoError returns true when the given error is an ArangoError.
 func IsArangoError(err error) bool {
-	ae, ok := Cause(err).(ArangoError)
+	ae, ok := asArangoError(err)
 	return ok && ae.HasError
 }
 
 // IsArangoErrorWithCode returns true when the given error is an ArangoError and its Code field is equal to the given code.
 func IsArangoErrorWithCode(err error, code int) bool {
-	ae, ok := Cause(err).(ArangoError)
+	ae, ok := asArangoError(err)
 	return ok && ae.Code == code
 }
 
 // IsArangoErrorWithErrorNum returns true when the given error is an ArangoError and its ErrorNum field is equal to one of the given numbers.
 func IsArangoErrorWithErrorNum(err error, errorNum ...int) bool {
-	ae, ok := Cause(err).(ArangoError)
+	ae, ok := asArangoError(err)
 	if !ok {
 		return false
 	}
@@ -180,6 +197,31 @@ func IsInvalidArgument(err error) bool {
 	return ok
 }
 
+// UnsupportedServerVersionError is returned when a feature needs a newer server version
+// than the version of the server that answered the request.
+type UnsupportedServerVersionError struct {
+	// Feature describes the unsupported feature. It is empty for errors returned by RequireVersion.
+	Feature string
+	// Required is the version constraint the server does not satisfy, e.g. ">=3.11".
+	Required string
+	// Actual is the version of the server.
+	Actual Version
+}
+
+// Error implements the error interface for UnsupportedServerVersionError.
+func (e UnsupportedServerVersionError) Error() string {
+	if e.Feature == "" {
+		return fmt.Sprintf("server version %s does not satisfy %s", e.Actual, e.Required)
+	}
+	return fmt.Sprintf("%s requires server version %s, got %s", e.Feature, e.Required, e.Actual)
+}
+
+// IsUnsupportedServerVersion returns true if the given error is an UnsupportedServerVersionError.
+func IsUnsupportedServerVersion(err error) bool {
+	_, ok := Cause(err).(UnsupportedServerVersionError)
+	return ok
+}
+
 // NoMoreDocumentsError is returned by Cursor's, when an attempt is made to read documents when there are no more.
 type NoMoreDocumentsError struct{}
 
@@ -205,6 +247,11 @@ func (e *ResponseError) Error() string {
 	return e.Err.Error()
 }
 
+// Unwrap returns the underlying error.
+func (e *ResponseError) Unwrap() error {
+	return e.Err
+}
+
 // IsResponse returns true if the given error is (or is caused by) a ResponseError.
 func IsResponse(err error) bool {
 	return isCausedBy(err, func(e error) bool { _, ok := e.(*ResponseError); return ok })
@@ -241,6 +288,8 @@ func isCausedBy(err error, p func(error) bool) bool {
 			err = xerr.Err
 		} else if xerr, ok := err.(*os.SyscallError); ok {
 			err = xerr.Err
+		} else if xerr, ok := err.(interface{ Unwrap() error }); ok {
+			err = Cause(xerr.Unwrap())
 		} else {
 			return false
 		}
@@ -0,0 +1,212 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package driver
+
+// Error numbers (errorNum) returned by ArangoDB, as defined in lib/Basics/errors.dat
+// of the ArangoDB server. Use them with IsArangoErrorWithErrorNum or errors.Is
+// (e.g. errors.Is(err, ArangoError{ErrorNum: ErrArangoConflict})).
+const (
+	// general errors
+	ErrNoError             = 0
+	ErrFailed              = 1
+	ErrSysError            = 2
+	ErrOutOfMemory         = 3
+	ErrInternal            = 4
+	ErrIllegalNumber       = 5
+	ErrNumericOverflow     = 6
+	ErrIllegalOption       = 7
+	ErrDeadPid             = 8
+	ErrNotImplemented      = 9
+	ErrBadParameter        = 10
+	ErrForbidden           = 11
+	ErrFileNotFound        = 14
+	ErrCannotWriteFile     = 15
+	ErrLocked              = 18
+	ErrFileExists          = 19
+	ErrDeadlock            = 20
+	ErrShuttingDown        = 21
+	ErrOnlyEnterprise      = 22
+	ErrResourceLimit       = 23
+	ErrCannotReadFile      = 26
+	ErrIncompatibleVersion = 27
+	ErrDisabled            = 36
+
+	// HTTP error status codes
+	ErrHttpBadParameter        = 400
+	ErrHttpUnauthorized        = 401
+	ErrHttpForbidden           = 403
+	ErrHttpNotFound            = 404
+	ErrHttpMethodNotAllowed    = 405
+	ErrHttpNotAcceptable       = 406
+	ErrHttpRequestTimeout      = 408
+	ErrHttpConflict            = 409
+	ErrHttpGone                = 410
+	ErrHttpPreconditionFailed  = 412
+	ErrHttpServerError         = 500
+	ErrHttpNotImplemented      = 501
+	ErrHttpServiceUnavailable  = 503
+	ErrHttpGatewayTimeout      = 504
+	ErrHttpCorruptedJson       = 600
+	ErrHttpSuperfluousSuffices = 601
+	// ErrHttpInternal is kept for backwards compatibility.
+	// Deprecated: Use ErrHttpNotImplemented or ErrHttpServerError.
+	ErrHttpInternal = 501
+
+	// Internal ArangoDB storage errors
+	ErrArangoIllegalState        = 1000
+	ErrArangoReadOnly            = 1004
+	ErrArangoDuplicateIdentifier = 1005
+
+	// General ArangoDB storage errors
+	ErrArangoConflict                   = 1200
+	ErrArangoDocumentNotFound           = 1202
+	ErrArangoDataSourceNotFound         = 1203
+	ErrArangoCollectionParameterMissing = 1204
+	ErrArangoDocumentHandleBad          = 1205
+	ErrArangoDuplicateName              = 1207
+	ErrArangoIllegalName                = 1208
+	ErrArangoUniqueConstraintViolated   = 1210
+	ErrArangoIndexNotFound              = 1212
+	ErrArangoCrossCollectionRequest     = 1213
+	ErrArangoIndexHandleBad             = 1214
+	ErrArangoDocumentTooLarge           = 1216
+	ErrArangoDocumentKeyBad             = 1221
+	ErrArangoDocumentKeyUnexpected      = 1222
+	ErrArangoDatadirNotWritable         = 1224
+	ErrArangoOutOfKeys                  = 1225
+	ErrArangoDocumentKeyMissing         = 1226
+	ErrArangoDocumentTypeInvalid        = 1227
+	ErrArangoDatabaseNotFound           = 1228
+	ErrArangoDatabaseNameInvalid        = 1229
+	ErrArangoUseSystemDatabase          = 1230
+	ErrArangoInvalidKeyGenerator        = 1232
+	ErrArangoInvalidEdgeAttribute       = 1233
+	ErrArangoIndexCreationFailed        = 1235
+	ErrArangoCollectionTypeMismatch     = 1237
+	ErrArangoCollectionNotLoaded        = 1238
+	ErrArangoDocumentRevBad             = 1239
+	ErrArangoIncompleteRead             = 1240
+
+	// Replication errors
+	ErrReplicationNoResponse      = 1400
+	ErrReplicationInvalidResponse = 1401
+	ErrReplicationLeaderError     = 1402
+
+	// ArangoDB cluster errors
+	ErrClusterTimeout                    = 1457
+	ErrClusterLeadershipChallengeOngoing = 1495
+	ErrClusterNotLeader                  = 1496
+
+	// Query errors
+	ErrQueryKilled                         = 1500
+	ErrQueryParse                          = 1501
+	ErrQueryEmpty                          = 1502
+	ErrQueryScript                         = 1503
+	ErrQueryNumberOutOfRange               = 1504
+	ErrQueryVariableNameInvalid            = 1510
+	ErrQueryVariableRedeclared             = 1511
+	ErrQueryVariableNameUnknown            = 1512
+	ErrQueryCollectionLockFailed           = 1521
+	ErrQueryTooManyCollections             = 1522
+	ErrQueryFunctionNameUnknown            = 1540
+	ErrQueryFunctionArgumentNumberMismatch = 1541
+	ErrQueryFunctionArgumentTypeMismatch   = 1542
+	ErrQueryBindParameterMissing           = 1552
+	ErrQueryBindParameterUndeclared        = 1553
+	ErrQueryBindParameterType              = 1554
+	ErrQueryDivisionByZero                 = 1562
+
+	// Cursor errors
+	ErrCursorNotFound = 1600
+	ErrCursorBusy     = 1601
+
+	// Transaction errors
+	ErrTransactionInternal               = 1650
+	ErrTransactionNested                 = 1651
+	ErrTransactionUnregisteredCollection = 1652
+	ErrTransactionDisallowedOperation    = 1653
+	ErrTransactionAborted                = 1654
+	ErrTransactionNotFound               = 1655
+
+	// User management errors
+	ErrUserInvalidName = 1700
+	ErrUserDuplicate   = 1702
+	ErrUserNotFound    = 1703
+	ErrUserExternal    = 1705
+
+	// Task errors
+	ErrTaskInvalidID   = 1850
+	ErrTaskDuplicateID = 1851
+	ErrTaskNotFound    = 1852
+
+	// Graph errors
+	ErrGraphInvalidGraph = 1901
+	ErrGraphNotFound     = 1924
+	ErrGraphDuplicate    = 1925
+
+	// Scheduler errors
+	ErrQueueFull                    = 21003
+	ErrQueueTimeRequirementViolated = 21004
+)
+
+// IsUniqueConstraintViolated returns true if the given error is an ArangoError caused by
+// a violated unique constraint (e.g. a document with the same key already exists).
+func IsUniqueConstraintViolated(err error) bool {
+	return IsArangoErrorWithErrorNum(err, ErrArangoUniqueConstraintViolated)
+}
+
+// IsDuplicateName returns true if the given error is an ArangoError caused by
+// creating a collection, view or database with a name that is already in use.
+func IsDuplicateName(err error) bool {
+	return IsArangoErrorWithErrorNum(err, ErrArangoDuplicateName)
+}
+
+// IsDocumentNotFound returns true if the given error is an ArangoError caused by a missing document.
+func IsDocumentNotFound(err error) bool {
+	return IsArangoErrorWithErrorNum(err, ErrArangoDocumentNotFound)
+}
+
+// IsDataSourceNotFound returns true if the given error is an ArangoError caused by
+// a missing collection or view.
+func IsDataSourceNotFound(err error) bool {
+	return IsArangoErrorWithErrorNum(err, ErrArangoDataSourceNotFound)
+}
+
+// IsDatabaseNotFound returns true if the given error is an ArangoError caused by a missing database.
+func IsDatabaseNotFound(err error) bool {
+	return IsArangoErrorWithErrorNum(err, ErrArangoDatabaseNotFound)
+}
+
+// IsCursorNotFound returns true if the given error is an ArangoError caused by
+// a cursor that no longer exists on the server (e.g. because its TTL expired).
+func IsCursorNotFound(err error) bool {
+	return IsArangoErrorWithErrorNum(err, ErrCursorNotFound)
+}
+
+// IsQueryParseError returns true if the given error is an ArangoError caused by an invalid AQL query.
+func IsQueryParseError(err error) bool {
+	return IsArangoErrorWithErrorNum(err, ErrQueryParse)
+}
+
+// IsTransactionAborted returns true if the given error is an ArangoError caused by an aborted transaction.
+func IsTransactionAborted(err error) bool {
+	return IsArangoErrorWithErrorNum(err, ErrTransactionAborted)
+}
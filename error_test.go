@@ -0,0 +1,87 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package driver
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+// TestArangoErrorIs tests errors.Is support of ArangoError.
+func TestArangoErrorIs(t *testing.T) {
+	err := WithStack(ArangoError{HasError: true, Code: http.StatusConflict, ErrorNum: ErrArangoConflict})
+	wrapped := fmt.Errorf("insert failed: %w", err)
+
+	if !errors.Is(wrapped, ArangoErrorConflict) {
+		t.Error("Expected wrapped error to match ArangoErrorConflict")
+	}
+	if !errors.Is(wrapped, ArangoError{ErrorNum: ErrArangoConflict}) {
+		t.Error("Expected wrapped error to match error number")
+	}
+	if errors.Is(wrapped, ArangoErrorNotFound) {
+		t.Error("Expected wrapped error not to match ArangoErrorNotFound")
+	}
+	var ae ArangoError
+	if !errors.As(wrapped, &ae) || ae.Code != http.StatusConflict {
+		t.Errorf("Expected errors.As to find ArangoError, got %v", ae)
+	}
+}
+
+// TestIsArangoErrorWrapped tests the Is* predicates with wrapped errors.
+func TestIsArangoErrorWrapped(t *testing.T) {
+	err := fmt.Errorf("lookup: %w", &ResponseError{Err: WithStack(ArangoError{HasError: true, Code: http.StatusNotFound, ErrorNum: ErrArangoDocumentNotFound})})
+
+	if !IsArangoError(err) {
+		t.Error("Expected IsArangoError to be true")
+	}
+	if !IsNotFound(err) {
+		t.Error("Expected IsNotFound to be true")
+	}
+	if !IsArangoErrorWithErrorNum(err, ErrArangoDocumentNotFound) {
+		t.Error("Expected IsArangoErrorWithErrorNum to be true")
+	}
+	if IsConflict(err) {
+		t.Error("Expected IsConflict to be false")
+	}
+	if IsArangoError(fmt.Errorf("plain: %w", errors.New("other"))) {
+		t.Error("Expected IsArangoError to be false for non arango errors")
+	}
+}
+
+// TestErrorNumberPredicates tests the error number based predicates.
+func TestErrorNumberPredicates(t *testing.T) {
+	err := WithStack(ArangoError{HasError: true, Code: http.StatusConflict, ErrorNum: ErrArangoUniqueConstraintViolated})
+	if !IsUniqueConstraintViolated(err) {
+		t.Error("Expected IsUniqueConstraintViolated to be true")
+	}
+	if IsDuplicateName(err) {
+		t.Error("Expected IsDuplicateName to be false")
+	}
+	err = WithStack(ArangoError{HasError: true, Code: http.StatusNotFound, ErrorNum: ErrCursorNotFound})
+	if !IsCursorNotFound(err) {
+		t.Error("Expected IsCursorNotFound to be true")
+	}
+	if IsDocumentNotFound(err) {
+		t.Error("Expected IsDocumentNotFound to be false")
+	}
+}
@@ -0,0 +1,41 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+/*
+Package geo provides GeoJSON types and helpers for geo-spatial AQL queries.
+
+Point, LineString and Polygon encode to and decode from GeoJSON, so they can be
+stored in documents and indexed by a geo index with GeoJSON set to true:
+
+	type Shop struct {
+		Name     string    `json:"name"`
+		Location geo.Point `json:"location"`
+	}
+	shop := Shop{Name: "Corner shop", Location: geo.NewPoint(6.96, 50.94)}
+
+Near, Contains and Intersects create aql queries that filter a collection in a way
+the geo index can be used for. Add the remaining operations to the returned query:
+
+	query, bindVars, err := geo.Near("s", "shops", "location", geo.NewPoint(6.95, 50.94), 1000).
+		Limit(0, 10).
+		Return("s").
+		Build()
+*/
+package geo
@@ -0,0 +1,101 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package geo
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestGeoJSONRoundTrip(t *testing.T) {
+	tests := []struct {
+		geometry Geometry
+		expected string
+		target   interface{}
+	}{
+		{NewPoint(6.96, 50.94), `{"type":"Point","coordinates":[6.96,50.94]}`, &Point{}},
+		{NewLineString(Position{0, 0}, Position{1, 1}), `{"type":"LineString","coordinates":[[0,0],[1,1]]}`, &LineString{}},
+		{NewPolygon([]Position{{0, 0}, {1, 0}, {1, 1}}), `{"type":"Polygon","coordinates":[[[0,0],[1,0],[1,1],[0,0]]]}`, &Polygon{}},
+	}
+	for _, test := range tests {
+		data, err := json.Marshal(test.geometry)
+		if err != nil {
+			t.Fatalf("Marshal of %s failed: %s", test.geometry.GeoJSONType(), err)
+		}
+		if string(data) != test.expected {
+			t.Errorf("Expected %s, got %s", test.expected, data)
+		}
+		if err := json.Unmarshal(data, test.target); err != nil {
+			t.Fatalf("Unmarshal of %s failed: %s", test.geometry.GeoJSONType(), err)
+		}
+		if actual := reflect.ValueOf(test.target).Elem().Interface(); !reflect.DeepEqual(actual, test.geometry) {
+			t.Errorf("Expected %+v after round trip, got %+v", test.geometry, actual)
+		}
+	}
+}
+
+func TestGeoJSONErrors(t *testing.T) {
+	var p Point
+	if err := json.Unmarshal([]byte(`{"type":"LineString","coordinates":[[0,0],[1,1]]}`), &p); err == nil {
+		t.Error("Expected error when decoding a LineString into a Point")
+	}
+	if _, err := json.Marshal(NewLineString(Position{0, 0})); err == nil {
+		t.Error("Expected error for line string with a single position")
+	}
+	if _, err := json.Marshal(Polygon{Coordinates: [][]Position{{{0, 0}, {1, 0}, {1, 1}}}}); err == nil {
+		t.Error("Expected error for polygon with an open ring")
+	}
+}
+
+func TestNear(t *testing.T) {
+	center := NewPoint(6.95, 50.94)
+	query, bindVars, err := Near("s", "shops", "address.location", center, 1000).Limit(0, 10).Return("s").Build()
+	if err != nil {
+		t.Fatalf("Build failed: %s", err)
+	}
+	expectedQuery := "FOR s IN @@p0 LET distance = GEO_DISTANCE(@p1, s.address.location) FILTER distance <= @p2 SORT distance ASC LIMIT 0, 10 RETURN s"
+	if query != expectedQuery {
+		t.Errorf("Expected query '%s', got '%s'", expectedQuery, query)
+	}
+	expectedBindVars := map[string]interface{}{"@p0": "shops", "p1": center, "p2": float64(1000)}
+	if !reflect.DeepEqual(bindVars, expectedBindVars) {
+		t.Errorf("Expected bind variables %v, got %v", expectedBindVars, bindVars)
+	}
+}
+
+func TestContainsAndIntersects(t *testing.T) {
+	area := NewPolygon([]Position{{0, 0}, {1, 0}, {1, 1}, {0, 1}})
+	query, _, err := Contains("d", "places", "geo-location", area).Return("d").Build()
+	if err != nil {
+		t.Fatalf("Build failed: %s", err)
+	}
+	if expected := "FOR d IN @@p0 FILTER GEO_CONTAINS(@p1, d.`geo-location`) RETURN d"; query != expected {
+		t.Errorf("Expected query '%s', got '%s'", expected, query)
+	}
+	query, _, err = Intersects("d", "places", "area", area).Return("d").Build()
+	if err != nil {
+		t.Fatalf("Build failed: %s", err)
+	}
+	if expected := "FOR d IN @@p0 FILTER GEO_INTERSECTS(@p1, d.area) RETURN d"; query != expected {
+		t.Errorf("Expected query '%s', got '%s'", expected, query)
+	}
+}
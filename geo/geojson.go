@@ -0,0 +1,160 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package geo
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	typePoint      = "Point"
+	typeLineString = "LineString"
+	typePolygon    = "Polygon"
+)
+
+// Geometry is a GeoJSON geometry.
+type Geometry interface {
+	json.Marshaler
+	// GeoJSONType returns the GeoJSON type of the geometry, e.g. "Point".
+	GeoJSONType() string
+}
+
+// Position is a GeoJSON position. Note that GeoJSON puts the longitude first.
+type Position [2]float64
+
+// Longitude returns the longitude of the position.
+func (p Position) Longitude() float64 { return p[0] }
+
+// Latitude returns the latitude of the position.
+func (p Position) Latitude() float64 { return p[1] }
+
+// Point is a GeoJSON Point.
+type Point struct {
+	Coordinates Position
+}
+
+// NewPoint creates a point at the given longitude and latitude.
+func NewPoint(longitude, latitude float64) Point {
+	return Point{Coordinates: Position{longitude, latitude}}
+}
+
+// GeoJSONType returns "Point".
+func (p Point) GeoJSONType() string { return typePoint }
+
+// MarshalJSON encodes the point as GeoJSON.
+func (p Point) MarshalJSON() ([]byte, error) {
+	return marshalGeometry(typePoint, p.Coordinates)
+}
+
+// UnmarshalJSON decodes the point from GeoJSON.
+func (p *Point) UnmarshalJSON(data []byte) error {
+	return unmarshalGeometry(data, typePoint, &p.Coordinates)
+}
+
+// LineString is a GeoJSON LineString.
+type LineString struct {
+	Coordinates []Position
+}
+
+// NewLineString creates a line string through the given positions.
+func NewLineString(positions ...Position) LineString {
+	return LineString{Coordinates: positions}
+}
+
+// GeoJSONType returns "LineString".
+func (l LineString) GeoJSONType() string { return typeLineString }
+
+// MarshalJSON encodes the line string as GeoJSON.
+func (l LineString) MarshalJSON() ([]byte, error) {
+	if len(l.Coordinates) < 2 {
+		return nil, fmt.Errorf("line string needs at least 2 positions, got %d", len(l.Coordinates))
+	}
+	return marshalGeometry(typeLineString, l.Coordinates)
+}
+
+// UnmarshalJSON decodes the line string from GeoJSON.
+func (l *LineString) UnmarshalJSON(data []byte) error {
+	return unmarshalGeometry(data, typeLineString, &l.Coordinates)
+}
+
+// Polygon is a GeoJSON Polygon.
+// The first ring is the outer boundary, further rings are holes.
+type Polygon struct {
+	Coordinates [][]Position
+}
+
+// NewPolygon creates a polygon with the given outer ring and holes.
+// Rings that are not closed are closed by repeating their first position.
+func NewPolygon(outer []Position, holes ...[]Position) Polygon {
+	rings := make([][]Position, 0, 1+len(holes))
+	for _, ring := range append([][]Position{outer}, holes...) {
+		if n := len(ring); n > 0 && ring[0] != ring[n-1] {
+			ring = append(append([]Position{}, ring...), ring[0])
+		}
+		rings = append(rings, ring)
+	}
+	return Polygon{Coordinates: rings}
+}
+
+// GeoJSONType returns "Polygon".
+func (p Polygon) GeoJSONType() string { return typePolygon }
+
+// MarshalJSON encodes the polygon as GeoJSON.
+func (p Polygon) MarshalJSON() ([]byte, error) {
+	if len(p.Coordinates) == 0 {
+		return nil, fmt.Errorf("polygon has no rings")
+	}
+	for i, ring := range p.Coordinates {
+		if n := len(ring); n < 4 || ring[0] != ring[n-1] {
+			return nil, fmt.Errorf("ring %d of polygon must be closed and have at least 4 positions", i)
+		}
+	}
+	return marshalGeometry(typePolygon, p.Coordinates)
+}
+
+// UnmarshalJSON decodes the polygon from GeoJSON.
+func (p *Polygon) UnmarshalJSON(data []byte) error {
+	return unmarshalGeometry(data, typePolygon, &p.Coordinates)
+}
+
+// geometry is the GeoJSON representation of a geometry.
+type geometry struct {
+	Type        string      `json:"type"`
+	Coordinates interface{} `json:"coordinates"`
+}
+
+// marshalGeometry encodes a geometry of the given type with the given coordinates.
+func marshalGeometry(geoType string, coordinates interface{}) ([]byte, error) {
+	return json.Marshal(geometry{Type: geoType, Coordinates: coordinates})
+}
+
+// unmarshalGeometry decodes a geometry of the given type into the given coordinates.
+func unmarshalGeometry(data []byte, geoType string, coordinates interface{}) error {
+	g := geometry{Coordinates: coordinates}
+	if err := json.Unmarshal(data, &g); err != nil {
+		return err
+	}
+	if g.Type != geoType {
+		return fmt.Errorf("expected GeoJSON type '%s', got '%s'", geoType, g.Type)
+	}
+	return nil
+}
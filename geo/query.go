@@ -0,0 +1,67 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package geo
+
+import (
+	"strings"
+
+	"github.com/arangodb/go-driver/aql"
+)
+
+// Near creates a query that iterates over the documents of the given collection whose GeoJSON attribute
+// lies within radius meters of center, sorted by distance (closest first).
+// The distance in meters is available in the `distance` variable.
+func Near(variable, collection, attribute string, center Point, radius float64) *aql.Query {
+	distance := "GEO_DISTANCE(?, " + attributePath(variable, attribute) + ")"
+	return aql.New().
+		For(variable, collection).
+		Raw("LET distance = "+distance, center).
+		Filter("distance <= ?", radius).
+		Sort("distance", aql.Ascending)
+}
+
+// Contains creates a query that iterates over the documents of the given collection whose GeoJSON attribute
+// is fully contained in the given area.
+func Contains(variable, collection, attribute string, area Geometry) *aql.Query {
+	return aql.New().
+		For(variable, collection).
+		Filter("GEO_CONTAINS(?, "+attributePath(variable, attribute)+")", area)
+}
+
+// Intersects creates a query that iterates over the documents of the given collection whose GeoJSON attribute
+// intersects with the given geometry.
+func Intersects(variable, collection, attribute string, geometry Geometry) *aql.Query {
+	return aql.New().
+		For(variable, collection).
+		Filter("GEO_INTERSECTS(?, "+attributePath(variable, attribute)+")", geometry)
+}
+
+// attributePath returns the AQL expression accessing the given (dot separated) attribute of the given variable.
+// Attribute names that are not valid unquoted names are quoted.
+func attributePath(variable, attribute string) string {
+	parts := strings.Split(attribute, ".")
+	for i, part := range parts {
+		if !aql.IsValidName(part) {
+			parts[i] = aql.Name(part)
+		}
+	}
+	return variable + "." + strings.Join(parts, ".")
+}
@@ -30,9 +30,22 @@ type Graph interface {
 	Name() string
 
 	// Remove removes the entire graph.
+	// The collections of the graph are kept, unless the context was prepared with `WithDropCollections`.
 	// If the graph does not exist, a NotFoundError is returned.
 	Remove(ctx context.Context) error
 
+	// EdgeDefinitions returns the edge definitions of the graph, as they were when the graph was opened.
+	EdgeDefinitions() []EdgeDefinition
+
+	// OrphanCollections returns the names of the vertex collections of the graph that are not used in any edge definition,
+	// as they were when the graph was opened.
+	OrphanCollections() []string
+
+	// Edges returns a cursor over all edges in any edge collection of the graph that are connected to the given vertex
+	// in the given direction. An empty direction is treated as EdgeDirectionAny.
+	// Note that the returned Cursor must always be closed.
+	Edges(ctx context.Context, vertex DocumentID, direction EdgeDirection) (Cursor, error)
+
 	// IsSmart returns true of smart is smart. In case of Community Edition it is always false
 	IsSmart() bool
 
@@ -46,6 +46,11 @@ type GraphEdgeCollections interface {
 
 	// SetVertexConstraints modifies the vertex constraints of an existing edge collection in the graph.
 	SetVertexConstraints(ctx context.Context, collection string, constraints VertexConstraints) error
+
+	// RemoveEdgeCollection removes the edge definition of the given edge collection from the graph.
+	// The collection itself is kept, unless the context was prepared with `WithDropCollections`.
+	// If the edge collection is not part of the graph, a NotFoundError is returned.
+	RemoveEdgeCollection(ctx context.Context, collection string) error
 }
 
 // VertexConstraints limit the vertex collection you can use in an edge.
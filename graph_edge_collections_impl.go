@@ -33,7 +33,8 @@ type graphDefinition struct {
 	IsSatellite bool   `json:"isSatellite"`
 	IsDisjoint  bool   `json:"isDisjoint,omitempty"`
 
-	EdgeDefinitions []EdgeDefinition `json:"edgeDefinitions,omitempty"`
+	EdgeDefinitions   []EdgeDefinition `json:"edgeDefinitions,omitempty"`
+	OrphanCollections []string         `json:"orphanCollections,omitempty"`
 }
 
 type getGraphResponse struct {
@@ -185,3 +186,20 @@ func (g *graph) SetVertexConstraints(ctx context.Context, collection string, con
 	}
 	return nil
 }
+
+// RemoveEdgeCollection removes the edge definition of the given edge collection from the graph.
+func (g *graph) RemoveEdgeCollection(ctx context.Context, collection string) error {
+	req, err := g.conn.NewRequest("DELETE", path.Join(g.relPath(), "edge", pathEscape(collection)))
+	if err != nil {
+		return WithStack(err)
+	}
+	applyContextSettings(ctx, req)
+	resp, err := g.conn.Do(ctx, req)
+	if err != nil {
+		return WithStack(err)
+	}
+	if err := resp.CheckStatus(201, 202); err != nil {
+		return WithStack(err)
+	}
+	return nil
+}
@@ -60,6 +60,36 @@ func (g *graph) IsSatellite() bool {
 	return g.input.IsSatellite
 }
 
+// EdgeDefinitions returns the edge definitions of the graph, as they were when the graph was opened.
+func (g *graph) EdgeDefinitions() []EdgeDefinition {
+	return g.input.EdgeDefinitions
+}
+
+// OrphanCollections returns the names of the vertex collections of the graph that are not used in any edge definition.
+func (g *graph) OrphanCollections() []string {
+	return g.input.OrphanCollections
+}
+
+// Edges returns a cursor over all edges in any edge collection of the graph that are connected to the given vertex.
+func (g *graph) Edges(ctx context.Context, vertex DocumentID, direction EdgeDirection) (Cursor, error) {
+	if err := vertex.Validate(); err != nil {
+		return nil, WithStack(InvalidArgumentError{Message: err.Error()})
+	}
+	dir, err := direction.traversalDirection()
+	if err != nil {
+		return nil, WithStack(err)
+	}
+	bindVars := map[string]interface{}{
+		"graph":  g.Name(),
+		"vertex": vertex.String(),
+	}
+	cursor, err := g.db.Query(ctx, "FOR v, e IN 1..1 "+dir+" @vertex GRAPH @graph RETURN e", bindVars)
+	if err != nil {
+		return nil, WithStack(err)
+	}
+	return cursor, nil
+}
+
 // relPath creates the relative path to this graph (`_db/<db-name>/_api/gharial/<graph-name>`)
 func (g *graph) relPath() string {
 	escapedName := pathEscape(g.Name())
@@ -78,6 +108,7 @@ func (g *graph) Remove(ctx context.Context) error {
 	if err != nil {
 		return WithStack(err)
 	}
+	applyContextSettings(ctx, req)
 	resp, err := g.conn.Do(ctx, req)
 	if err != nil {
 		return WithStack(err)
@@ -25,10 +25,14 @@ package http
 import (
 	"context"
 	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
+	"strings"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	driver "github.com/arangodb/go-driver"
 )
@@ -45,6 +49,16 @@ type httpAuthentication interface {
 	Configure(req driver.Request) error
 }
 
+// renewableAuthentication is implemented by authentications that can obtain new credentials
+// by calling Prepare again.
+type renewableAuthentication interface {
+	// NeedsRenewal returns true if the credentials are about to expire.
+	NeedsRenewal() bool
+}
+
+// jwtRenewalMargin is the time before the expiry of a JWT token at which it is renewed.
+const jwtRenewalMargin = time.Minute
+
 // IsAuthenticationTheSame checks whether two authentications are the same.
 func IsAuthenticationTheSame(auth1, auth2 driver.Authentication) bool {
 
@@ -116,10 +130,13 @@ func (a *basicAuthentication) Configure(req driver.Request) error {
 }
 
 // jwtAuthentication implements JWT token authentication.
+// The token is renewed shortly before it expires.
 type jwtAuthentication struct {
 	userName string
 	password string
+	mutex    sync.RWMutex
 	token    string
+	expires  time.Time
 }
 
 type jwtOpenRequest struct {
@@ -160,7 +177,10 @@ func (a *jwtAuthentication) Prepare(ctx context.Context, conn driver.Connection)
 	}
 
 	// Store token
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
 	a.token = data.Token
+	a.expires = jwtExpiry(data.Token)
 
 	// Ok
 	return nil
@@ -168,10 +188,39 @@ func (a *jwtAuthentication) Prepare(ctx context.Context, conn driver.Connection)
 
 // Configure is called for every request made on a connection.
 func (a *jwtAuthentication) Configure(req driver.Request) error {
+	a.mutex.RLock()
+	defer a.mutex.RUnlock()
 	req.SetHeader("Authorization", "bearer "+a.token)
 	return nil
 }
 
+// NeedsRenewal returns true if the token is about to expire.
+func (a *jwtAuthentication) NeedsRenewal() bool {
+	a.mutex.RLock()
+	defer a.mutex.RUnlock()
+	return !a.expires.IsZero() && time.Now().Add(jwtRenewalMargin).After(a.expires)
+}
+
+// jwtExpiry returns the expiry time of the given JWT token.
+// If the token has no (readable) expiry, the zero time is returned.
+func jwtExpiry(token string) time.Time {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return time.Time{}
+	}
+	var claims struct {
+		Expires float64 `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Expires <= 0 {
+		return time.Time{}
+	}
+	return time.Unix(int64(claims.Expires), 0)
+}
+
 // newAuthenticatedConnection creates a Connection that applies the given connection on the given underlying connection.
 func newAuthenticatedConnection(conn driver.Connection, auth httpAuthentication) (driver.Connection, error) {
 	if conn == nil {
@@ -192,6 +241,8 @@ type authenticatedConnection struct {
 	auth         httpAuthentication
 	prepareMutex sync.Mutex
 	prepared     int32
+	// generation is incremented every time the authentication is prepared.
+	generation uint32
 }
 
 // NewRequest creates a new request with given method and path.
@@ -204,21 +255,39 @@ func (c *authenticatedConnection) NewRequest(method, path string) (driver.Reques
 }
 
 // Do performs a given request, returning its response.
+// When the credentials of a renewable authentication are rejected, they are renewed and the request is retried once.
 func (c *authenticatedConnection) Do(ctx context.Context, req driver.Request) (driver.Response, error) {
-	if atomic.LoadInt32(&c.prepared) == 0 {
-		// Probably we're not yet prepared
+	_, isRenewable := c.auth.(renewableAuthentication)
+	if atomic.LoadInt32(&c.prepared) == 0 || c.needsRenewal() {
+		// Probably we're not yet prepared, or our credentials are about to expire
 		if err := c.prepare(ctx); err != nil {
 			// Authentication failed
 			return nil, driver.WithStack(err)
 		}
 	}
+	var retryReq driver.Request
+	if isRenewable {
+		retryReq = req.Clone()
+	}
 	// Configure the request for authentication.
+	generation := atomic.LoadUint32(&c.generation)
 	if err := c.auth.Configure(req); err != nil {
 		// Failed to configure request for authentication
 		return nil, driver.WithStack(err)
 	}
 	// Do the authenticated request
 	resp, err := c.conn.Do(ctx, req)
+	// A rejection without a JSON body is returned as an error instead of a response
+	if isRenewable && (driver.IsUnauthorized(err) || (err == nil && resp.StatusCode() == http.StatusUnauthorized)) {
+		// Credentials may have expired, renew them and try again
+		if err := c.renew(ctx, generation); err != nil {
+			return nil, driver.WithStack(err)
+		}
+		if err := c.auth.Configure(retryReq); err != nil {
+			return nil, driver.WithStack(err)
+		}
+		resp, err = c.conn.Do(ctx, retryReq)
+	}
 	if err != nil {
 		return nil, driver.WithStack(err)
 	}
@@ -260,11 +329,17 @@ func (c *authenticatedConnection) Protocols() driver.ProtocolSet {
 	return c.conn.Protocols()
 }
 
+// needsRenewal returns true if the credentials of the authentication are about to expire.
+func (c *authenticatedConnection) needsRenewal() bool {
+	renewable, ok := c.auth.(renewableAuthentication)
+	return ok && renewable.NeedsRenewal()
+}
+
 // prepare calls Authentication.Prepare if needed.
 func (c *authenticatedConnection) prepare(ctx context.Context) error {
 	c.prepareMutex.Lock()
 	defer c.prepareMutex.Unlock()
-	if c.prepared == 0 {
+	if c.prepared == 0 || c.needsRenewal() {
 		// We need to prepare first
 		if err := c.auth.Prepare(ctx, c.conn); err != nil {
 			// Authentication failed
@@ -272,8 +347,27 @@ func (c *authenticatedConnection) prepare(ctx context.Context) error {
 		}
 		// We're now prepared
 		atomic.StoreInt32(&c.prepared, 1)
+		atomic.AddUint32(&c.generation, 1)
 	} else {
 		// We're already prepared, do nothing
 	}
 	return nil
 }
+
+// renew calls Authentication.Prepare after the credentials of the given generation were rejected.
+// If the credentials have been renewed since (e.g. by a concurrent request), they are not renewed again.
+func (c *authenticatedConnection) renew(ctx context.Context, generation uint32) error {
+	c.prepareMutex.Lock()
+	defer c.prepareMutex.Unlock()
+	if atomic.LoadUint32(&c.generation) != generation {
+		// Already renewed
+		return nil
+	}
+	if err := c.auth.Prepare(ctx, c.conn); err != nil {
+		// Authentication failed
+		return driver.WithStack(err)
+	}
+	atomic.StoreInt32(&c.prepared, 1)
+	atomic.AddUint32(&c.generation, 1)
+	return nil
+}
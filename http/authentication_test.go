@@ -23,10 +23,20 @@
 package http
 
 import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/arangodb/go-driver"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestIsAuthenticationTheSame(t *testing.T) {
@@ -73,3 +83,93 @@ func TestIsAuthenticationTheSame(t *testing.T) {
 	}
 
 }
+
+func testJWTToken(expires time.Time, id string) string {
+	payload := base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(`{"exp":%d,"id":"%s"}`, expires.Unix(), id)))
+	return "header." + payload + ".signature"
+}
+
+func TestJWTExpiry(t *testing.T) {
+	expires := time.Now().Add(time.Hour).Truncate(time.Second)
+	assert.True(t, expires.Equal(jwtExpiry(testJWTToken(expires, "a"))))
+	assert.True(t, jwtExpiry("not-a-token").IsZero())
+}
+
+// newJWTRenewalServer returns a server that only accepts the second JWT token it hands out.
+// Rejections are sent as JSON, or as plain text when plainText is set.
+func newJWTRenewalServer(tokens *int32, plainText bool) *httptest.Server {
+	expires := time.Now().Add(time.Hour)
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/_open/auth" {
+			n := atomic.AddInt32(tokens, 1)
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprintf(w, `{"jwt":"%s"}`, testJWTToken(expires, strconv.Itoa(int(n))))
+			return
+		}
+		if r.Header.Get("Authorization") != "bearer "+testJWTToken(expires, "2") {
+			// Only the second token is accepted
+			if plainText {
+				w.Header().Set("Content-Type", "text/plain")
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprint(w, "Unauthorized")
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"error":true,"code":401}`)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{}`)
+	}))
+}
+
+func TestJWTAuthenticationRenewal(t *testing.T) {
+	for _, plainText := range []bool{false, true} {
+		var tokens int32
+		server := newJWTRenewalServer(&tokens, plainText)
+		defer server.Close()
+
+		conn, err := newHTTPConnection(server.URL, ConnectionConfig{})
+		require.NoError(t, err)
+		authConn, err := newAuthenticatedConnection(conn, newJWTAuthentication("root", ""))
+		require.NoError(t, err)
+		req, err := authConn.NewRequest("GET", "_api/version")
+		require.NoError(t, err)
+		resp, err := authConn.Do(context.Background(), req)
+		require.NoError(t, err, "plainText=%v", plainText)
+		assert.Equal(t, http.StatusOK, resp.StatusCode())
+		assert.Equal(t, int32(2), atomic.LoadInt32(&tokens))
+	}
+}
+
+func TestJWTAuthenticationConcurrentRenewal(t *testing.T) {
+	var tokens int32
+	server := newJWTRenewalServer(&tokens, false)
+	defer server.Close()
+
+	conn, err := newHTTPConnection(server.URL, ConnectionConfig{})
+	require.NoError(t, err)
+	authConn, err := newAuthenticatedConnection(conn, newJWTAuthentication("root", ""))
+	require.NoError(t, err)
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req, err := authConn.NewRequest("GET", "_api/version")
+			if err == nil {
+				_, err = authConn.Do(context.Background(), req)
+			}
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		require.NoError(t, err)
+	}
+	// Rejected requests share a single renewal
+	assert.Equal(t, int32(2), atomic.LoadInt32(&tokens))
+}
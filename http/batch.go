@@ -0,0 +1,190 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package http
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"net/url"
+	"strconv"
+	"strings"
+
+	driver "github.com/arangodb/go-driver"
+)
+
+const (
+	batchPartContentType = "application/x-arango-batchpart"
+)
+
+// Batch collects requests that are sent to the server in a single HTTP request,
+// using the `_api/batch` API.
+// Note that the batch API is deprecated since ArangoDB 3.8.
+type Batch struct {
+	conn     driver.Connection
+	requests []driver.Request
+}
+
+// NewBatch creates a new batch of requests that are sent using the given connection.
+// The connection must be a HTTP connection.
+func NewBatch(conn driver.Connection) *Batch {
+	return &Batch{conn: conn}
+}
+
+// NewRequest creates a new request with given method and path and adds it to the batch.
+func (b *Batch) NewRequest(method, path string) (driver.Request, error) {
+	req, err := b.conn.NewRequest(method, path)
+	if err != nil {
+		return nil, driver.WithStack(err)
+	}
+	if _, ok := req.(*httpRequest); !ok {
+		return nil, driver.WithStack(driver.InvalidArgumentError{Message: "batch requests need a HTTP connection"})
+	}
+	b.requests = append(b.requests, req)
+	return req, nil
+}
+
+// Len returns the number of requests in the batch.
+func (b *Batch) Len() int {
+	return len(b.requests)
+}
+
+// Do sends all requests of the batch to the server in a single request.
+// It returns the responses of the individual requests, in the order in which the requests were created.
+// The returned error only relates to the batch request as a whole, use the individual responses to check
+// the outcome of each request.
+func (b *Batch) Do(ctx context.Context) ([]driver.Response, error) {
+	if len(b.requests) == 0 {
+		return nil, nil
+	}
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	for i, req := range b.requests {
+		partHeader := textproto.MIMEHeader{}
+		partHeader.Set("Content-Type", batchPartContentType)
+		partHeader.Set("Content-Id", strconv.Itoa(i+1))
+		part, err := mw.CreatePart(partHeader)
+		if err != nil {
+			return nil, driver.WithStack(err)
+		}
+		if err := writeBatchPart(part, req.(*httpRequest)); err != nil {
+			return nil, driver.WithStack(err)
+		}
+	}
+	if err := mw.Close(); err != nil {
+		return nil, driver.WithStack(err)
+	}
+
+	batchReq, err := b.conn.NewRequest("POST", "_api/batch")
+	if err != nil {
+		return nil, driver.WithStack(err)
+	}
+	batchReq.SetHeader("Content-Type", mw.FormDataContentType())
+	if _, err := batchReq.SetBody(body.Bytes()); err != nil {
+		return nil, driver.WithStack(err)
+	}
+	var raw []byte
+	resp, err := b.conn.Do(driver.WithRawResponse(ctx, &raw), batchReq)
+	if err != nil {
+		return nil, driver.WithStack(err)
+	}
+	if err := resp.CheckStatus(200); err != nil {
+		return nil, driver.WithStack(err)
+	}
+	return b.parseResponses(resp, raw)
+}
+
+// writeBatchPart writes the given request as a HTTP/1.1 request into a part of a batch request.
+func writeBatchPart(w io.Writer, req *httpRequest) error {
+	r, err := req.createHTTPRequest(url.URL{})
+	if err != nil {
+		return driver.WithStack(err)
+	}
+	fmt.Fprintf(w, "%s %s HTTP/1.1\r\n", r.Method, r.URL.RequestURI())
+	if err := r.Header.Write(w); err != nil {
+		return driver.WithStack(err)
+	}
+	if _, err := io.WriteString(w, "\r\n"); err != nil {
+		return driver.WithStack(err)
+	}
+	if _, err := w.Write(req.bodyBuilder.GetBody()); err != nil {
+		return driver.WithStack(err)
+	}
+	return nil
+}
+
+// parseResponses splits the given raw multipart response of a batch request into individual responses.
+func (b *Batch) parseResponses(resp driver.Response, raw []byte) ([]driver.Response, error) {
+	_, params, err := mime.ParseMediaType(resp.Header("Content-Type"))
+	if err != nil {
+		return nil, driver.WithStack(err)
+	}
+	endpoint, err := url.Parse(resp.Endpoint())
+	if err != nil {
+		return nil, driver.WithStack(err)
+	}
+	result := make([]driver.Response, len(b.requests))
+	mr := multipart.NewReader(bytes.NewReader(raw), params["boundary"])
+	for i := 0; ; i++ {
+		part, err := mr.NextPart()
+		if err != nil {
+			break
+		}
+		index := i
+		if id, err := strconv.Atoi(part.Header.Get("Content-Id")); err == nil {
+			index = id - 1
+		}
+		if index < 0 || index >= len(result) {
+			return nil, driver.WithStack(fmt.Errorf("Unexpected batch response part %d", index+1))
+		}
+		partResp, err := http.ReadResponse(bufio.NewReader(part), nil)
+		if err != nil {
+			return nil, driver.WithStack(err)
+		}
+		partBody, err := ioutil.ReadAll(partResp.Body)
+		partResp.Body.Close()
+		if err != nil {
+			return nil, driver.WithStack(err)
+		}
+		partResp.Request = &http.Request{Method: b.requests[index].Method(), URL: endpoint}
+		if strings.HasPrefix(partResp.Header.Get("Content-Type"), "application/x-velocypack") {
+			result[index] = &httpVPackResponse{resp: partResp, rawResponse: partBody}
+		} else {
+			if len(partBody) == 0 {
+				partBody = []byte("{}")
+			}
+			result[index] = &httpJSONResponse{resp: partResp, rawResponse: partBody}
+		}
+	}
+	for i, r := range result {
+		if r == nil {
+			return nil, driver.WithStack(fmt.Errorf("Missing batch response part %d", i+1))
+		}
+	}
+	return result, nil
+}
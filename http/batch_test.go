@@ -0,0 +1,94 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package http
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/textproto"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+		require.NoError(t, err)
+		mr := multipart.NewReader(r.Body, params["boundary"])
+		mw := multipart.NewWriter(w)
+		w.Header().Set("Content-Type", mw.FormDataContentType())
+		for {
+			part, err := mr.NextPart()
+			if err != nil {
+				break
+			}
+			partReq, err := http.ReadRequest(bufio.NewReader(part))
+			require.NoError(t, err)
+			h := textproto.MIMEHeader{}
+			h.Set("Content-Type", batchPartContentType)
+			h.Set("Content-Id", part.Header.Get("Content-Id"))
+			pw, err := mw.CreatePart(h)
+			require.NoError(t, err)
+			status := 200
+			if partReq.Method == "DELETE" {
+				status = 404
+			}
+			fmt.Fprintf(pw, "HTTP/1.1 %d Status\r\nContent-Type: application/json\r\n\r\n", status)
+			fmt.Fprintf(pw, `{"method":"%s","path":"%s"}`, partReq.Method, partReq.URL.Path)
+		}
+		mw.Close()
+	}))
+	defer server.Close()
+
+	conn, err := newHTTPConnection(server.URL, ConnectionConfig{})
+	require.NoError(t, err)
+	batch := NewBatch(conn)
+	_, err = batch.NewRequest("GET", "_api/version")
+	require.NoError(t, err)
+	req, err := batch.NewRequest("DELETE", "_api/document/c/k")
+	require.NoError(t, err)
+	req.SetQuery("waitForSync", "true")
+	require.Equal(t, 2, batch.Len())
+
+	resps, err := batch.Do(context.Background())
+	require.NoError(t, err)
+	require.Len(t, resps, 2)
+
+	var data struct {
+		Method string `json:"method"`
+		Path   string `json:"path"`
+	}
+	require.NoError(t, resps[0].CheckStatus(200))
+	require.NoError(t, resps[0].ParseBody("", &data))
+	assert.Equal(t, "GET", data.Method)
+	assert.Equal(t, "/_api/version", data.Path)
+	assert.Equal(t, 404, resps[1].StatusCode())
+	require.NoError(t, resps[1].ParseBody("", &data))
+	assert.Equal(t, "/_api/document/c/k", data.Path)
+	assert.Equal(t, server.URL, resps[1].Endpoint())
+}
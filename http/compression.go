@@ -0,0 +1,165 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package http
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+
+	driver "github.com/arangodb/go-driver"
+)
+
+// CompressionMethod specifies the encoding used to compress HTTP bodies.
+type CompressionMethod string
+
+const (
+	CompressionGzip    CompressionMethod = "gzip"
+	CompressionDeflate CompressionMethod = "deflate"
+
+	// DefaultRequestCompressionMinSize is the default minimum size of request bodies to compress.
+	DefaultRequestCompressionMinSize = 1024
+)
+
+// CompressionConfig configures compression of HTTP request & response bodies.
+type CompressionConfig struct {
+	// ResponseCompression asks the server to compress responses using gzip or deflate.
+	// Compressed responses are decompressed transparently.
+	ResponseCompression bool
+	// RequestCompression is the method used to compress request bodies.
+	// If empty, request bodies are not compressed. This needs ArangoDB 3.12 and up.
+	RequestCompression CompressionMethod
+	// RequestCompressionMinSize is the minimum size of a request body to compress.
+	// The default is 1024 (DefaultRequestCompressionMinSize).
+	RequestCompressionMinSize int
+}
+
+// compressRequest compresses the body of the given request according to the given configuration.
+func compressRequest(config *CompressionConfig, r *http.Request, body []byte) error {
+	minSize := config.RequestCompressionMinSize
+	if minSize <= 0 {
+		minSize = DefaultRequestCompressionMinSize
+	}
+	if config.RequestCompression == "" || len(body) < minSize {
+		return nil
+	}
+	var buf bytes.Buffer
+	var w io.WriteCloser
+	switch config.RequestCompression {
+	case CompressionGzip:
+		w = gzip.NewWriter(&buf)
+	case CompressionDeflate:
+		w = zlib.NewWriter(&buf)
+	default:
+		return driver.WithStack(driver.InvalidArgumentError{Message: "Unsupported compression method " + string(config.RequestCompression)})
+	}
+	if _, err := w.Write(body); err != nil {
+		return driver.WithStack(err)
+	}
+	if err := w.Close(); err != nil {
+		return driver.WithStack(err)
+	}
+	compressed := buf.Bytes()
+	r.Body = ioutil.NopCloser(bytes.NewReader(compressed))
+	r.GetBody = func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewReader(compressed)), nil
+	}
+	r.ContentLength = int64(len(compressed))
+	r.Header.Set("Content-Length", strconv.Itoa(len(compressed)))
+	r.Header.Set("Content-Encoding", string(config.RequestCompression))
+	return nil
+}
+
+// decompressResponse replaces the body of the given response with a decompressing reader,
+// if the body is compressed. Empty bodies are left as they are.
+func decompressResponse(resp *http.Response) error {
+	encoding := strings.ToLower(strings.TrimSpace(resp.Header.Get("Content-Encoding")))
+	if encoding != string(CompressionGzip) && encoding != string(CompressionDeflate) {
+		return nil
+	}
+	if !responseHasBody(resp) {
+		return nil
+	}
+	// The length of the body may be unknown (e.g. chunked), so check whether there is any data
+	body := bufio.NewReader(resp.Body)
+	if _, err := body.Peek(1); err == io.EOF {
+		return nil
+	}
+	var reader io.ReadCloser
+	switch encoding {
+	case string(CompressionGzip):
+		r, err := gzip.NewReader(body)
+		if err != nil {
+			resp.Body.Close()
+			return driver.WithStack(err)
+		}
+		reader = r
+	case string(CompressionDeflate):
+		r, err := zlib.NewReader(body)
+		if err != nil {
+			resp.Body.Close()
+			return driver.WithStack(err)
+		}
+		reader = r
+	}
+	resp.Body = &decompressingBody{reader: reader, body: resp.Body}
+	resp.ContentLength = -1
+	resp.Header.Del("Content-Encoding")
+	resp.Header.Del("Content-Length")
+	return nil
+}
+
+// responseHasBody returns false if the given response cannot have a body,
+// because of its status code or request method, or because its body is known to be empty.
+func responseHasBody(resp *http.Response) bool {
+	switch {
+	case resp.ContentLength == 0:
+		return false
+	case resp.StatusCode == http.StatusNoContent || resp.StatusCode == http.StatusNotModified:
+		return false
+	case resp.StatusCode >= 100 && resp.StatusCode < 200:
+		return false
+	case resp.Request != nil && resp.Request.Method == "HEAD":
+		return false
+	}
+	return true
+}
+
+// decompressingBody reads a decompressed response body and closes both the decompressor and the original body.
+type decompressingBody struct {
+	reader io.ReadCloser
+	body   io.ReadCloser
+}
+
+func (b *decompressingBody) Read(p []byte) (int, error) {
+	return b.reader.Read(p)
+}
+
+func (b *decompressingBody) Close() error {
+	b.reader.Close()
+	return b.body.Close()
+}
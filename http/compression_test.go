@@ -0,0 +1,115 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package http
+
+import (
+	"compress/gzip"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestCompression(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Content-Encoding") != "gzip" {
+			t.Errorf("Expected gzip request body, got '%s'", r.Header.Get("Content-Encoding"))
+		}
+		zr, err := gzip.NewReader(r.Body)
+		if err != nil {
+			t.Fatalf("Cannot read gzip request body: %v", err)
+		}
+		body, _ := ioutil.ReadAll(zr)
+		if !strings.Contains(string(body), strings.Repeat("x", 2000)) {
+			t.Errorf("Unexpected request body")
+		}
+		if !strings.Contains(r.Header.Get("Accept-Encoding"), "gzip") {
+			t.Errorf("Expected gzip to be accepted, got '%s'", r.Header.Get("Accept-Encoding"))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Content-Encoding", "gzip")
+		zw := gzip.NewWriter(w)
+		zw.Write([]byte(`{"result":"ok"}`))
+		zw.Close()
+	}))
+	defer server.Close()
+
+	conn, err := newHTTPConnection(server.URL, ConnectionConfig{
+		Compression: &CompressionConfig{
+			ResponseCompression: true,
+			RequestCompression:  CompressionGzip,
+		},
+	})
+	if err != nil {
+		t.Fatalf("newHTTPConnection failed: %v", err)
+	}
+	req, err := conn.NewRequest("POST", "_api/test")
+	if err != nil {
+		t.Fatalf("NewRequest failed: %v", err)
+	}
+	if _, err := req.SetBody(map[string]string{"data": strings.Repeat("x", 2000)}); err != nil {
+		t.Fatalf("SetBody failed: %v", err)
+	}
+	resp, err := conn.Do(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Do failed: %v", err)
+	}
+	var result string
+	if err := resp.ParseBody("result", &result); err != nil {
+		t.Fatalf("ParseBody failed: %v", err)
+	}
+	if result != "ok" {
+		t.Errorf("Expected 'ok', got '%s'", result)
+	}
+}
+
+func TestDecompressEmptyResponse(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		method string
+		length int64
+	}{
+		{name: "no content", status: http.StatusNoContent, method: "DELETE"},
+		{name: "not modified", status: http.StatusNotModified, method: "GET"},
+		{name: "head", status: http.StatusOK, method: "HEAD", length: 100},
+		{name: "empty", status: http.StatusOK, method: "GET"},
+		{name: "unknown length", status: http.StatusOK, method: "GET", length: -1},
+	}
+	for _, test := range tests {
+		resp := &http.Response{
+			StatusCode:    test.status,
+			Header:        http.Header{"Content-Encoding": []string{"gzip"}},
+			Body:          ioutil.NopCloser(strings.NewReader("")),
+			ContentLength: test.length,
+			Request:       &http.Request{Method: test.method},
+		}
+		if err := decompressResponse(resp); err != nil {
+			t.Errorf("%s: decompressResponse failed: %v", test.name, err)
+			continue
+		}
+		if body, err := ioutil.ReadAll(resp.Body); err != nil || len(body) != 0 {
+			t.Errorf("%s: expected empty body, got '%s' (%v)", test.name, body, err)
+		}
+	}
+}
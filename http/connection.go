@@ -33,6 +33,7 @@ import (
 	"net/http"
 	"net/http/httptrace"
 	"net/url"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -49,6 +50,11 @@ const (
 
 	keyRawResponse driver.ContextKey = "arangodb-rawResponse"
 	keyResponse    driver.ContextKey = "arangodb-response"
+
+	keyStreamingResponse driver.ContextKey = "arangodb-streamingResponse"
+
+	// requestTimeoutHeader is the header used to tell the server the remaining time of the request context.
+	requestTimeoutHeader = "x-arango-request-timeout"
 )
 
 // ConnectionConfig provides all configuration options for a HTTP connection.
@@ -58,6 +64,9 @@ type ConnectionConfig struct {
 	Endpoints []string
 	// TLSConfig holds settings used to configure a TLS (HTTPS) connection.
 	// This is only used for endpoints using the HTTPS scheme.
+	// Client certificates (mutual TLS), custom CA pools, the minimum TLS version and the
+	// server name (SNI) can all be configured here. Use util.ClientCertificateReloader to reload
+	// client certificates without creating a new connection.
 	TLSConfig *tls.Config
 	// Transport allows the use of a custom round tripper.
 	// If Transport is not of type `*http.Transport`, the `TLSConfig` property is not used.
@@ -83,6 +92,28 @@ type ConnectionConfig struct {
 	// The default is 32 (DefaultConnLimit).
 	// Set this value to -1 if you do not want any upper limit.
 	ConnLimit int
+	// The following settings tune the transport created by the driver.
+	// They are not used when Transport is set. A zero value means the default is used.
+	// DialTimeout is the maximum time spent establishing a TCP connection. The default is 30s.
+	DialTimeout time.Duration
+	// KeepAlive is the interval between TCP keep-alive probes. The default is 30s.
+	// Set this value to -1 to disable TCP keep-alive probes.
+	KeepAlive time.Duration
+	// TLSHandshakeTimeout is the maximum time spent performing a TLS handshake. The default is 10s.
+	TLSHandshakeTimeout time.Duration
+	// ResponseHeaderTimeout is the maximum time spent waiting for the response headers of the server
+	// after the request has been written. The default is no timeout (the request context is still honored).
+	ResponseHeaderTimeout time.Duration
+	// IdleConnTimeout is the maximum time an idle connection is kept open. The default is 90s.
+	IdleConnTimeout time.Duration
+	// MaxIdleConnsPerHost is the maximum number of idle connections kept open per server.
+	// The default is 64 (DefaultMaxIdleConnsPerHost).
+	MaxIdleConnsPerHost int
+	// DisableKeepAlives disables the reuse of connections for multiple requests.
+	DisableKeepAlives bool
+	// Compression configures compression of request & response bodies.
+	// If nil, bodies are not compressed by the driver.
+	Compression *CompressionConfig
 }
 
 // NewConnection creates a new HTTP connection based on the given configuration settings.
@@ -118,14 +149,17 @@ func newHTTPConnection(endpoint string, config ConnectionConfig) (driver.Connect
 			// Copy default values from http.DefaultTransport
 			Proxy: http.ProxyFromEnvironment,
 			DialContext: (&net.Dialer{
-				Timeout:   30 * time.Second,
-				KeepAlive: 30 * time.Second,
+				Timeout:   durationOrDefault(config.DialTimeout, 30*time.Second),
+				KeepAlive: durationOrDefault(config.KeepAlive, 30*time.Second),
 				DualStack: true,
 			}).DialContext,
 			MaxIdleConns:          100,
-			IdleConnTimeout:       90 * time.Second,
-			TLSHandshakeTimeout:   10 * time.Second,
+			MaxIdleConnsPerHost:   config.MaxIdleConnsPerHost,
+			IdleConnTimeout:       durationOrDefault(config.IdleConnTimeout, 90*time.Second),
+			TLSHandshakeTimeout:   durationOrDefault(config.TLSHandshakeTimeout, 10*time.Second),
+			ResponseHeaderTimeout: config.ResponseHeaderTimeout,
 			ExpectContinueTimeout: 1 * time.Second,
+			DisableKeepAlives:     config.DisableKeepAlives,
 		}
 		config.Transport = httpTransport
 	}
@@ -177,16 +211,26 @@ func newHTTPConnection(endpoint string, config ConnectionConfig) (driver.Connect
 		contentType: config.ContentType,
 		client:      httpClient,
 		connPool:    connPool,
+		compression: config.Compression,
 	}
 	return c, nil
 }
 
+// durationOrDefault returns the given duration, or the given default if the duration is not set.
+func durationOrDefault(d, defaultValue time.Duration) time.Duration {
+	if d == 0 {
+		return defaultValue
+	}
+	return d
+}
+
 // httpConnection implements an HTTP + JSON connection to an arangodb server.
 type httpConnection struct {
 	endpoint    url.URL
 	contentType driver.ContentType
 	client      *http.Client
 	connPool    chan int
+	compression *CompressionConfig
 }
 
 // String returns the endpoint as string
@@ -234,6 +278,15 @@ func (c *httpConnection) Do(ctx context.Context, req driver.Request) (driver.Res
 		return nil, driver.WithStack(driver.InvalidArgumentError{Message: "request is not a httpRequest type"})
 	}
 
+	driver.ApplyQueueTimeSettings(ctx, request)
+	if ctx != nil {
+		if deadline, ok := ctx.Deadline(); ok {
+			// Let the server know how long we're willing to wait for the response
+			if timeout := time.Until(deadline); timeout > 0 {
+				request.SetHeader(requestTimeoutHeader, strconv.FormatFloat(timeout.Seconds(), 'f', 3, 64))
+			}
+		}
+	}
 	r, err := request.createHTTPRequest(c.endpoint)
 	rctx := ctx
 	if rctx == nil {
@@ -244,10 +297,18 @@ func (c *httpConnection) Do(ctx context.Context, req driver.Request) (driver.Res
 			request.WroteRequest(info)
 		},
 	})
-	r = r.WithContext(rctx)
 	if err != nil {
 		return nil, driver.WithStack(err)
 	}
+	r = r.WithContext(rctx)
+	if c.compression != nil {
+		if c.compression.ResponseCompression {
+			r.Header.Set("Accept-Encoding", "gzip, deflate")
+		}
+		if err := compressRequest(c.compression, r, request.bodyBuilder.GetBody()); err != nil {
+			return nil, driver.WithStack(err)
+		}
+	}
 
 	// Block on too many concurrent connections
 	if c.connPool != nil {
@@ -268,13 +329,34 @@ func (c *httpConnection) Do(ctx context.Context, req driver.Request) (driver.Res
 	if err != nil {
 		return nil, driver.WithStack(err)
 	}
+	if c.compression != nil && c.compression.ResponseCompression {
+		if err := decompressResponse(resp); err != nil {
+			return nil, driver.WithStack(err)
+		}
+	}
 	var rawResponse *[]byte
+	streaming := false
 	if ctx != nil {
 		if v := ctx.Value(keyRawResponse); v != nil {
 			if buf, ok := v.(*[]byte); ok {
 				rawResponse = buf
 			}
 		}
+		if v := ctx.Value(keyStreamingResponse); v != nil {
+			if b, ok := v.(bool); ok {
+				streaming = b
+			}
+		}
+	}
+
+	ct := resp.Header.Get("Content-Type")
+	if streaming && rawResponse == nil && strings.Split(ct, ";")[0] == "application/json" {
+		// Decode response body directly from the stream
+		httpResp, err := newStreamingJSONResponse(resp)
+		if err != nil {
+			return nil, driver.WithStack(err)
+		}
+		return handleResponse(ctx, httpResp)
 	}
 
 	// Read response body
@@ -286,10 +368,9 @@ func (c *httpConnection) Do(ctx context.Context, req driver.Request) (driver.Res
 		*rawResponse = body
 	}
 
-	ct := resp.Header.Get("Content-Type")
 	var httpResp driver.Response
 	switch strings.Split(ct, ";")[0] {
-	case "application/json", "application/x-arango-dump":
+	case "application/json", "application/x-arango-dump", "multipart/form-data":
 		httpResp = &httpJSONResponse{resp: resp, rawResponse: body}
 	case "application/x-velocypack":
 		httpResp = &httpVPackResponse{resp: resp, rawResponse: body}
@@ -313,14 +394,23 @@ func (c *httpConnection) Do(ctx context.Context, req driver.Request) (driver.Res
 			return nil, driver.WithStack(fmt.Errorf("Unsupported content type '%s' with status %d and content '%s'", ct, resp.StatusCode, string(body)))
 		}
 	}
+	return handleResponse(ctx, httpResp)
+}
+
+// handleResponse stores the given response in the response reference of the given context (if any)
+// and checks the queue time requirement of the request.
+func handleResponse(ctx context.Context, resp driver.Response) (driver.Response, error) {
+	if err := driver.LoadQueueTimeResponse(ctx, resp); err != nil {
+		return nil, driver.WithStack(err)
+	}
 	if ctx != nil {
 		if v := ctx.Value(keyResponse); v != nil {
 			if respPtr, ok := v.(*driver.Response); ok {
-				*respPtr = httpResp
+				*respPtr = resp
 			}
 		}
 	}
-	return httpResp, nil
+	return resp, nil
 }
 
 // readBody reads the body of the given response into a byte slice.
@@ -0,0 +1,49 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package http
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestNewHTTPConnectionTransportSettings(t *testing.T) {
+	conn, err := newHTTPConnection("http://localhost:8529", ConnectionConfig{
+		ResponseHeaderTimeout: time.Minute,
+		IdleConnTimeout:       time.Second,
+		MaxIdleConnsPerHost:   5,
+		DisableKeepAlives:     true,
+	})
+	if err != nil {
+		t.Fatalf("newHTTPConnection failed: %v", err)
+	}
+	tr := conn.(*httpConnection).client.Transport.(*http.Transport)
+	if tr.ResponseHeaderTimeout != time.Minute || tr.IdleConnTimeout != time.Second {
+		t.Errorf("Unexpected timeouts %s, %s", tr.ResponseHeaderTimeout, tr.IdleConnTimeout)
+	}
+	if tr.MaxIdleConnsPerHost != 5 || !tr.DisableKeepAlives {
+		t.Errorf("Unexpected connection settings %d, %v", tr.MaxIdleConnsPerHost, tr.DisableKeepAlives)
+	}
+	if tr.TLSHandshakeTimeout != 10*time.Second {
+		t.Errorf("Expected default TLS handshake timeout, got %s", tr.TLSHandshakeTimeout)
+	}
+}
@@ -135,6 +135,11 @@ func (r *httpRequest) SetHeader(key, value string) driver.Request {
 		case "application/octet-stream":
 		case "application/zip":
 			r.bodyBuilder = NewBinaryBodyBuilder(strings.ToLower(value))
+		default:
+			if strings.HasPrefix(strings.ToLower(value), "multipart/") {
+				// Keep the boundary parameter as is
+				r.bodyBuilder = NewBinaryBodyBuilder(value)
+			}
 		}
 	}
 
@@ -40,6 +40,14 @@ type httpJSONResponse struct {
 	bodyArray   []map[string]*json.RawMessage
 }
 
+// NewJSONResponse creates a driver.Response for the given HTTP response and its (already read) JSON encoded body.
+// It is intended for connection wrappers that create responses without contacting a server,
+// e.g. to replay recorded responses in tests.
+// The Request (with URL) of the given response must be set, it is used to determine the endpoint.
+func NewJSONResponse(resp *http.Response, body []byte) driver.Response {
+	return &httpJSONResponse{resp: resp, rawResponse: body}
+}
+
 // StatusCode returns an HTTP compatible status code of the response.
 func (r *httpJSONResponse) StatusCode() int {
 	return r.resp.StatusCode
@@ -83,6 +91,27 @@ func (r *httpJSONResponse) Header(key string) string {
 	return r.resp.Header.Get(key)
 }
 
+// RawBody returns the raw encoded body of the response.
+// When the body was decoded directly from the network stream, it is encoded again.
+func (r *httpJSONResponse) RawBody() []byte {
+	if r.rawResponse != nil {
+		return r.rawResponse
+	}
+	var body interface{}
+	if r.bodyObject != nil {
+		body = r.bodyObject
+	} else if r.bodyArray != nil {
+		body = r.bodyArray
+	} else {
+		return nil
+	}
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil
+	}
+	return data
+}
+
 // ParseBody performs protocol specific unmarshalling of the response data into the given result.
 // If the given field is non-empty, the contents of that field will be parsed into the given result.
 func (r *httpJSONResponse) ParseBody(field string, result interface{}) error {
@@ -95,6 +95,15 @@ func (r *httpJSONResponseElement) Header(key string) string {
 	return ""
 }
 
+// RawBody returns the raw encoded body of the response.
+func (r *httpJSONResponseElement) RawBody() []byte {
+	data, err := json.Marshal(r.bodyObject)
+	if err != nil {
+		return nil
+	}
+	return data
+}
+
 // ParseBody performs protocol specific unmarshalling of the response data into the given result.
 // If the given field is non-empty, the contents of that field will be parsed into the given result.
 func (r *httpJSONResponseElement) ParseBody(field string, result interface{}) error {
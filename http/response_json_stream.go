@@ -0,0 +1,84 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	driver "github.com/arangodb/go-driver"
+)
+
+// newStreamingJSONResponse creates a JSON response by decoding the body of the given response
+// directly from the stream, one top-level field (or array element) at a time.
+// Unlike reading the entire body first, this avoids holding both the raw body and its
+// decoded fields in memory.
+func newStreamingJSONResponse(resp *http.Response) (*httpJSONResponse, error) {
+	defer resp.Body.Close()
+	r := &httpJSONResponse{resp: resp}
+	dec := json.NewDecoder(resp.Body)
+	tok, err := dec.Token()
+	if err == io.EOF {
+		// Empty body
+		return r, nil
+	} else if err != nil {
+		return nil, driver.WithStack(err)
+	}
+	switch tok {
+	case json.Delim('{'):
+		bodyObject := make(map[string]*json.RawMessage)
+		for dec.More() {
+			keyTok, err := dec.Token()
+			if err != nil {
+				return nil, driver.WithStack(err)
+			}
+			key, ok := keyTok.(string)
+			if !ok {
+				return nil, driver.WithStack(fmt.Errorf("Unexpected JSON token %v", keyTok))
+			}
+			var raw *json.RawMessage
+			if err := dec.Decode(&raw); err != nil {
+				return nil, driver.WithStack(err)
+			}
+			bodyObject[key] = raw
+		}
+		r.bodyObject = bodyObject
+	case json.Delim('['):
+		bodyArray := make([]map[string]*json.RawMessage, 0)
+		for dec.More() {
+			var elem map[string]*json.RawMessage
+			if err := dec.Decode(&elem); err != nil {
+				return nil, driver.WithStack(err)
+			}
+			bodyArray = append(bodyArray, elem)
+		}
+		r.bodyArray = bodyArray
+	default:
+		return nil, driver.WithStack(fmt.Errorf("Unexpected JSON token %v", tok))
+	}
+	// Consume closing delimiter
+	if _, err := dec.Token(); err != nil {
+		return nil, driver.WithStack(err)
+	}
+	return r, nil
+}
@@ -0,0 +1,103 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package http
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func newTestHTTPResponse(body string) *http.Response {
+	return &http.Response{
+		StatusCode: 200,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       ioutil.NopCloser(strings.NewReader(body)),
+	}
+}
+
+func TestStreamingJSONResponseObject(t *testing.T) {
+	r, err := newStreamingJSONResponse(newTestHTTPResponse(`{"result":[1,2,3],"hasMore":true,"id":null,"extra":{"a":"b"}}`))
+	if err != nil {
+		t.Fatalf("newStreamingJSONResponse failed: %v", err)
+	}
+	var data struct {
+		Result  []int  `json:"result"`
+		HasMore bool   `json:"hasMore"`
+		ID      string `json:"id"`
+	}
+	if err := r.ParseBody("", &data); err != nil {
+		t.Fatalf("ParseBody failed: %v", err)
+	}
+	if len(data.Result) != 3 || data.Result[2] != 3 || !data.HasMore || data.ID != "" {
+		t.Errorf("Unexpected result %+v", data)
+	}
+	var extra map[string]string
+	if err := r.ParseBody("extra", &extra); err != nil {
+		t.Fatalf("ParseBody failed: %v", err)
+	}
+	if extra["a"] != "b" {
+		t.Errorf("Unexpected extra %v", extra)
+	}
+}
+
+func TestStreamingJSONResponseArray(t *testing.T) {
+	r, err := newStreamingJSONResponse(newTestHTTPResponse(`[{"_key":"a"},{"_key":"b"}]`))
+	if err != nil {
+		t.Fatalf("newStreamingJSONResponse failed: %v", err)
+	}
+	resps, err := r.ParseArrayBody()
+	if err != nil {
+		t.Fatalf("ParseArrayBody failed: %v", err)
+	}
+	if len(resps) != 2 {
+		t.Fatalf("Expected 2 responses, got %d", len(resps))
+	}
+	var doc struct {
+		Key string `json:"_key"`
+	}
+	if err := resps[1].ParseBody("", &doc); err != nil {
+		t.Fatalf("ParseBody failed: %v", err)
+	}
+	if doc.Key != "b" {
+		t.Errorf("Expected key 'b', got '%s'", doc.Key)
+	}
+}
+
+func TestStreamingJSONResponseInvalid(t *testing.T) {
+	if _, err := newStreamingJSONResponse(newTestHTTPResponse(`{"a":`)); err == nil {
+		t.Error("Expected error for truncated body")
+	}
+	if _, err := newStreamingJSONResponse(newTestHTTPResponse(``)); err != nil {
+		t.Errorf("Expected no error for empty body, got %v", err)
+	}
+}
+
+func TestStreamingJSONResponseRawBody(t *testing.T) {
+	r, err := newStreamingJSONResponse(newTestHTTPResponse(`{"b":1,"a":[true]}`))
+	if err != nil {
+		t.Fatalf("newStreamingJSONResponse failed: %v", err)
+	}
+	if raw := string(r.RawBody()); raw != `{"a":[true],"b":1}` {
+		t.Errorf("Unexpected raw body %s", raw)
+	}
+}
@@ -81,6 +81,11 @@ func (r *httpVPackResponse) Header(key string) string {
 	return r.resp.Header.Get(key)
 }
 
+// RawBody returns the raw encoded body of the response.
+func (r *httpVPackResponse) RawBody() []byte {
+	return r.rawResponse
+}
+
 // ParseBody performs protocol specific unmarshalling of the response data into the given result.
 // If the given field is non-empty, the contents of that field will be parsed into the given result.
 func (r *httpVPackResponse) ParseBody(field string, result interface{}) error {
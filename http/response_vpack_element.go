@@ -93,6 +93,11 @@ func (r *httpVPackResponseElement) Header(key string) string {
 	return ""
 }
 
+// RawBody returns the raw encoded body of the response.
+func (r *httpVPackResponseElement) RawBody() []byte {
+	return []byte(r.slice)
+}
+
 // ParseBody performs protocol specific unmarshalling of the response data into the given result.
 // If the given field is non-empty, the contents of that field will be parsed into the given result.
 func (r *httpVPackResponseElement) ParseBody(field string, result interface{}) error {
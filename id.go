@@ -90,3 +90,13 @@ func (id DocumentID) Key() string {
 func NewDocumentID(collection, key string) DocumentID {
 	return DocumentID(pathEscape(collection) + "/" + pathEscape(key))
 }
+
+// ParseDocumentID parses the given `collection/key` string into a document ID.
+// If the string is not a valid document ID, an InvalidArgumentError is returned.
+func ParseDocumentID(id string) (DocumentID, error) {
+	result := DocumentID(id)
+	if err := result.Validate(); err != nil {
+		return "", WithStack(InvalidArgumentError{Message: err.Error()})
+	}
+	return result, nil
+}
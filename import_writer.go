@@ -0,0 +1,236 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package driver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"sync"
+)
+
+const (
+	// defaultImportWriterChunkSize is the default number of documents sent to the server in a single import request.
+	defaultImportWriterChunkSize = 1000
+)
+
+// ImportWriterOptions holds optional options that control an ImportWriter.
+type ImportWriterOptions struct {
+	// ChunkSize is the number of documents that are sent to the server in a single import request.
+	// If not set, 1000 documents are sent per request.
+	ChunkSize int
+	// ImportOptions are the options passed to every ImportDocuments call.
+	ImportOptions *ImportDocumentOptions
+}
+
+// ImportWriter imports documents into a collection in chunks.
+// Documents are written as JSON lines (one JSON object per line) using Write,
+// or as individual documents using WriteDocument.
+// Once a chunk is complete, it is imported using Collection.ImportDocuments.
+// The import happens synchronously in the writing goroutine, so a slow server slows down the writer
+// instead of accumulating documents in memory.
+// Close must be called to import the remaining documents.
+type ImportWriter struct {
+	ctx       context.Context
+	col       Collection
+	chunkSize int
+	options   *ImportDocumentOptions
+
+	mutex   sync.Mutex
+	partial []byte
+	docs    []json.RawMessage
+	stats   ImportDocumentStatistics
+	err     error
+	closed  bool
+}
+
+// NewImportWriter creates a new ImportWriter that imports documents into the given collection.
+// The given context is used for all import requests.
+func NewImportWriter(ctx context.Context, col Collection, options *ImportWriterOptions) *ImportWriter {
+	w := &ImportWriter{
+		ctx:       ctx,
+		col:       col,
+		chunkSize: defaultImportWriterChunkSize,
+	}
+	if options != nil {
+		if options.ChunkSize > 0 {
+			w.chunkSize = options.ChunkSize
+		}
+		w.options = options.ImportOptions
+	}
+	return w
+}
+
+// Write implements io.Writer. The given data is interpreted as JSON lines.
+// A line may be split across multiple Write calls. Empty lines are skipped.
+// Once an import request has failed, all subsequent calls return that error.
+func (w *ImportWriter) Write(p []byte) (int, error) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if err := w.checkWritable(); err != nil {
+		return 0, WithStack(err)
+	}
+	data := p
+	for {
+		idx := bytes.IndexByte(data, '\n')
+		if idx < 0 {
+			w.partial = append(w.partial, data...)
+			return len(p), nil
+		}
+		line := data[:idx]
+		data = data[idx+1:]
+		if len(w.partial) > 0 {
+			line = append(w.partial, line...)
+			w.partial = nil
+		}
+		if err := w.addLine(line); err != nil {
+			return len(p) - len(data), WithStack(err)
+		}
+	}
+}
+
+// WriteDocument adds a single document to the import.
+// The document is marshalled to JSON.
+// A pending last line written using Write (not terminated by a newline) is added first,
+// so the documents are imported in the order in which they were written.
+// If that line is not a complete JSON document, an InvalidArgumentError is returned.
+func (w *ImportWriter) WriteDocument(document interface{}) error {
+	encoded, err := json.Marshal(document)
+	if err != nil {
+		return WithStack(err)
+	}
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if err := w.checkWritable(); err != nil {
+		return WithStack(err)
+	}
+	if len(w.partial) > 0 {
+		line := bytes.TrimSpace(w.partial)
+		if len(line) > 0 && !json.Valid(line) {
+			return WithStack(InvalidArgumentError{Message: "cannot write document while an incomplete line is pending: " + string(line)})
+		}
+		w.partial = nil
+		if err := w.addLine(line); err != nil {
+			return WithStack(err)
+		}
+	}
+	if err := w.add(encoded); err != nil {
+		return WithStack(err)
+	}
+	return nil
+}
+
+// Flush imports all documents that have been written so far.
+// An incomplete last line (not terminated by a newline) is not imported.
+func (w *ImportWriter) Flush() error {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if err := w.checkWritable(); err != nil {
+		return WithStack(err)
+	}
+	if err := w.flush(); err != nil {
+		return WithStack(err)
+	}
+	return nil
+}
+
+// Close imports all remaining documents, including an incomplete last line.
+// After Close, the writer can no longer be used.
+func (w *ImportWriter) Close() error {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if w.closed {
+		return WithStack(w.err)
+	}
+	if w.err == nil && len(w.partial) > 0 {
+		line := w.partial
+		w.partial = nil
+		w.err = w.addLine(line)
+	}
+	if w.err == nil {
+		w.err = w.flush()
+	}
+	w.closed = true
+	return WithStack(w.err)
+}
+
+// Statistics returns the accumulated statistics of all import requests made so far.
+func (w *ImportWriter) Statistics() ImportDocumentStatistics {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	return w.stats
+}
+
+// checkWritable returns an error if the writer is closed or a previous import has failed.
+func (w *ImportWriter) checkWritable() error {
+	if w.err != nil {
+		return w.err
+	}
+	if w.closed {
+		return InvalidArgumentError{Message: "import writer is closed"}
+	}
+	return nil
+}
+
+// addLine adds a single JSON line to the current chunk.
+func (w *ImportWriter) addLine(line []byte) error {
+	line = bytes.TrimSpace(line)
+	if len(line) == 0 {
+		return nil
+	}
+	if !json.Valid(line) {
+		return InvalidArgumentError{Message: "invalid JSON document: " + string(line)}
+	}
+	return w.add(append([]byte(nil), line...))
+}
+
+// add adds a single encoded document to the current chunk, importing the chunk when it is complete.
+func (w *ImportWriter) add(document json.RawMessage) error {
+	w.docs = append(w.docs, document)
+	if len(w.docs) >= w.chunkSize {
+		return w.flush()
+	}
+	return nil
+}
+
+// flush imports the current chunk.
+func (w *ImportWriter) flush() error {
+	if len(w.docs) == 0 {
+		return nil
+	}
+	stats, err := w.col.ImportDocuments(w.ctx, w.docs, w.options)
+	w.docs = w.docs[:0]
+	if err != nil {
+		w.err = err
+		return err
+	}
+	w.stats.Created += stats.Created
+	w.stats.Errors += stats.Errors
+	w.stats.Empty += stats.Empty
+	w.stats.Updated += stats.Updated
+	w.stats.Ignored += stats.Ignored
+	return nil
+}
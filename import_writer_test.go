@@ -0,0 +1,102 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package driver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+// importRecorder is a Collection that records the documents of every ImportDocuments call.
+type importRecorder struct {
+	Collection
+	chunks [][]string
+}
+
+func (r *importRecorder) ImportDocuments(ctx context.Context, documents interface{}, options *ImportDocumentOptions) (ImportDocumentStatistics, error) {
+	docs := documents.([]json.RawMessage)
+	chunk := make([]string, len(docs))
+	for i, d := range docs {
+		chunk[i] = string(d)
+	}
+	r.chunks = append(r.chunks, chunk)
+	return ImportDocumentStatistics{Created: int64(len(docs))}, nil
+}
+
+func TestImportWriter(t *testing.T) {
+	col := &importRecorder{}
+	w := NewImportWriter(nil, col, &ImportWriterOptions{ChunkSize: 2})
+	if _, err := w.Write([]byte("{\"a\":1}\n\n{\"a\"")); err != nil {
+		t.Fatalf("Write failed: %s", err)
+	}
+	if _, err := w.Write([]byte(":2}\n{\"a\":3}")); err != nil {
+		t.Fatalf("Write failed: %s", err)
+	}
+	if err := w.WriteDocument(map[string]int{"a": 4}); err != nil {
+		t.Fatalf("WriteDocument failed: %s", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close failed: %s", err)
+	}
+	expected := `[[{"a":1} {"a":2}] [{"a":3} {"a":4}]]`
+	if result := fmt.Sprintf("%v", col.chunks); result != expected {
+		t.Errorf("Expected chunks %s, got %s", expected, result)
+	}
+	if created := w.Statistics().Created; created != 4 {
+		t.Errorf("Expected 4 created documents, got %d", created)
+	}
+	if _, err := w.Write([]byte("{}\n")); !IsInvalidArgument(err) {
+		t.Errorf("Expected InvalidArgumentError after Close, got %v", err)
+	}
+}
+
+func TestImportWriterInvalidJSON(t *testing.T) {
+	w := NewImportWriter(nil, &importRecorder{}, nil)
+	if _, err := w.Write([]byte("{\"a\":1}\nnot json\n")); !IsInvalidArgument(err) {
+		t.Errorf("Expected InvalidArgumentError, got %v", err)
+	}
+}
+
+func TestImportWriterIncompleteLine(t *testing.T) {
+	col := &importRecorder{}
+	w := NewImportWriter(nil, col, nil)
+	if _, err := w.Write([]byte("{\"a\":1}\n{\"a\"")); err != nil {
+		t.Fatalf("Write failed: %s", err)
+	}
+	if err := w.WriteDocument(map[string]int{"a": 3}); !IsInvalidArgument(err) {
+		t.Errorf("Expected InvalidArgumentError for pending incomplete line, got %v", err)
+	}
+	if _, err := w.Write([]byte(":2}")); err != nil {
+		t.Fatalf("Write failed: %s", err)
+	}
+	if err := w.WriteDocument(map[string]int{"a": 3}); err != nil {
+		t.Fatalf("WriteDocument failed: %s", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close failed: %s", err)
+	}
+	expected := `[[{"a":1} {"a":2} {"a":3}]]`
+	if result := fmt.Sprintf("%v", col.chunks); result != expected {
+		t.Errorf("Expected chunks %s, got %s", expected, result)
+	}
+}
@@ -37,6 +37,8 @@ const (
 	GeoIndex        = IndexType("geo")
 	EdgeIndex       = IndexType("edge")
 	TTLIndex        = IndexType("ttl")
+	InvertedIndex   = IndexType("inverted")
+	ZKDIndex        = IndexType("zkd")
 )
 
 // Index provides access to a single index in a single collection.
@@ -55,7 +57,84 @@ type Index interface {
 	// Type returns the type of the index
 	Type() IndexType
 
+	// Fields returns the attribute paths covered by the index.
+	// For inverted indexes, the fields are part of InvertedIndexOptions.
+	Fields() []string
+
+	// MinLength returns the minimum character length of words indexed by a fulltext index.
+	MinLength() int
+
+	// Unique returns true if the index is a unique index.
+	Unique() bool
+
+	// Sparse returns true if the index is a sparse index.
+	Sparse() bool
+
+	// Deduplicate returns true if array values are de-duplicated before being added to the index.
+	Deduplicate() bool
+
+	// GeoJSON returns true if a geo index interprets coordinate pairs in GeoJSON order (longitude first).
+	GeoJSON() bool
+
+	// ExpireAfter returns the time in seconds after which documents expire in a TTL index.
+	ExpireAfter() int
+
+	// FieldValueTypes returns the type of the values indexed by a zkd index, e.g. "double".
+	FieldValueTypes() string
+
+	// SelectivityEstimate returns the selectivity estimate (between 0 and 1) of the index.
+	// A value of 0 is returned for index types that do not provide an estimate.
+	SelectivityEstimate() float64
+
+	// StoredValues returns the additional attribute paths that are stored in a persistent index.
+	StoredValues() []string
+
+	// CacheEnabled returns true if an in-memory cache is enabled for a persistent index.
+	CacheEnabled() bool
+
+	// Estimates returns true if selectivity estimates are maintained for the index.
+	// If the server does not report the option, the server default (true) is returned.
+	Estimates() bool
+
+	// LegacyPolygons returns true if a geo index uses the polygon semantics of ArangoDB versions before 3.10.
+	LegacyPolygons() bool
+
+	// InvertedIndexOptions returns the options of an inverted index.
+	// For other index types, empty options are returned.
+	InvertedIndexOptions() InvertedIndexOptions
+
+	// Figures returns the statistics of the index.
+	// It is only available for indexes that were fetched with a context prepared with `WithIndexStats`,
+	// otherwise nil is returned.
+	Figures() *IndexFigures
+
 	// Remove removes the entire index.
 	// If the index does not exist, a NotFoundError is returned.
 	Remove(ctx context.Context) error
+
+	// BuildProgress fetches the progress of an index that is being created in the background
+	// (see the InBackground option of the EnsureXyzIndex functions).
+	// Once the index is completely built, IsBuilding is false and Progress is 100.
+	// If the index does not exist (anymore), a NotFoundError is returned.
+	BuildProgress(ctx context.Context) (IndexBuildProgress, error)
+}
+
+// IndexFigures contains statistics about a single index.
+type IndexFigures struct {
+	// Memory is the memory used by the index (in bytes).
+	Memory int64 `json:"memory,omitempty"`
+	// CacheInUse is true when the in-memory cache of the index is enabled.
+	CacheInUse bool `json:"cacheInUse,omitempty"`
+	// CacheSize is the memory allocated for the in-memory cache of the index (in bytes).
+	CacheSize int64 `json:"cacheSize,omitempty"`
+	// CacheUsage is the memory used by the in-memory cache of the index (in bytes).
+	CacheUsage int64 `json:"cacheUsage,omitempty"`
+}
+
+// IndexBuildProgress contains the progress of an index that is being created in the background.
+type IndexBuildProgress struct {
+	// IsBuilding is true as long as the index is still being created.
+	IsBuilding bool `json:"isBuilding,omitempty"`
+	// Progress is the percentage (0-100) of the index creation that has been completed.
+	Progress float64 `json:"progress,omitempty"`
 }
@@ -24,6 +24,7 @@ package driver
 
 import (
 	"context"
+	"net/http"
 	"path"
 	"strings"
 )
@@ -47,30 +48,33 @@ func indexStringToType(indexTypeString string) (IndexType, error) {
 		return EdgeIndex, nil
 	case string(TTLIndex):
 		return TTLIndex, nil
+	case string(InvertedIndex):
+		return InvertedIndex, nil
+	case string(ZKDIndex):
+		return ZKDIndex, nil
 	default:
 		return "", WithStack(InvalidArgumentError{Message: "unknown index type"})
 	}
 }
 
 // newIndex creates a new Index implementation.
-func newIndex(id string, indexTypeString string, name string, col *collection) (Index, error) {
-	if id == "" {
+func newIndex(data indexData, col *collection) (Index, error) {
+	if data.ID == "" {
 		return nil, WithStack(InvalidArgumentError{Message: "id is empty"})
 	}
-	parts := strings.Split(id, "/")
+	parts := strings.Split(data.ID, "/")
 	if len(parts) != 2 {
 		return nil, WithStack(InvalidArgumentError{Message: "id must be `collection/name`"})
 	}
 	if col == nil {
 		return nil, WithStack(InvalidArgumentError{Message: "col is nil"})
 	}
-	indexType, err := indexStringToType(indexTypeString)
+	indexType, err := indexStringToType(data.Type)
 	if err != nil {
 		return nil, WithStack(err)
 	}
 	return &index{
-		id:        id,
-		name:      name,
+		indexData: data,
 		indexType: indexType,
 		col:       col,
 		db:        col.db,
@@ -78,9 +82,20 @@ func newIndex(id string, indexTypeString string, name string, col *collection) (
 	}, nil
 }
 
+// newInvertedIndex creates a new Index implementation for an inverted index.
+func newInvertedIndex(data invertedIndexData, col *collection) (Index, error) {
+	idx, err := newIndex(indexData{ID: data.ID, Type: data.Type, Name: data.Name}, col)
+	if err != nil {
+		return nil, WithStack(err)
+	}
+	options := data.InvertedIndexOptions
+	idx.(*index).inverted = &options
+	return idx, nil
+}
+
 type index struct {
-	id        string
-	name      string
+	indexData
+	inverted  *InvertedIndexOptions
 	indexType IndexType
 	db        *database
 	col       *collection
@@ -94,18 +109,18 @@ func (i *index) relPath() string {
 
 // Name returns the name of the index.
 func (i *index) Name() string {
-	parts := strings.Split(i.id, "/")
+	parts := strings.Split(i.indexData.ID, "/")
 	return parts[1]
 }
 
 // ID returns the ID of the index.
 func (i *index) ID() string {
-	return i.id
+	return i.indexData.ID
 }
 
 // UserName returns the user provided name of the index or empty string if non is provided.
 func (i *index) UserName() string {
-	return i.name
+	return i.indexData.Name
 }
 
 // Type returns the type of the index
@@ -113,10 +128,89 @@ func (i *index) Type() IndexType {
 	return i.indexType
 }
 
+// SelectivityEstimate returns the selectivity estimate of the index, if supported by the index type.
+func (i *index) SelectivityEstimate() float64 {
+	return i.indexData.SelectivityEstimate
+}
+
+// Fields returns the attribute paths covered by the index.
+func (i *index) Fields() []string {
+	return i.indexData.Fields
+}
+
+// MinLength returns the minimum character length of words indexed by a fulltext index.
+func (i *index) MinLength() int {
+	return i.indexData.MinLength
+}
+
+// Unique returns true if the index is a unique index.
+func (i *index) Unique() bool {
+	return i.indexData.Unique != nil && *i.indexData.Unique
+}
+
+// Sparse returns true if the index is a sparse index.
+func (i *index) Sparse() bool {
+	return i.indexData.Sparse != nil && *i.indexData.Sparse
+}
+
+// Deduplicate returns true if array values are de-duplicated before being added to the index.
+func (i *index) Deduplicate() bool {
+	return i.indexData.Deduplicate != nil && *i.indexData.Deduplicate
+}
+
+// GeoJSON returns true if a geo index interprets coordinate pairs in GeoJSON order.
+func (i *index) GeoJSON() bool {
+	return i.indexData.GeoJSON != nil && *i.indexData.GeoJSON
+}
+
+// ExpireAfter returns the time in seconds after which documents expire in a TTL index.
+func (i *index) ExpireAfter() int {
+	return i.indexData.ExpireAfter
+}
+
+// FieldValueTypes returns the type of the values indexed by a zkd index.
+func (i *index) FieldValueTypes() string {
+	return i.indexData.FieldValueTypes
+}
+
+// StoredValues returns the additional attribute paths that are stored in a persistent index.
+func (i *index) StoredValues() []string {
+	return i.indexData.StoredValues
+}
+
+// CacheEnabled returns true if an in-memory cache is enabled for a persistent index.
+func (i *index) CacheEnabled() bool {
+	return i.indexData.CacheEnabled != nil && *i.indexData.CacheEnabled
+}
+
+// Estimates returns true if selectivity estimates are maintained for the index.
+// When the server does not report the option, its default (true) is returned.
+func (i *index) Estimates() bool {
+	return i.indexData.Estimates == nil || *i.indexData.Estimates
+}
+
+// LegacyPolygons returns true if a geo index uses the polygon semantics of ArangoDB versions before 3.10.
+func (i *index) LegacyPolygons() bool {
+	return i.indexData.LegacyPolygons != nil && *i.indexData.LegacyPolygons
+}
+
+// InvertedIndexOptions returns the options of an inverted index.
+func (i *index) InvertedIndexOptions() InvertedIndexOptions {
+	if i.inverted == nil {
+		return InvertedIndexOptions{}
+	}
+	return *i.inverted
+}
+
+// Figures returns the statistics of the index, if these were requested.
+func (i *index) Figures() *IndexFigures {
+	return i.indexData.Figures
+}
+
 // Remove removes the entire index.
 // If the index does not exist, a NotFoundError is returned.
 func (i *index) Remove(ctx context.Context) error {
-	req, err := i.conn.NewRequest("DELETE", path.Join(i.relPath(), i.id))
+	req, err := i.conn.NewRequest("DELETE", path.Join(i.relPath(), i.indexData.ID))
 	if err != nil {
 		return WithStack(err)
 	}
@@ -129,3 +223,40 @@ func (i *index) Remove(ctx context.Context) error {
 	}
 	return nil
 }
+
+// BuildProgress fetches the progress of an index that is being created in the background.
+// If the index does not exist (anymore), a NotFoundError is returned.
+func (i *index) BuildProgress(ctx context.Context) (IndexBuildProgress, error) {
+	req, err := i.conn.NewRequest("GET", i.relPath())
+	if err != nil {
+		return IndexBuildProgress{}, WithStack(err)
+	}
+	req.SetQuery("collection", i.col.name)
+	req.SetQuery("withHidden", "true")
+	resp, err := i.conn.Do(ctx, req)
+	if err != nil {
+		return IndexBuildProgress{}, WithStack(err)
+	}
+	if err := resp.CheckStatus(200); err != nil {
+		return IndexBuildProgress{}, WithStack(err)
+	}
+	var data struct {
+		Indexes []struct {
+			ID string `json:"id,omitempty"`
+			IndexBuildProgress
+		} `json:"indexes,omitempty"`
+	}
+	if err := resp.ParseBody("", &data); err != nil {
+		return IndexBuildProgress{}, WithStack(err)
+	}
+	for _, x := range data.Indexes {
+		if x.ID != i.indexData.ID {
+			continue
+		}
+		if !x.IsBuilding {
+			return IndexBuildProgress{IsBuilding: false, Progress: 100}, nil
+		}
+		return x.IndexBuildProgress, nil
+	}
+	return IndexBuildProgress{}, WithStack(newArangoError(http.StatusNotFound, ErrArangoIndexNotFound, "index not found"))
+}
@@ -0,0 +1,110 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package driver
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// newLimitConnection creates a connection that limits the number of concurrent requests
+// and/or the rate of requests performed on the given connection.
+// A value <= 0 disables the corresponding limit.
+func newLimitConnection(conn Connection, maxConcurrent int, requestsPerSecond float64) Connection {
+	c := &limitConnection{
+		Connection:        conn,
+		maxConcurrent:     maxConcurrent,
+		requestsPerSecond: requestsPerSecond,
+	}
+	if maxConcurrent > 0 {
+		c.slots = make(chan struct{}, maxConcurrent)
+	}
+	if requestsPerSecond > 0 {
+		c.interval = time.Duration(float64(time.Second) / requestsPerSecond)
+	}
+	return c
+}
+
+// limitConnection is a Connection that limits concurrency and rate of requests.
+type limitConnection struct {
+	Connection
+	maxConcurrent     int
+	requestsPerSecond float64
+
+	slots    chan struct{}
+	interval time.Duration
+	mutex    sync.Mutex
+	next     time.Time
+}
+
+// Do performs a given request, returning its response.
+// It blocks until the request is allowed by the configured limits, or the context is done.
+func (c *limitConnection) Do(ctx context.Context, req Request) (Response, error) {
+	ctx = contextOrBackground(ctx)
+	if c.interval > 0 {
+		if err := c.waitForRate(ctx); err != nil {
+			return nil, WithStack(err)
+		}
+	}
+	if c.slots != nil {
+		select {
+		case c.slots <- struct{}{}:
+			defer func() { <-c.slots }()
+		case <-ctx.Done():
+			return nil, WithStack(ctx.Err())
+		}
+	}
+	return c.Connection.Do(ctx, req)
+}
+
+// waitForRate reserves the next request slot and waits until it is reached.
+func (c *limitConnection) waitForRate(ctx context.Context) error {
+	c.mutex.Lock()
+	now := time.Now()
+	at := c.next
+	if at.Before(now) {
+		at = now
+	}
+	c.next = at.Add(c.interval)
+	c.mutex.Unlock()
+
+	if wait := at.Sub(now); wait > 0 {
+		t := time.NewTimer(wait)
+		defer t.Stop()
+		select {
+		case <-t.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// SetAuthentication creates a copy of the connection with the given authentication.
+// The copy has its own limits.
+func (c *limitConnection) SetAuthentication(auth Authentication) (Connection, error) {
+	conn, err := c.Connection.SetAuthentication(auth)
+	if err != nil {
+		return nil, WithStack(err)
+	}
+	return newLimitConnection(conn, c.maxConcurrent, c.requestsPerSecond), nil
+}
@@ -0,0 +1,76 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package driver
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type testLimitConnection struct {
+	Connection
+	inFlight    int32
+	maxInFlight int32
+}
+
+func (c *testLimitConnection) Do(ctx context.Context, req Request) (Response, error) {
+	n := atomic.AddInt32(&c.inFlight, 1)
+	for {
+		max := atomic.LoadInt32(&c.maxInFlight)
+		if n <= max || atomic.CompareAndSwapInt32(&c.maxInFlight, max, n) {
+			break
+		}
+	}
+	time.Sleep(5 * time.Millisecond)
+	atomic.AddInt32(&c.inFlight, -1)
+	return nil, nil
+}
+
+func TestLimitConnectionConcurrency(t *testing.T) {
+	conn := &testLimitConnection{}
+	lc := newLimitConnection(conn, 2, 0)
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			lc.Do(context.Background(), nil)
+		}()
+	}
+	wg.Wait()
+	if conn.maxInFlight != 2 {
+		t.Errorf("Expected at most 2 concurrent requests, got %d", conn.maxInFlight)
+	}
+}
+
+func TestLimitConnectionRate(t *testing.T) {
+	lc := newLimitConnection(&testLimitConnection{}, 0, 100)
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		lc.Do(context.Background(), nil)
+	}
+	if d := time.Since(start); d < 40*time.Millisecond {
+		t.Errorf("Expected requests to be rate limited, took %s", d)
+	}
+}
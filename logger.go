@@ -0,0 +1,101 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package driver
+
+import (
+	"context"
+	"time"
+)
+
+// Logger is used by the client to log requests and retry decisions.
+// Arguments are alternating key/value pairs, which makes a *slog.Logger
+// directly usable as Logger.
+type Logger interface {
+	Debug(msg string, args ...interface{})
+	Info(msg string, args ...interface{})
+	Warn(msg string, args ...interface{})
+	Error(msg string, args ...interface{})
+}
+
+// logAt logs the given message using the given logger at the given level.
+func logAt(l Logger, level LogLevel, msg string, args ...interface{}) {
+	switch level {
+	case LogLevelFatal, LogLevelError:
+		l.Error(msg, args...)
+	case LogLevelWarning:
+		l.Warn(msg, args...)
+	case LogLevelInfo:
+		l.Info(msg, args...)
+	default:
+		l.Debug(msg, args...)
+	}
+}
+
+// newLoggingConnection creates a connection that logs every request performed on the given connection.
+func newLoggingConnection(conn Connection, logger Logger, requestLevel, errorLevel LogLevel) Connection {
+	if requestLevel == "" {
+		requestLevel = LogLevelDebug
+	}
+	if errorLevel == "" {
+		errorLevel = LogLevelWarning
+	}
+	return &loggingConnection{
+		Connection:   conn,
+		logger:       logger,
+		requestLevel: requestLevel,
+		errorLevel:   errorLevel,
+	}
+}
+
+// loggingConnection is a Connection that logs all requests.
+type loggingConnection struct {
+	Connection
+	logger       Logger
+	requestLevel LogLevel
+	errorLevel   LogLevel
+}
+
+// Do performs a given request, returning its response.
+func (c *loggingConnection) Do(ctx context.Context, req Request) (Response, error) {
+	start := time.Now()
+	resp, err := c.Connection.Do(ctx, req)
+	args := []interface{}{
+		"method", req.Method(),
+		"path", req.Path(),
+		"duration", time.Since(start),
+	}
+	if err != nil {
+		logAt(c.logger, c.errorLevel, "Request failed", append(args, "error", err.Error())...)
+		return resp, err
+	}
+	args = append(args, "status", resp.StatusCode(), "endpoint", resp.Endpoint())
+	logAt(c.logger, c.requestLevel, "Request completed", args...)
+	return resp, nil
+}
+
+// SetAuthentication creates a copy of the connection with the given authentication.
+func (c *loggingConnection) SetAuthentication(auth Authentication) (Connection, error) {
+	conn, err := c.Connection.SetAuthentication(auth)
+	if err != nil {
+		return nil, WithStack(err)
+	}
+	return newLoggingConnection(conn, c.logger, c.requestLevel, c.errorLevel), nil
+}
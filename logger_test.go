@@ -0,0 +1,64 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package driver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+type testLogger struct {
+	lines []string
+}
+
+func (l *testLogger) log(level, msg string)                 { l.lines = append(l.lines, level+" "+msg) }
+func (l *testLogger) Debug(msg string, args ...interface{}) { l.log("debug", msg) }
+func (l *testLogger) Info(msg string, args ...interface{})  { l.log("info", msg) }
+func (l *testLogger) Warn(msg string, args ...interface{})  { l.log("warn", msg) }
+func (l *testLogger) Error(msg string, args ...interface{}) { l.log("error", msg) }
+
+type testLoggingFailingConnection struct {
+	Connection
+}
+
+func (c *testLoggingFailingConnection) Do(ctx context.Context, req Request) (Response, error) {
+	return nil, errors.New("connection refused")
+}
+
+type testLoggingRequest struct {
+	Request
+}
+
+func (r *testLoggingRequest) Method() string { return "GET" }
+func (r *testLoggingRequest) Path() string   { return "_api/version" }
+
+func TestLoggingConnectionFailure(t *testing.T) {
+	logger := &testLogger{}
+	conn := newLoggingConnection(&testLoggingFailingConnection{}, logger, "", LogLevelError)
+	if _, err := conn.Do(context.Background(), &testLoggingRequest{}); err == nil {
+		t.Fatal("Expected error")
+	}
+	if fmt.Sprint(logger.lines) != "[error Request failed]" {
+		t.Errorf("Unexpected log lines %v", logger.lines)
+	}
+}
@@ -0,0 +1,62 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+/*
+Package migrate manages the schema of ArangoDB deployments.
+
+Desired databases, collections, indexes, views and analyzers are declared as Go values
+and Reconcile creates or updates whatever is missing on the server:
+
+	schema := migrate.Schema{
+		Databases: []migrate.Database{{
+			Name: "shop",
+			Analyzers: []driver.ArangoSearchAnalyzerDefinition{
+				{Name: "text_en_lower", Type: driver.ArangoSearchAnalyzerTypeNorm, Properties: driver.ArangoSearchAnalyzerProperties{Locale: "en", Case: driver.ArangoSearchCaseLower}},
+			},
+			Collections: []migrate.Collection{{
+				Name: "orders",
+				Indexes: []migrate.Index{
+					{Name: "byCustomer", Type: driver.PersistentIndex, Fields: []string{"customer"}},
+				},
+			}},
+		}},
+	}
+	changes, err := migrate.NewReconciler(client, schema, nil).Reconcile(ctx)
+
+Reconcile is idempotent: running it against a deployment that already matches the
+schema results in no changes. Objects that exist on the server but are not part
+of the schema are left untouched. An existing index whose definition differs from
+the declared one is dropped and recreated, since indexes cannot be modified.
+
+Set ReconcileOptions.DryRun to list the changes without applying them.
+
+Changes that cannot be declared, such as data migrations, are written as versioned
+migrations and applied by a Runner:
+
+	runner, err := migrate.NewRunner(db, []migrate.Migration{
+		{Version: 1, Name: "add status", Up: addStatus, Down: removeStatus},
+	}, nil)
+	applied, err := runner.Up(ctx)
+
+The versions of applied migrations are stored in the `_migrations` collection of the database.
+A lock document in that collection prevents concurrent runs; a runner that finds the lock
+held by another runner returns ErrLocked.
+*/
+package migrate
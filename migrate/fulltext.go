@@ -0,0 +1,216 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package migrate
+
+import (
+	"context"
+	"strings"
+
+	driver "github.com/arangodb/go-driver"
+)
+
+// FulltextMigrationOptions holds optional options that control a fulltext migration.
+type FulltextMigrationOptions struct {
+	// ViewName is the name of the ArangoSearch view. If not set, `<collection>_fulltext` is used.
+	ViewName string
+	// AnalyzerName is the name of the text analyzer. If not set, `fulltext_<locale>` is used.
+	AnalyzerName string
+	// Locale of the text analyzer. If not set, "en" is used.
+	Locale string
+	// RemoveIndexes, if set, makes MigrateFulltext remove the fulltext indexes once the view has been created.
+	RemoveIndexes bool
+}
+
+// FulltextMigration describes the ArangoSearch view and analyzer that replace the fulltext indexes of a collection.
+type FulltextMigration struct {
+	// Indexes are the fulltext indexes of the collection.
+	Indexes []driver.Index
+	// Analyzer is a text analyzer that tokenizes and lower-cases words like a fulltext index does.
+	// Fulltext indexes ignore words shorter than their minimum length, the analyzer does not.
+	Analyzer driver.ArangoSearchAnalyzerDefinition
+	// ViewName is the name of the view.
+	ViewName string
+	// View holds the properties of the view. It links the fields of all fulltext indexes using the analyzer.
+	View driver.ArangoSearchViewProperties
+}
+
+// PlanFulltextMigration inspects the fulltext indexes of the given collection and returns the ArangoSearch view
+// and analyzer that replace them. Nothing is changed in the database.
+// If the collection has no fulltext indexes, the returned migration has no Indexes and no view links.
+//
+// A query like `FOR d IN FULLTEXT(col, "text", "word")` becomes
+// `FOR d IN view SEARCH ANALYZER(d.text IN TOKENS("word", "analyzer"), "analyzer")`, and prefix searches
+// (`prefix:word`) become `STARTS_WITH(d.text, "word")` inside ANALYZER.
+func PlanFulltextMigration(ctx context.Context, col driver.Collection, opts *FulltextMigrationOptions) (FulltextMigration, error) {
+	var options FulltextMigrationOptions
+	if opts != nil {
+		options = *opts
+	}
+	if options.Locale == "" {
+		options.Locale = "en"
+	}
+	if options.AnalyzerName == "" {
+		options.AnalyzerName = "fulltext_" + strings.Replace(options.Locale, ".", "_", -1)
+	}
+	if options.ViewName == "" {
+		options.ViewName = col.Name() + "_fulltext"
+	}
+
+	indexes, err := col.Indexes(ctx)
+	if err != nil {
+		return FulltextMigration{}, driver.WithStack(err)
+	}
+	accent, stemming := false, false
+	m := FulltextMigration{
+		Analyzer: driver.ArangoSearchAnalyzerDefinition{
+			Name: options.AnalyzerName,
+			Type: driver.ArangoSearchAnalyzerTypeText,
+			Properties: driver.ArangoSearchAnalyzerProperties{
+				Locale:    options.Locale,
+				Case:      driver.ArangoSearchCaseLower,
+				Accent:    &accent,
+				Stemming:  &stemming,
+				Stopwords: []string{},
+			},
+			Features: []driver.ArangoSearchAnalyzerFeature{
+				driver.ArangoSearchAnalyzerFeatureFrequency,
+				driver.ArangoSearchAnalyzerFeatureNorm,
+				driver.ArangoSearchAnalyzerFeaturePosition,
+			},
+		},
+		ViewName: options.ViewName,
+	}
+	fields := driver.ArangoSearchFields{}
+	for _, idx := range indexes {
+		if idx.Type() != driver.FullTextIndex {
+			continue
+		}
+		m.Indexes = append(m.Indexes, idx)
+		for _, field := range idx.Fields() {
+			addSearchField(fields, strings.Split(field, "."), options.AnalyzerName)
+		}
+	}
+	if len(fields) > 0 {
+		m.View.Links = driver.ArangoSearchLinks{
+			col.Name(): driver.ArangoSearchElementProperties{Fields: fields},
+		}
+	}
+	return m, nil
+}
+
+// MigrateFulltext creates the analyzer and view planned by PlanFulltextMigration in the given database.
+// If the view already exists, the planned fields are merged into its link of the collection,
+// keeping the fields and analyzers that are already linked.
+// The fulltext indexes are only removed when RemoveIndexes is set.
+func MigrateFulltext(ctx context.Context, db driver.Database, col driver.Collection, opts *FulltextMigrationOptions) (FulltextMigration, error) {
+	m, err := PlanFulltextMigration(ctx, col, opts)
+	if err != nil {
+		return FulltextMigration{}, driver.WithStack(err)
+	}
+	if len(m.Indexes) == 0 {
+		return m, nil
+	}
+	if _, _, err := db.EnsureAnalyzer(ctx, m.Analyzer); err != nil {
+		return m, driver.WithStack(err)
+	}
+	exists, err := db.ViewExists(ctx, m.ViewName)
+	if err != nil {
+		return m, driver.WithStack(err)
+	}
+	if exists {
+		view, err := db.View(ctx, m.ViewName)
+		if err != nil {
+			return m, driver.WithStack(err)
+		}
+		asView, err := view.ArangoSearchView()
+		if err != nil {
+			return m, driver.WithStack(err)
+		}
+		props, err := asView.Properties(ctx)
+		if err != nil {
+			return m, driver.WithStack(err)
+		}
+		if props.Links == nil {
+			props.Links = driver.ArangoSearchLinks{}
+		}
+		link := props.Links[col.Name()]
+		if link.Fields == nil {
+			link.Fields = driver.ArangoSearchFields{}
+		}
+		mergeSearchFields(link.Fields, m.View.Links[col.Name()].Fields)
+		props.Links[col.Name()] = link
+		if err := asView.SetProperties(ctx, props); err != nil {
+			return m, driver.WithStack(err)
+		}
+	} else if _, err := db.CreateArangoSearchView(ctx, m.ViewName, &m.View); err != nil {
+		return m, driver.WithStack(err)
+	}
+
+	if opts != nil && opts.RemoveIndexes {
+		for _, idx := range m.Indexes {
+			if err := idx.Remove(ctx); err != nil && !driver.IsNotFound(err) {
+				return m, driver.WithStack(err)
+			}
+		}
+	}
+	return m, nil
+}
+
+// addSearchField adds the (nested) field with the given path to fields, indexed using the given analyzer.
+func addSearchField(fields driver.ArangoSearchFields, path []string, analyzer string) {
+	element := fields[path[0]]
+	if len(path) == 1 {
+		element.Analyzers = appendAnalyzer(element.Analyzers, analyzer)
+	} else {
+		if element.Fields == nil {
+			element.Fields = driver.ArangoSearchFields{}
+		}
+		addSearchField(element.Fields, path[1:], analyzer)
+	}
+	fields[path[0]] = element
+}
+
+// mergeSearchFields adds the fields (and their analyzers) of src to dst.
+func mergeSearchFields(dst, src driver.ArangoSearchFields) {
+	for name, field := range src {
+		element := dst[name]
+		for _, analyzer := range field.Analyzers {
+			element.Analyzers = appendAnalyzer(element.Analyzers, analyzer)
+		}
+		if len(field.Fields) > 0 {
+			if element.Fields == nil {
+				element.Fields = driver.ArangoSearchFields{}
+			}
+			mergeSearchFields(element.Fields, field.Fields)
+		}
+		dst[name] = element
+	}
+}
+
+// appendAnalyzer adds the given analyzer to analyzers, unless it is already included.
+func appendAnalyzer(analyzers []string, analyzer string) []string {
+	for _, x := range analyzers {
+		if x == analyzer {
+			return analyzers
+		}
+	}
+	return append(analyzers, analyzer)
+}
@@ -0,0 +1,111 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package migrate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	driver "github.com/arangodb/go-driver"
+)
+
+type testIndex struct {
+	driver.Index
+	indexType   driver.IndexType
+	fields      []string
+	unique      bool
+	expireAfter int
+}
+
+func (i testIndex) Type() driver.IndexType { return i.indexType }
+func (i testIndex) Fields() []string       { return i.fields }
+func (i testIndex) Unique() bool           { return i.unique }
+func (i testIndex) Sparse() bool           { return false }
+func (i testIndex) ExpireAfter() int       { return i.expireAfter }
+
+type testIndexCollection struct {
+	driver.Collection
+	indexes []driver.Index
+}
+
+func (c testIndexCollection) Name() string { return "articles" }
+
+func (c testIndexCollection) Indexes(ctx context.Context) ([]driver.Index, error) {
+	return c.indexes, nil
+}
+
+func TestPlanFulltextMigration(t *testing.T) {
+	col := testIndexCollection{indexes: []driver.Index{
+		testIndex{indexType: driver.PrimaryIndex, fields: []string{"_key"}},
+		testIndex{indexType: driver.FullTextIndex, fields: []string{"title"}},
+		testIndex{indexType: driver.FullTextIndex, fields: []string{"body.text"}},
+		testIndex{indexType: driver.FullTextIndex, fields: []string{"title"}},
+	}}
+	m, err := PlanFulltextMigration(context.Background(), col, nil)
+	require.NoError(t, err)
+	require.Len(t, m.Indexes, 3)
+	require.Equal(t, "articles_fulltext", m.ViewName)
+	require.Equal(t, "fulltext_en", m.Analyzer.Name)
+	require.Equal(t, driver.ArangoSearchAnalyzerTypeText, m.Analyzer.Type)
+	require.Equal(t, driver.ArangoSearchCaseLower, m.Analyzer.Properties.Case)
+
+	analyzers := []string{"fulltext_en"}
+	require.Equal(t, driver.ArangoSearchLinks{
+		"articles": driver.ArangoSearchElementProperties{
+			Fields: driver.ArangoSearchFields{
+				"title": {Analyzers: analyzers},
+				"body":  {Fields: driver.ArangoSearchFields{"text": {Analyzers: analyzers}}},
+			},
+		},
+	}, m.View.Links)
+
+	m, err = PlanFulltextMigration(context.Background(), testIndexCollection{}, &FulltextMigrationOptions{ViewName: "v", Locale: "de"})
+	require.NoError(t, err)
+	require.Empty(t, m.Indexes)
+	require.Nil(t, m.View.Links)
+	require.Equal(t, "v", m.ViewName)
+	require.Equal(t, "fulltext_de", m.Analyzer.Name)
+}
+
+func TestMergeSearchFields(t *testing.T) {
+	existing := driver.ArangoSearchFields{
+		"title":   {Analyzers: []string{"identity"}},
+		"summary": {Analyzers: []string{"text_en"}},
+		"body":    {Fields: driver.ArangoSearchFields{"lang": {}}},
+	}
+	mergeSearchFields(existing, driver.ArangoSearchFields{
+		"title": {Analyzers: []string{"fulltext_en"}},
+		"body":  {Fields: driver.ArangoSearchFields{"text": {Analyzers: []string{"fulltext_en"}}}},
+	})
+	mergeSearchFields(existing, driver.ArangoSearchFields{
+		"title": {Analyzers: []string{"fulltext_en"}},
+	})
+	require.Equal(t, driver.ArangoSearchFields{
+		"title":   {Analyzers: []string{"identity", "fulltext_en"}},
+		"summary": {Analyzers: []string{"text_en"}},
+		"body": {Fields: driver.ArangoSearchFields{
+			"lang": {},
+			"text": {Analyzers: []string{"fulltext_en"}},
+		}},
+	}, existing)
+}
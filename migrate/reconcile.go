@@ -0,0 +1,480 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package migrate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	driver "github.com/arangodb/go-driver"
+)
+
+// ChangeType identifies the kind of a Change.
+type ChangeType string
+
+const (
+	ChangeCreateDatabase   ChangeType = "create-database"
+	ChangeCreateCollection ChangeType = "create-collection"
+	ChangeUpdateCollection ChangeType = "update-collection"
+	ChangeCreateIndex      ChangeType = "create-index"
+	ChangeReplaceIndex     ChangeType = "replace-index"
+	ChangeCreateView       ChangeType = "create-view"
+	ChangeUpdateView       ChangeType = "update-view"
+	ChangeCreateAnalyzer   ChangeType = "create-analyzer"
+)
+
+// Change describes a single modification made (or, in dry-run mode, needed) to reach the desired schema.
+type Change struct {
+	// Type of the change.
+	Type ChangeType
+	// Database the change applies to.
+	Database string
+	// Collection the change applies to. Only set for collection and index changes.
+	Collection string
+	// Name of the created or updated object.
+	Name string
+}
+
+// String returns a human readable description of the change.
+func (c Change) String() string {
+	switch c.Type {
+	case ChangeCreateDatabase:
+		return fmt.Sprintf("%s %s", c.Type, c.Name)
+	case ChangeCreateIndex, ChangeReplaceIndex:
+		return fmt.Sprintf("%s %s/%s/%s", c.Type, c.Database, c.Collection, c.Name)
+	default:
+		return fmt.Sprintf("%s %s/%s", c.Type, c.Database, c.Name)
+	}
+}
+
+// ReconcileOptions holds optional options that control Reconcile.
+type ReconcileOptions struct {
+	// DryRun, if set, makes Reconcile only report the changes without applying them.
+	DryRun bool
+}
+
+// Reconciler applies a Schema to a deployment.
+type Reconciler struct {
+	client driver.Client
+	schema Schema
+	opts   ReconcileOptions
+}
+
+// NewReconciler creates a Reconciler that applies the given schema using the given client.
+func NewReconciler(client driver.Client, schema Schema, opts *ReconcileOptions) *Reconciler {
+	r := &Reconciler{
+		client: client,
+		schema: schema,
+	}
+	if opts != nil {
+		r.opts = *opts
+	}
+	return r
+}
+
+// Reconcile compares the schema with the deployment and creates or updates everything that is missing
+// or different. It returns the list of changes that were applied, or would be applied in dry-run mode.
+// When an error occurs, the changes applied so far are returned together with the error.
+func (r *Reconciler) Reconcile(ctx context.Context) ([]Change, error) {
+	var changes []Change
+	for _, d := range r.schema.Databases {
+		var err error
+		if changes, err = r.reconcileDatabase(ctx, d, changes); err != nil {
+			return changes, driver.WithStack(err)
+		}
+	}
+	return changes, nil
+}
+
+// reconcileDatabase reconciles a single database and all objects declared in it.
+func (r *Reconciler) reconcileDatabase(ctx context.Context, d Database, changes []Change) ([]Change, error) {
+	if d.Name == "" {
+		return changes, driver.WithStack(driver.InvalidArgumentError{Message: "database name is empty"})
+	}
+	exists, err := r.client.DatabaseExists(ctx, d.Name)
+	if err != nil {
+		return changes, driver.WithStack(err)
+	}
+	if !exists {
+		changes = append(changes, Change{Type: ChangeCreateDatabase, Database: d.Name, Name: d.Name})
+		if r.opts.DryRun {
+			// Nothing can be inspected in a database that does not exist, so everything in it is new.
+			return append(changes, newDatabaseChanges(d)...), nil
+		}
+		if _, err := r.client.CreateDatabase(ctx, d.Name, d.Options); err != nil {
+			return changes, driver.WithStack(err)
+		}
+	}
+	db, err := r.client.Database(ctx, d.Name)
+	if err != nil {
+		return changes, driver.WithStack(err)
+	}
+	for _, a := range d.Analyzers {
+		if changes, err = r.reconcileAnalyzer(ctx, db, a, changes); err != nil {
+			return changes, driver.WithStack(err)
+		}
+	}
+	for _, c := range d.Collections {
+		if changes, err = r.reconcileCollection(ctx, db, c, changes); err != nil {
+			return changes, driver.WithStack(err)
+		}
+	}
+	for _, v := range d.Views {
+		if changes, err = r.reconcileView(ctx, db, v, changes); err != nil {
+			return changes, driver.WithStack(err)
+		}
+	}
+	return changes, nil
+}
+
+// reconcileAnalyzer creates the given analyzer when it does not exist.
+func (r *Reconciler) reconcileAnalyzer(ctx context.Context, db driver.Database, a driver.ArangoSearchAnalyzerDefinition, changes []Change) ([]Change, error) {
+	change := Change{Type: ChangeCreateAnalyzer, Database: db.Name(), Name: a.Name}
+	if r.opts.DryRun {
+		if _, err := db.Analyzer(ctx, a.Name); err == nil {
+			return changes, nil
+		} else if !driver.IsNotFound(err) {
+			return changes, driver.WithStack(err)
+		}
+		return append(changes, change), nil
+	}
+	existed, _, err := db.EnsureAnalyzer(ctx, a)
+	if err != nil {
+		return changes, driver.WithStack(err)
+	}
+	if !existed {
+		changes = append(changes, change)
+	}
+	return changes, nil
+}
+
+// reconcileCollection creates the given collection when it does not exist,
+// updates its properties when they differ and ensures all of its indexes.
+func (r *Reconciler) reconcileCollection(ctx context.Context, db driver.Database, c Collection, changes []Change) ([]Change, error) {
+	exists, err := db.CollectionExists(ctx, c.Name)
+	if err != nil {
+		return changes, driver.WithStack(err)
+	}
+	if !exists {
+		changes = append(changes, Change{Type: ChangeCreateCollection, Database: db.Name(), Collection: c.Name, Name: c.Name})
+		if r.opts.DryRun {
+			return append(changes, newIndexChanges(db.Name(), c)...), nil
+		}
+		col, err := db.CreateCollection(ctx, c.Name, c.Options)
+		if err != nil {
+			return changes, driver.WithStack(err)
+		}
+		if c.Properties != nil {
+			if err := col.SetProperties(ctx, *c.Properties); err != nil {
+				return changes, driver.WithStack(err)
+			}
+		}
+		return r.reconcileIndexes(ctx, db.Name(), col, c, changes)
+	}
+
+	col, err := db.Collection(ctx, c.Name)
+	if err != nil {
+		return changes, driver.WithStack(err)
+	}
+	if c.Properties != nil {
+		props, err := col.Properties(ctx)
+		if err != nil {
+			return changes, driver.WithStack(err)
+		}
+		if propertiesDiffer(*c.Properties, props) {
+			changes = append(changes, Change{Type: ChangeUpdateCollection, Database: db.Name(), Collection: c.Name, Name: c.Name})
+			if !r.opts.DryRun {
+				if err := col.SetProperties(ctx, *c.Properties); err != nil {
+					return changes, driver.WithStack(err)
+				}
+			}
+		}
+	}
+	return r.reconcileIndexes(ctx, db.Name(), col, c, changes)
+}
+
+// reconcileIndexes ensures all indexes of the given collection, replacing indexes whose
+// definition differs from the declared one.
+func (r *Reconciler) reconcileIndexes(ctx context.Context, dbName string, col driver.Collection, c Collection, changes []Change) ([]Change, error) {
+	for _, idx := range c.Indexes {
+		if idx.Name == "" {
+			return changes, driver.WithStack(driver.InvalidArgumentError{Message: fmt.Sprintf("index on collection '%s' has no name", c.Name)})
+		}
+		exists, err := col.IndexExists(ctx, idx.Name)
+		if err != nil {
+			return changes, driver.WithStack(err)
+		}
+		if exists {
+			have, err := col.Index(ctx, idx.Name)
+			if err != nil {
+				return changes, driver.WithStack(err)
+			}
+			if !indexDiffers(idx, have) {
+				continue
+			}
+			// Indexes cannot be modified, so a changed definition requires dropping and recreating the index.
+			changes = append(changes, Change{Type: ChangeReplaceIndex, Database: dbName, Collection: c.Name, Name: idx.Name})
+			if r.opts.DryRun {
+				continue
+			}
+			if err := have.Remove(ctx); err != nil {
+				return changes, driver.WithStack(err)
+			}
+		} else {
+			changes = append(changes, Change{Type: ChangeCreateIndex, Database: dbName, Collection: c.Name, Name: idx.Name})
+			if r.opts.DryRun {
+				continue
+			}
+		}
+		if err := ensureIndex(ctx, col, idx); err != nil {
+			return changes, driver.WithStack(err)
+		}
+	}
+	return changes, nil
+}
+
+// reconcileView creates the given view when it does not exist, or applies its properties
+// when the existing view lacks one of the declared links.
+func (r *Reconciler) reconcileView(ctx context.Context, db driver.Database, v View, changes []Change) ([]Change, error) {
+	exists, err := db.ViewExists(ctx, v.Name)
+	if err != nil {
+		return changes, driver.WithStack(err)
+	}
+	if !exists {
+		changes = append(changes, Change{Type: ChangeCreateView, Database: db.Name(), Name: v.Name})
+		if r.opts.DryRun {
+			return changes, nil
+		}
+		if _, err := db.CreateArangoSearchView(ctx, v.Name, v.Properties); err != nil {
+			return changes, driver.WithStack(err)
+		}
+		return changes, nil
+	}
+	if v.Properties == nil {
+		return changes, nil
+	}
+
+	view, err := db.View(ctx, v.Name)
+	if err != nil {
+		return changes, driver.WithStack(err)
+	}
+	asView, err := view.ArangoSearchView()
+	if err != nil {
+		return changes, driver.WithStack(err)
+	}
+	props, err := asView.Properties(ctx)
+	if err != nil {
+		return changes, driver.WithStack(err)
+	}
+	for name := range v.Properties.Links {
+		if _, found := props.Links[name]; !found {
+			changes = append(changes, Change{Type: ChangeUpdateView, Database: db.Name(), Name: v.Name})
+			if r.opts.DryRun {
+				return changes, nil
+			}
+			if err := asView.SetProperties(ctx, *v.Properties); err != nil {
+				return changes, driver.WithStack(err)
+			}
+			return changes, nil
+		}
+	}
+	return changes, nil
+}
+
+// ensureIndex creates the given index on the given collection.
+func ensureIndex(ctx context.Context, col driver.Collection, idx Index) error {
+	var err error
+	switch idx.Type {
+	case driver.PersistentIndex:
+		_, _, err = col.EnsurePersistentIndex(ctx, idx.Fields, &driver.EnsurePersistentIndexOptions{
+			Name:         idx.Name,
+			Unique:       idx.Unique,
+			Sparse:       idx.Sparse,
+			InBackground: idx.InBackground,
+		})
+	case driver.TTLIndex:
+		if len(idx.Fields) != 1 {
+			return driver.WithStack(driver.InvalidArgumentError{Message: fmt.Sprintf("ttl index '%s' must have exactly one field", idx.Name)})
+		}
+		_, _, err = col.EnsureTTLIndex(ctx, idx.Fields[0], idx.ExpireAfter, &driver.EnsureTTLIndexOptions{
+			Name:         idx.Name,
+			InBackground: idx.InBackground,
+		})
+	case driver.GeoIndex:
+		_, _, err = col.EnsureGeoIndex(ctx, idx.Fields, &driver.EnsureGeoIndexOptions{
+			Name:         idx.Name,
+			GeoJSON:      idx.GeoJSON,
+			InBackground: idx.InBackground,
+		})
+	case driver.FullTextIndex:
+		_, _, err = col.EnsureFullTextIndex(ctx, idx.Fields, &driver.EnsureFullTextIndexOptions{
+			Name:         idx.Name,
+			MinLength:    idx.MinLength,
+			InBackground: idx.InBackground,
+		})
+	case driver.InvertedIndex:
+		var opts driver.InvertedIndexOptions
+		if idx.Inverted != nil {
+			opts = *idx.Inverted
+		}
+		opts.Name = idx.Name
+		if idx.InBackground {
+			opts.InBackground = true
+		}
+		_, _, err = col.EnsureInvertedIndex(ctx, &opts)
+	default:
+		return driver.WithStack(driver.InvalidArgumentError{Message: fmt.Sprintf("unsupported type '%s' of index '%s'", idx.Type, idx.Name)})
+	}
+	return driver.WithStack(err)
+}
+
+// indexDiffers returns true when the definition of the existing index differs from the declared one.
+func indexDiffers(want Index, have driver.Index) bool {
+	if want.Type != have.Type() {
+		return true
+	}
+	switch want.Type {
+	case driver.InvertedIndex:
+		if want.Inverted == nil {
+			return false
+		}
+		haveFields := have.InvertedIndexOptions().Fields
+		if len(want.Inverted.Fields) != len(haveFields) {
+			return true
+		}
+		for i, f := range want.Inverted.Fields {
+			if f.Name != haveFields[i].Name {
+				return true
+			}
+		}
+		return false
+	case driver.TTLIndex:
+		if want.ExpireAfter != have.ExpireAfter() {
+			return true
+		}
+	case driver.GeoIndex:
+		if want.GeoJSON != have.GeoJSON() {
+			return true
+		}
+	case driver.FullTextIndex:
+		if want.MinLength != 0 && want.MinLength != have.MinLength() {
+			return true
+		}
+	case driver.PersistentIndex:
+		if want.Unique != have.Unique() || want.Sparse != have.Sparse() {
+			return true
+		}
+	}
+	haveFields := have.Fields()
+	if len(want.Fields) != len(haveFields) {
+		return true
+	}
+	for i, f := range want.Fields {
+		if f != haveFields[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// propertiesDiffer returns true when one of the properties set in want differs from have.
+func propertiesDiffer(want driver.SetCollectionPropertiesOptions, have driver.CollectionProperties) bool {
+	switch {
+	case want.WaitForSync != nil && *want.WaitForSync != have.WaitForSync:
+		return true
+	case want.CacheEnabled != nil && *want.CacheEnabled != have.CacheEnabled:
+		return true
+	case want.JournalSize != 0 && want.JournalSize != have.JournalSize:
+		return true
+	case want.ReplicationFactor != 0 && want.ReplicationFactor != have.ReplicationFactor:
+		return true
+	case want.WriteConcern != 0 && want.WriteConcern != have.WriteConcern:
+		return true
+	case want.Schema != nil && !schemaEqual(want.Schema, have.Schema):
+		return true
+	case want.ComputedValues != nil && !computedValuesEqual(want.ComputedValues, have.ComputedValues):
+		return true
+	}
+	return false
+}
+
+// schemaEqual compares two collection schemas by their JSON representation,
+// so rules given as Go structs compare equal to the rules returned by the server.
+func schemaEqual(a, b *driver.CollectionSchemaOptions) bool {
+	if b == nil {
+		return false
+	}
+	var ja, jb interface{}
+	if !normalizeJSON(a, &ja) || !normalizeJSON(b, &jb) {
+		return false
+	}
+	return reflect.DeepEqual(ja, jb)
+}
+
+// computedValuesEqual compares the names and expressions of two lists of computed values.
+// Other fields are filled with defaults by the server and are not compared.
+func computedValuesEqual(a, b []driver.ComputedValue) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Name != b[i].Name || a[i].Expression != b[i].Expression {
+			return false
+		}
+	}
+	return true
+}
+
+// normalizeJSON converts v into its generic JSON representation.
+func normalizeJSON(v interface{}, result *interface{}) bool {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return false
+	}
+	return json.Unmarshal(data, result) == nil
+}
+
+// newDatabaseChanges returns the changes needed to create all objects of a database that does not exist yet.
+func newDatabaseChanges(d Database) []Change {
+	var changes []Change
+	for _, a := range d.Analyzers {
+		changes = append(changes, Change{Type: ChangeCreateAnalyzer, Database: d.Name, Name: a.Name})
+	}
+	for _, c := range d.Collections {
+		changes = append(changes, Change{Type: ChangeCreateCollection, Database: d.Name, Collection: c.Name, Name: c.Name})
+		changes = append(changes, newIndexChanges(d.Name, c)...)
+	}
+	for _, v := range d.Views {
+		changes = append(changes, Change{Type: ChangeCreateView, Database: d.Name, Name: v.Name})
+	}
+	return changes
+}
+
+// newIndexChanges returns the changes needed to create all indexes of a collection that does not exist yet.
+func newIndexChanges(dbName string, c Collection) []Change {
+	var changes []Change
+	for _, idx := range c.Indexes {
+		changes = append(changes, Change{Type: ChangeCreateIndex, Database: dbName, Collection: c.Name, Name: idx.Name})
+	}
+	return changes
+}
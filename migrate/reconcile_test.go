@@ -0,0 +1,96 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package migrate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	driver "github.com/arangodb/go-driver"
+)
+
+func TestPropertiesDiffer(t *testing.T) {
+	yes, no := true, false
+	have := driver.CollectionProperties{WaitForSync: true, WriteConcern: 1}
+	have.Schema = &driver.CollectionSchemaOptions{
+		Rule:  map[string]interface{}{"type": "object", "minProperties": float64(1)},
+		Level: driver.CollectionSchemaLevelStrict,
+	}
+	have.ComputedValues = []driver.ComputedValue{{Name: "created", Expression: "RETURN DATE_NOW()", Overwrite: true}}
+
+	require.False(t, propertiesDiffer(driver.SetCollectionPropertiesOptions{}, have))
+	require.False(t, propertiesDiffer(driver.SetCollectionPropertiesOptions{WaitForSync: &yes, WriteConcern: 1}, have))
+	require.True(t, propertiesDiffer(driver.SetCollectionPropertiesOptions{WaitForSync: &no}, have))
+	require.True(t, propertiesDiffer(driver.SetCollectionPropertiesOptions{WriteConcern: 2}, have))
+
+	// Rules declared with Go types compare equal to rules decoded from JSON.
+	require.False(t, propertiesDiffer(driver.SetCollectionPropertiesOptions{Schema: &driver.CollectionSchemaOptions{
+		Rule:  map[string]interface{}{"type": "object", "minProperties": 1},
+		Level: driver.CollectionSchemaLevelStrict,
+	}}, have))
+	require.True(t, propertiesDiffer(driver.SetCollectionPropertiesOptions{Schema: &driver.CollectionSchemaOptions{
+		Rule:  map[string]interface{}{"type": "object", "minProperties": 2},
+		Level: driver.CollectionSchemaLevelStrict,
+	}}, have))
+
+	require.False(t, propertiesDiffer(driver.SetCollectionPropertiesOptions{
+		ComputedValues: []driver.ComputedValue{{Name: "created", Expression: "RETURN DATE_NOW()"}},
+	}, have))
+	require.True(t, propertiesDiffer(driver.SetCollectionPropertiesOptions{
+		ComputedValues: []driver.ComputedValue{{Name: "updated", Expression: "RETURN DATE_NOW()"}},
+	}, have))
+}
+
+func TestNewDatabaseChanges(t *testing.T) {
+	d := Database{
+		Name:      "db",
+		Analyzers: []driver.ArangoSearchAnalyzerDefinition{{Name: "a"}},
+		Collections: []Collection{{
+			Name:    "c",
+			Indexes: []Index{{Name: "i", Type: driver.PersistentIndex, Fields: []string{"x"}}},
+		}},
+		Views: []View{{Name: "v"}},
+	}
+	changes := newDatabaseChanges(d)
+	var descriptions []string
+	for _, c := range changes {
+		descriptions = append(descriptions, c.String())
+	}
+	require.Equal(t, []string{
+		"create-analyzer db/a",
+		"create-collection db/c",
+		"create-index db/c/i",
+		"create-view db/v",
+	}, descriptions)
+}
+
+func TestIndexDiffers(t *testing.T) {
+	have := testIndex{indexType: driver.PersistentIndex, fields: []string{"a", "b"}, unique: true}
+	require.False(t, indexDiffers(Index{Name: "i", Type: driver.PersistentIndex, Fields: []string{"a", "b"}, Unique: true}, have))
+	require.True(t, indexDiffers(Index{Name: "i", Type: driver.PersistentIndex, Fields: []string{"a", "b"}}, have))
+	require.True(t, indexDiffers(Index{Name: "i", Type: driver.PersistentIndex, Fields: []string{"b", "a"}, Unique: true}, have))
+	require.True(t, indexDiffers(Index{Name: "i", Type: driver.TTLIndex, Fields: []string{"a"}, ExpireAfter: 60}, have))
+
+	ttl := testIndex{indexType: driver.TTLIndex, fields: []string{"expires"}, expireAfter: 60}
+	require.False(t, indexDiffers(Index{Name: "t", Type: driver.TTLIndex, Fields: []string{"expires"}, ExpireAfter: 60}, ttl))
+	require.True(t, indexDiffers(Index{Name: "t", Type: driver.TTLIndex, Fields: []string{"expires"}, ExpireAfter: 120}, ttl))
+}
@@ -0,0 +1,382 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package migrate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	driver "github.com/arangodb/go-driver"
+)
+
+const (
+	// DefaultMigrationsCollection is the collection that holds the applied migrations when
+	// RunnerOptions.Collection is not set.
+	DefaultMigrationsCollection = "_migrations"
+	// defaultLockTTL is the time after which a lock that has not been released is considered stale.
+	defaultLockTTL = 15 * time.Minute
+	// lockKey is the key of the lock document in the migrations collection.
+	lockKey = "lock"
+)
+
+// ErrLocked is returned when another runner holds the migration lock of the database.
+var ErrLocked = errors.New("Migrations are locked by another runner")
+
+// MigrationFunc applies or reverts a migration in the given database.
+type MigrationFunc func(ctx context.Context, db driver.Database) error
+
+// Migration is a single versioned change of a database.
+type Migration struct {
+	// Version identifies the migration. Migrations are applied in ascending order of their version
+	// and reverted in descending order. Versions must be positive and unique.
+	Version int64
+	// Name is a human readable description of the migration.
+	Name string
+	// Up applies the migration. It is required.
+	Up MigrationFunc
+	// Down reverts the migration. Migrations without Down cannot be reverted.
+	Down MigrationFunc
+}
+
+// AppliedMigration describes a migration that has been applied to a database.
+type AppliedMigration struct {
+	Version   int64     `json:"version"`
+	Name      string    `json:"name,omitempty"`
+	AppliedAt time.Time `json:"appliedAt"`
+}
+
+// MigrationError is returned when the Up or Down function of a migration fails.
+type MigrationError struct {
+	Version int64
+	Name    string
+	Err     error
+}
+
+// Error returns a human readable error string.
+func (e *MigrationError) Error() string {
+	return fmt.Sprintf("migration %d (%s) failed: %v", e.Version, e.Name, e.Err)
+}
+
+// Unwrap returns the error returned by the migration.
+func (e *MigrationError) Unwrap() error {
+	return e.Err
+}
+
+// RunnerOptions holds optional options that control a Runner.
+type RunnerOptions struct {
+	// Collection is the name of the collection that stores the applied migrations.
+	// If not set, DefaultMigrationsCollection is used.
+	Collection string
+	// DryRun, if set, makes Up and Down only return the migrations they would run.
+	// Nothing is written to the database in dry-run mode.
+	DryRun bool
+	// LockTTL is the time after which the lock of a runner that did not release it (e.g. because
+	// its process crashed) is taken over by another runner. It must be longer than the longest
+	// running migration. If not set, 15 minutes is used.
+	LockTTL time.Duration
+	// Owner identifies the runner in the lock document.
+	// If not set, the hostname and process ID are used.
+	Owner string
+}
+
+// Runner applies and reverts versioned migrations of a database.
+// The versions of applied migrations are stored in a collection of the database,
+// and a lock document in the same collection prevents concurrent runs.
+type Runner struct {
+	db         driver.Database
+	migrations []Migration
+	opts       RunnerOptions
+}
+
+// lockDocument is the document that holds the migration lock.
+type lockDocument struct {
+	Key       string    `json:"_key"`
+	Owner     string    `json:"owner"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// migrationDocument is the document that records an applied migration.
+type migrationDocument struct {
+	Key string `json:"_key"`
+	AppliedMigration
+}
+
+// NewRunner creates a Runner for the given migrations of the given database.
+func NewRunner(db driver.Database, migrations []Migration, opts *RunnerOptions) (*Runner, error) {
+	r := &Runner{
+		db:         db,
+		migrations: append([]Migration(nil), migrations...),
+	}
+	if opts != nil {
+		r.opts = *opts
+	}
+	if r.opts.Collection == "" {
+		r.opts.Collection = DefaultMigrationsCollection
+	}
+	if r.opts.LockTTL <= 0 {
+		r.opts.LockTTL = defaultLockTTL
+	}
+	if r.opts.Owner == "" {
+		hostname, _ := os.Hostname()
+		r.opts.Owner = fmt.Sprintf("%s:%d", hostname, os.Getpid())
+	}
+
+	sort.Slice(r.migrations, func(i, j int) bool { return r.migrations[i].Version < r.migrations[j].Version })
+	for i, m := range r.migrations {
+		if m.Version <= 0 {
+			return nil, driver.WithStack(driver.InvalidArgumentError{Message: fmt.Sprintf("migration '%s' has invalid version %d", m.Name, m.Version)})
+		}
+		if i > 0 && r.migrations[i-1].Version == m.Version {
+			return nil, driver.WithStack(driver.InvalidArgumentError{Message: fmt.Sprintf("duplicate migration version %d", m.Version)})
+		}
+		if m.Up == nil {
+			return nil, driver.WithStack(driver.InvalidArgumentError{Message: fmt.Sprintf("migration %d has no Up function", m.Version)})
+		}
+	}
+	return r, nil
+}
+
+// Applied returns the migrations that have been applied to the database, in ascending order of their version.
+func (r *Runner) Applied(ctx context.Context) ([]AppliedMigration, error) {
+	exists, err := r.db.CollectionExists(ctx, r.opts.Collection)
+	if err != nil {
+		return nil, driver.WithStack(err)
+	}
+	if !exists {
+		return nil, nil
+	}
+	query := "FOR d IN @@col FILTER d._key != @lock SORT d.version RETURN d"
+	cursor, err := r.db.Query(ctx, query, map[string]interface{}{"@col": r.opts.Collection, "lock": lockKey})
+	if err != nil {
+		return nil, driver.WithStack(err)
+	}
+	defer cursor.Close()
+	var result []AppliedMigration
+	for cursor.HasMore() {
+		var doc migrationDocument
+		if _, err := cursor.ReadDocument(ctx, &doc); err != nil {
+			return nil, driver.WithStack(err)
+		}
+		result = append(result, doc.AppliedMigration)
+	}
+	return result, nil
+}
+
+// Pending returns the migrations that have not been applied to the database yet, in the order Up would apply them.
+func (r *Runner) Pending(ctx context.Context) ([]Migration, error) {
+	applied, err := r.Applied(ctx)
+	if err != nil {
+		return nil, driver.WithStack(err)
+	}
+	return r.pending(applied), nil
+}
+
+// Up applies all pending migrations in ascending order of their version.
+// It returns the migrations that have been applied, or would be applied in dry-run mode.
+// When a migration fails, Up stops and returns the migrations applied before it together with a *MigrationError.
+func (r *Runner) Up(ctx context.Context) ([]Migration, error) {
+	if r.opts.DryRun {
+		return r.Pending(ctx)
+	}
+	var result []Migration
+	err := r.withLock(ctx, func(col driver.Collection) error {
+		applied, err := r.Applied(ctx)
+		if err != nil {
+			return driver.WithStack(err)
+		}
+		for _, m := range r.pending(applied) {
+			if err := m.Up(ctx, r.db); err != nil {
+				return driver.WithStack(&MigrationError{Version: m.Version, Name: m.Name, Err: err})
+			}
+			doc := migrationDocument{
+				Key:              migrationKey(m.Version),
+				AppliedMigration: AppliedMigration{Version: m.Version, Name: m.Name, AppliedAt: time.Now().UTC()},
+			}
+			if _, err := col.CreateDocument(ctx, doc); err != nil {
+				return driver.WithStack(err)
+			}
+			result = append(result, m)
+		}
+		return nil
+	})
+	return result, driver.WithStack(err)
+}
+
+// Down reverts all applied migrations with a version higher than the given version,
+// in descending order of their version. Down(ctx, 0) reverts all migrations.
+// It returns the migrations that have been reverted, or would be reverted in dry-run mode.
+// When a migration fails, Down stops and returns the migrations reverted before it together with a *MigrationError.
+func (r *Runner) Down(ctx context.Context, version int64) ([]Migration, error) {
+	if r.opts.DryRun {
+		applied, err := r.Applied(ctx)
+		if err != nil {
+			return nil, driver.WithStack(err)
+		}
+		return r.revertible(applied, version)
+	}
+	var result []Migration
+	err := r.withLock(ctx, func(col driver.Collection) error {
+		applied, err := r.Applied(ctx)
+		if err != nil {
+			return driver.WithStack(err)
+		}
+		migrations, err := r.revertible(applied, version)
+		if err != nil {
+			return driver.WithStack(err)
+		}
+		for _, m := range migrations {
+			if err := m.Down(ctx, r.db); err != nil {
+				return driver.WithStack(&MigrationError{Version: m.Version, Name: m.Name, Err: err})
+			}
+			if _, err := col.RemoveDocument(ctx, migrationKey(m.Version)); err != nil {
+				return driver.WithStack(err)
+			}
+			result = append(result, m)
+		}
+		return nil
+	})
+	return result, driver.WithStack(err)
+}
+
+// pending returns the migrations that are not in the given list of applied migrations.
+func (r *Runner) pending(applied []AppliedMigration) []Migration {
+	done := make(map[int64]struct{}, len(applied))
+	for _, a := range applied {
+		done[a.Version] = struct{}{}
+	}
+	var result []Migration
+	for _, m := range r.migrations {
+		if _, found := done[m.Version]; !found {
+			result = append(result, m)
+		}
+	}
+	return result
+}
+
+// revertible returns the applied migrations with a version higher than the given version, in descending order.
+// An error is returned when one of them is unknown to the runner or cannot be reverted.
+func (r *Runner) revertible(applied []AppliedMigration, version int64) ([]Migration, error) {
+	known := make(map[int64]Migration, len(r.migrations))
+	for _, m := range r.migrations {
+		known[m.Version] = m
+	}
+	var result []Migration
+	for i := len(applied) - 1; i >= 0; i-- {
+		a := applied[i]
+		if a.Version <= version {
+			continue
+		}
+		m, found := known[a.Version]
+		if !found {
+			return nil, driver.WithStack(driver.InvalidArgumentError{Message: fmt.Sprintf("applied migration %d is unknown", a.Version)})
+		}
+		if m.Down == nil {
+			return nil, driver.WithStack(driver.InvalidArgumentError{Message: fmt.Sprintf("migration %d has no Down function", m.Version)})
+		}
+		result = append(result, m)
+	}
+	return result, nil
+}
+
+// withLock ensures the migrations collection, acquires the lock, calls the given function and releases the lock.
+func (r *Runner) withLock(ctx context.Context, f func(col driver.Collection) error) error {
+	col, err := r.ensureCollection(ctx)
+	if err != nil {
+		return driver.WithStack(err)
+	}
+	rev, err := r.lock(ctx, col)
+	if err != nil {
+		return driver.WithStack(err)
+	}
+	err = f(col)
+	if _, unlockErr := col.RemoveDocument(driver.WithRevision(ctx, rev), lockKey); unlockErr != nil && err == nil {
+		err = unlockErr
+	}
+	return driver.WithStack(err)
+}
+
+// ensureCollection returns the migrations collection, creating it when it does not exist.
+func (r *Runner) ensureCollection(ctx context.Context) (driver.Collection, error) {
+	exists, err := r.db.CollectionExists(ctx, r.opts.Collection)
+	if err != nil {
+		return nil, driver.WithStack(err)
+	}
+	if !exists {
+		opts := &driver.CreateCollectionOptions{IsSystem: strings.HasPrefix(r.opts.Collection, "_")}
+		col, err := r.db.CreateCollection(ctx, r.opts.Collection, opts)
+		if err == nil {
+			return col, nil
+		} else if !driver.IsConflict(err) && !driver.IsDuplicateName(err) {
+			return nil, driver.WithStack(err)
+		}
+		// Another runner created the collection concurrently.
+	}
+	col, err := r.db.Collection(ctx, r.opts.Collection)
+	if err != nil {
+		return nil, driver.WithStack(err)
+	}
+	return col, nil
+}
+
+// lock acquires the migration lock and returns the revision of the lock document.
+// A lock that has expired is taken over.
+func (r *Runner) lock(ctx context.Context, col driver.Collection) (string, error) {
+	for {
+		doc := lockDocument{Key: lockKey, Owner: r.opts.Owner, ExpiresAt: time.Now().UTC().Add(r.opts.LockTTL)}
+		meta, err := col.CreateDocument(ctx, doc)
+		if err == nil {
+			return meta.Rev, nil
+		} else if !driver.IsConflict(err) {
+			return "", driver.WithStack(err)
+		}
+
+		var current lockDocument
+		meta, err = col.ReadDocument(ctx, lockKey, &current)
+		if driver.IsNotFound(err) {
+			// The lock was released in the meantime.
+			continue
+		} else if err != nil {
+			return "", driver.WithStack(err)
+		}
+		if time.Now().Before(current.ExpiresAt) {
+			return "", driver.WithStack(ErrLocked)
+		}
+		meta, err = col.ReplaceDocument(driver.WithRevision(ctx, meta.Rev), lockKey, doc)
+		if err == nil {
+			return meta.Rev, nil
+		} else if driver.IsPreconditionFailed(err) || driver.IsNotFound(err) {
+			// Another runner took over or released the lock in the meantime.
+			continue
+		}
+		return "", driver.WithStack(err)
+	}
+}
+
+// migrationKey returns the document key that records the migration with the given version.
+func migrationKey(version int64) string {
+	return strconv.FormatInt(version, 10)
+}
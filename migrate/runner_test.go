@@ -0,0 +1,82 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package migrate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	driver "github.com/arangodb/go-driver"
+)
+
+func noop(ctx context.Context, db driver.Database) error { return nil }
+
+func TestNewRunnerValidation(t *testing.T) {
+	_, err := NewRunner(nil, []Migration{{Version: 0, Up: noop}}, nil)
+	require.True(t, driver.IsInvalidArgument(err))
+	_, err = NewRunner(nil, []Migration{{Version: 1, Up: noop}, {Version: 1, Up: noop}}, nil)
+	require.True(t, driver.IsInvalidArgument(err))
+	_, err = NewRunner(nil, []Migration{{Version: 1}}, nil)
+	require.True(t, driver.IsInvalidArgument(err))
+
+	r, err := NewRunner(nil, []Migration{{Version: 3, Up: noop}, {Version: 1, Up: noop}}, nil)
+	require.NoError(t, err)
+	require.Equal(t, DefaultMigrationsCollection, r.opts.Collection)
+	require.Equal(t, defaultLockTTL, r.opts.LockTTL)
+	require.NotEmpty(t, r.opts.Owner)
+	require.Equal(t, int64(1), r.migrations[0].Version)
+}
+
+func TestRunnerPendingAndRevertible(t *testing.T) {
+	r, err := NewRunner(nil, []Migration{
+		{Version: 1, Up: noop, Down: noop},
+		{Version: 2, Up: noop},
+		{Version: 3, Up: noop, Down: noop},
+		{Version: 4, Up: noop, Down: noop},
+	}, nil)
+	require.NoError(t, err)
+
+	versions := func(migrations []Migration) []int64 {
+		var result []int64
+		for _, m := range migrations {
+			result = append(result, m.Version)
+		}
+		return result
+	}
+
+	applied := []AppliedMigration{{Version: 1}, {Version: 3}}
+	require.Equal(t, []int64{2, 4}, versions(r.pending(applied)))
+
+	applied = []AppliedMigration{{Version: 1}, {Version: 2}, {Version: 3}, {Version: 4}}
+	down, err := r.revertible(applied, 2)
+	require.NoError(t, err)
+	require.Equal(t, []int64{4, 3}, versions(down))
+
+	// Migration 2 has no Down function.
+	_, err = r.revertible(applied, 1)
+	require.True(t, driver.IsInvalidArgument(err))
+
+	// Migration 5 is not known to the runner.
+	_, err = r.revertible(append(applied, AppliedMigration{Version: 5}), 3)
+	require.True(t, driver.IsInvalidArgument(err))
+}
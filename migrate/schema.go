@@ -0,0 +1,96 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package migrate
+
+import (
+	driver "github.com/arangodb/go-driver"
+)
+
+// Schema is the desired state of a deployment.
+type Schema struct {
+	// Databases lists the databases that must exist.
+	Databases []Database
+}
+
+// Database is the desired state of a single database.
+type Database struct {
+	// Name of the database.
+	Name string
+	// Options used when the database has to be created.
+	Options *driver.CreateDatabaseOptions
+	// Analyzers lists the analyzers that must exist in the database.
+	// Analyzers are reconciled before views, so views can refer to them.
+	Analyzers []driver.ArangoSearchAnalyzerDefinition
+	// Collections lists the collections that must exist in the database.
+	Collections []Collection
+	// Views lists the ArangoSearch views that must exist in the database.
+	Views []View
+}
+
+// Collection is the desired state of a single collection.
+type Collection struct {
+	// Name of the collection.
+	Name string
+	// Options used when the collection has to be created.
+	Options *driver.CreateCollectionOptions
+	// Properties, if set, are applied to an existing collection when they differ
+	// from the properties reported by the server.
+	Properties *driver.SetCollectionPropertiesOptions
+	// Indexes lists the indexes that must exist on the collection.
+	Indexes []Index
+}
+
+// Index is the desired state of a single index.
+// Indexes are identified by their name, so Name is required.
+type Index struct {
+	// Name of the index.
+	Name string
+	// Type of the index. Supported types are PersistentIndex, TTLIndex, GeoIndex,
+	// FullTextIndex and InvertedIndex.
+	Type driver.IndexType
+	// Fields holds the indexed attribute paths.
+	// Not used for inverted indexes, which take their fields from Inverted.
+	Fields []string
+	// Unique creates a unique index (persistent indexes only).
+	Unique bool
+	// Sparse creates a sparse index (persistent indexes only).
+	Sparse bool
+	// ExpireAfter is the expiry time in seconds (TTL indexes only).
+	ExpireAfter int
+	// GeoJSON sets the GeoJSON option (geo indexes only).
+	GeoJSON bool
+	// MinLength is the minimum word length (fulltext indexes only).
+	MinLength int
+	// InBackground builds the index without holding an exclusive collection lock.
+	InBackground bool
+	// Inverted holds the options of an inverted index (inverted indexes only).
+	// Its Name is overwritten with the name of the index.
+	Inverted *driver.InvertedIndexOptions
+}
+
+// View is the desired state of a single ArangoSearch view.
+type View struct {
+	// Name of the view.
+	Name string
+	// Properties of the view. When the view exists but does not link all
+	// collections listed in Properties.Links, the properties are applied.
+	Properties *driver.ArangoSearchViewProperties
+}
@@ -28,15 +28,21 @@ import (
 )
 
 const (
-	keyQueryCount          = "arangodb-query-count"
-	keyQueryBatchSize      = "arangodb-query-batchSize"
-	keyQueryCache          = "arangodb-query-cache"
-	keyQueryMemoryLimit    = "arangodb-query-memoryLimit"
-	keyQueryTTL            = "arangodb-query-ttl"
-	keyQueryOptSatSyncWait = "arangodb-query-opt-satSyncWait"
-	keyQueryOptFullCount   = "arangodb-query-opt-fullCount"
-	keyQueryOptStream      = "arangodb-query-opt-stream"
-	keyQueryOptMaxRuntime  = "arangodb-query-opt-maxRuntime"
+	keyQueryCount                          = "arangodb-query-count"
+	keyQueryBatchSize                      = "arangodb-query-batchSize"
+	keyQueryCache                          = "arangodb-query-cache"
+	keyQueryMemoryLimit                    = "arangodb-query-memoryLimit"
+	keyQueryTTL                            = "arangodb-query-ttl"
+	keyQueryOptSatSyncWait                 = "arangodb-query-opt-satSyncWait"
+	keyQueryOptFullCount                   = "arangodb-query-opt-fullCount"
+	keyQueryOptStream                      = "arangodb-query-opt-stream"
+	keyQueryOptMaxRuntime                  = "arangodb-query-opt-maxRuntime"
+	keyQueryOptAllowRetry                  = "arangodb-query-opt-allowRetry"
+	keyQueryOptProfile                     = "arangodb-query-opt-profile"
+	keyQueryOptFillBlockCache              = "arangodb-query-opt-fillBlockCache"
+	keyQueryOptOptimizerRules              = "arangodb-query-opt-optimizerRules"
+	keyQueryOptMaxPlans                    = "arangodb-query-opt-maxPlans"
+	keyQueryOptForceOneShardAttributeValue = "arangodb-query-opt-forceOneShardAttributeValue"
 )
 
 // WithQueryCount is used to configure a context that will set the Count of a query request,
@@ -110,6 +116,65 @@ func WithQueryMaxRuntime(parent context.Context, value ...float64) context.Conte
 	return context.WithValue(contextOrBackground(parent), keyQueryOptMaxRuntime, v)
 }
 
+// WithQueryAllowRetry is used to configure a context that will make the query cursor keep the latest batch
+// on the server, so it can be fetched again when the connection drops while reading a batch.
+// See Cursor.RetryReadDocument.
+// If value is not given it defaults to true.
+// This option requires ArangoDB 3.11.
+func WithQueryAllowRetry(parent context.Context, value ...bool) context.Context {
+	v := true
+	if len(value) > 0 {
+		v = value[0]
+	}
+	return context.WithValue(contextOrBackground(parent), keyQueryOptAllowRetry, v)
+}
+
+// WithQueryProfile is used to configure a context that will make the query return profiling information.
+// With level 1 the duration of every execution phase is returned (see CursorExtra.GetProfile),
+// with level 2 the execution plan with runtime statistics of every execution node is returned as well
+// (see CursorExtra.GetPlan and QueryStatistics.Nodes).
+// If value is not given it defaults to 1.
+func WithQueryProfile(parent context.Context, value ...int) context.Context {
+	v := 1
+	if len(value) > 0 {
+		v = value[0]
+	}
+	return context.WithValue(contextOrBackground(parent), keyQueryOptProfile, v)
+}
+
+// WithQueryFillBlockCache is used to configure a context that will make the query store the data it reads
+// in the RocksDB block cache (value==true, server default) or not (value==false).
+// Not filling the block cache is useful for queries that read a lot of data that is not accessed again soon.
+// If value is not given it defaults to true.
+func WithQueryFillBlockCache(parent context.Context, value ...bool) context.Context {
+	v := true
+	if len(value) > 0 {
+		v = value[0]
+	}
+	return context.WithValue(contextOrBackground(parent), keyQueryOptFillBlockCache, v)
+}
+
+// WithQueryOptimizerRules is used to configure a context that will include or exclude optimizer rules for the query.
+// To disable a rule, prefix its name with a "-", to enable a rule, prefix it with a "+".
+// There is also a pseudo-rule "all", which matches all optimizer rules. "-all" disables all rules.
+func WithQueryOptimizerRules(parent context.Context, rules ...string) context.Context {
+	return context.WithValue(contextOrBackground(parent), keyQueryOptOptimizerRules, rules)
+}
+
+// WithQueryMaxPlans is used to configure a context that will limit the maximum number of plans
+// that are created by the AQL query optimizer.
+func WithQueryMaxPlans(parent context.Context, value int) context.Context {
+	return context.WithValue(contextOrBackground(parent), keyQueryOptMaxPlans, value)
+}
+
+// WithQueryForceOneShardAttributeValue is used to configure a context that will restrict the query to the
+// single shard that contains documents with the given shard key value.
+// This is an Enterprise Edition option that only applies to queries on OneShard databases or collections
+// sharded by a single attribute. Using a value that does not match the data of the query leads to wrong results.
+func WithQueryForceOneShardAttributeValue(parent context.Context, value string) context.Context {
+	return context.WithValue(contextOrBackground(parent), keyQueryOptForceOneShardAttributeValue, value)
+}
+
 type queryRequest struct {
 	// indicates whether the number of documents in the result set should be returned in the "count" attribute of the result.
 	// Calculating the "count" attribute might have a performance impact for some queries in the future so this option is
@@ -134,12 +199,13 @@ type queryRequest struct {
 	// key/value pairs representing the bind parameters.
 	BindVars map[string]interface{} `json:"bindVars,omitempty"`
 	Options  struct {
-		// If set to true, then the additional query profiling information will be returned in the sub-attribute profile of the
+		// If set to 1 (or higher), then the additional query profiling information will be returned in the sub-attribute profile of the
 		// extra return attribute if the query result is not served from the query cache.
-		Profile bool `json:"profile,omitempty"`
-		// A list of to-be-included or to-be-excluded optimizer rules can be put into this attribute, telling the optimizer to include or exclude specific rules.
+		// If set to 2, the query plan with runtime statistics of every execution node is returned as well.
+		Profile int `json:"profile,omitempty"`
+		// Optimizer contains a list of to-be-included or to-be-excluded optimizer rules, telling the optimizer to include or exclude specific rules.
 		// To disable a rule, prefix its name with a -, to enable a rule, prefix it with a +. There is also a pseudo-rule all, which will match all optimizer rules.
-		OptimizerRules string `json:"optimizer.rules,omitempty"`
+		Optimizer *ExplainQueryOptimizerOptions `json:"optimizer,omitempty"`
 		// This Enterprise Edition parameter allows to configure how long a DBServer will have time to bring the satellite collections
 		// involved in the query into sync. The default value is 60.0 (seconds). When the max time has been reached the query will be stopped.
 		SatelliteSyncWait float64 `json:"satelliteSyncWait,omitempty"`
@@ -161,6 +227,13 @@ type queryRequest struct {
 		// MaxRuntime specify the timeout which can be used to kill a query on the server after the specified
 		// amount in time. The timeout value is specified in seconds. A value of 0 means no timeout will be enforced.
 		MaxRuntime float64 `json:"maxRuntime,omitempty"`
+		// AllowRetry makes the server keep the latest batch of the cursor, so it can be requested again
+		// if the connection drops while the batch is being transferred.
+		AllowRetry bool `json:"allowRetry,omitempty"`
+		// FillBlockCache determines whether the query stores the data it reads in the RocksDB block cache.
+		FillBlockCache *bool `json:"fillBlockCache,omitempty"`
+		// ForceOneShardAttributeValue restricts the query to the shard that contains documents with this shard key value.
+		ForceOneShardAttributeValue string `json:"forceOneShardAttributeValue,omitempty"`
 	} `json:"options,omitempty"`
 }
 
@@ -214,9 +287,62 @@ func (q *queryRequest) applyContextSettings(ctx context.Context) {
 			q.Options.MaxRuntime = value
 		}
 	}
+	if rawValue := ctx.Value(keyQueryOptProfile); rawValue != nil {
+		if value, ok := rawValue.(int); ok {
+			q.Options.Profile = value
+		}
+	}
+	if rawValue := ctx.Value(keyQueryOptAllowRetry); rawValue != nil {
+		if value, ok := rawValue.(bool); ok {
+			q.Options.AllowRetry = value
+		}
+	}
+	if rawValue := ctx.Value(keyQueryOptFillBlockCache); rawValue != nil {
+		if value, ok := rawValue.(bool); ok {
+			q.Options.FillBlockCache = &value
+		}
+	}
+	if rawValue := ctx.Value(keyQueryOptOptimizerRules); rawValue != nil {
+		if value, ok := rawValue.([]string); ok {
+			q.Options.Optimizer = &ExplainQueryOptimizerOptions{Rules: value}
+		}
+	}
+	if rawValue := ctx.Value(keyQueryOptMaxPlans); rawValue != nil {
+		if value, ok := rawValue.(int); ok {
+			q.Options.MaxPlans = value
+		}
+	}
+	if rawValue := ctx.Value(keyQueryOptForceOneShardAttributeValue); rawValue != nil {
+		if value, ok := rawValue.(string); ok {
+			q.Options.ForceOneShardAttributeValue = value
+		}
+	}
+}
+
+type explainQueryRequest struct {
+	// contains the query string to be explained
+	Query string `json:"query"`
+	// key/value pairs representing the bind parameters.
+	BindVars map[string]interface{} `json:"bindVars,omitempty"`
+	// options for explaining the query
+	Options *ExplainQueryOptions `json:"options,omitempty"`
 }
 
 type parseQueryRequest struct {
 	// contains the query string to be executed
 	Query string `json:"query"`
 }
+
+// QueryAll performs an AQL query and reads all resulting documents into the slice pointed to by result
+// (e.g. `*[]MyDocument`). The cursor used to read the documents is always closed.
+func QueryAll(ctx context.Context, db Database, query string, bindVars map[string]interface{}, result interface{}) error {
+	cursor, err := db.Query(ctx, query, bindVars)
+	if err != nil {
+		return WithStack(err)
+	}
+	defer cursor.Close()
+	if err := cursor.ReadAll(ctx, result); err != nil {
+		return WithStack(err)
+	}
+	return nil
+}
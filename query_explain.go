@@ -0,0 +1,137 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package driver
+
+// ExplainQueryOptions contains options for explaining an AQL query.
+type ExplainQueryOptions struct {
+	// If set to true, all possible execution plans will be returned.
+	// The default is false, meaning only the optimal plan will be returned.
+	AllPlans bool `json:"allPlans,omitempty"`
+	// An optional maximum number of plans that the optimizer is allowed to generate.
+	// Setting this attribute to a low value allows to put a cap on the amount of work the optimizer does.
+	MaxNumberOfPlans int `json:"maxNumberOfPlans,omitempty"`
+	// Optimizer contains options related to the query optimizer.
+	Optimizer ExplainQueryOptimizerOptions `json:"optimizer,omitempty"`
+}
+
+// ExplainQueryOptimizerOptions contains options related to the query optimizer.
+type ExplainQueryOptimizerOptions struct {
+	// A list of to-be-included or to-be-excluded optimizer rules can be put into this attribute,
+	// telling the optimizer to include or exclude specific rules.
+	// To disable a rule, prefix its name with a "-", to enable a rule, prefix it with a "+".
+	// There is also a pseudo-rule "all", which matches all optimizer rules. "-all" disables all rules.
+	Rules []string `json:"rules,omitempty"`
+}
+
+// ExplainQueryResult is the result of explaining an AQL query.
+type ExplainQueryResult struct {
+	// Plan is the optimal execution plan of the query.
+	// It is not set when ExplainQueryOptions.AllPlans is true.
+	Plan *ExplainQueryPlan `json:"plan,omitempty"`
+	// Plans contains all execution plans of the query.
+	// It is only set when ExplainQueryOptions.AllPlans is true.
+	Plans []ExplainQueryPlan `json:"plans,omitempty"`
+	// Warnings contains the warnings raised while explaining the query.
+	Warnings []QueryWarning `json:"warnings,omitempty"`
+	// Stats contains statistics of the optimizer.
+	Stats ExplainQueryStats `json:"stats,omitempty"`
+	// Cacheable is true if the query result could be stored in the query results cache.
+	Cacheable *bool `json:"cacheable,omitempty"`
+}
+
+// ExplainQueryPlan is a single execution plan of an AQL query.
+type ExplainQueryPlan struct {
+	// Nodes contains the execution nodes of the plan.
+	Nodes []ExplainQueryPlanNode `json:"nodes,omitempty"`
+	// Rules contains the names of the optimizer rules that were applied to the plan.
+	Rules []string `json:"rules,omitempty"`
+	// Collections contains the collections used in the plan.
+	Collections []ExplainQueryPlanCollection `json:"collections,omitempty"`
+	// Variables contains the variables used in the plan.
+	Variables []ExplainQueryPlanVariable `json:"variables,omitempty"`
+	// EstimatedCost is the total estimated cost of the plan.
+	EstimatedCost float64 `json:"estimatedCost,omitempty"`
+	// EstimatedNrItems is the estimated number of results of the plan.
+	EstimatedNrItems int64 `json:"estimatedNrItems,omitempty"`
+	// IsModificationQuery is true if the query modifies data.
+	IsModificationQuery bool `json:"isModificationQuery,omitempty"`
+}
+
+// ExplainQueryPlanNode is a single execution node of an execution plan.
+// Only the attributes that are common to all node types are provided.
+type ExplainQueryPlanNode struct {
+	// Type is the type of the node, e.g. "EnumerateCollectionNode" or "IndexNode".
+	Type string `json:"type,omitempty"`
+	// ID is the identifier of the node within the plan.
+	ID int `json:"id,omitempty"`
+	// Dependencies contains the IDs of the nodes this node depends on.
+	Dependencies []int `json:"dependencies,omitempty"`
+	// EstimatedCost is the estimated cost of the node, including the cost of its dependencies.
+	EstimatedCost float64 `json:"estimatedCost,omitempty"`
+	// EstimatedNrItems is the estimated number of items produced by the node.
+	EstimatedNrItems int64 `json:"estimatedNrItems,omitempty"`
+}
+
+// ExplainQueryPlanCollection is a collection used by an execution plan.
+type ExplainQueryPlanCollection struct {
+	// Name of the collection.
+	Name string `json:"name"`
+	// Type of access to the collection ("read" or "write").
+	Type string `json:"type"`
+}
+
+// ExplainQueryPlanVariable is a variable used by an execution plan.
+type ExplainQueryPlanVariable struct {
+	// ID of the variable.
+	ID int `json:"id"`
+	// Name of the variable.
+	Name string `json:"name"`
+}
+
+// ExplainQueryStats contains statistics of the query optimizer.
+type ExplainQueryStats struct {
+	// RulesExecuted is the number of optimizer rules that were executed.
+	RulesExecuted int `json:"rulesExecuted,omitempty"`
+	// RulesSkipped is the number of optimizer rules that were skipped.
+	RulesSkipped int `json:"rulesSkipped,omitempty"`
+	// PlansCreated is the number of plans created by the optimizer.
+	PlansCreated int `json:"plansCreated,omitempty"`
+	// PeakMemoryUsage is the maximum memory usage of the optimizer (in bytes).
+	PeakMemoryUsage int64 `json:"peakMemoryUsage,omitempty"`
+	// ExecutionTime is the time spent by the optimizer (in seconds).
+	ExecutionTime float64 `json:"executionTime,omitempty"`
+}
+
+// QueryProfile contains the duration (in seconds) of every execution phase of a profiled query,
+// keyed by the name of the phase (e.g. "parsing", "optimizing plan", "executing").
+type QueryProfile map[string]float64
+
+// QueryNodeStatistics contains runtime statistics of a single execution node of a profiled query.
+type QueryNodeStatistics struct {
+	// ID of the execution node.
+	ID int `json:"id"`
+	// Calls is the number of calls to the node.
+	Calls int64 `json:"calls"`
+	// Items is the number of items returned by the node.
+	Items int64 `json:"items"`
+	// Runtime is the time spent in the node and its dependencies (in seconds).
+	Runtime float64 `json:"runtime"`
+}
@@ -0,0 +1,50 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package driver
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestQueryRequestApplyContextSettings(t *testing.T) {
+	ctx := WithQueryBatchSize(nil, 10)
+	ctx = WithQueryMemoryLimit(ctx, 1024)
+	ctx = WithQueryTTL(ctx, time.Minute)
+	ctx = WithQueryMaxRuntime(ctx, 2.5)
+	ctx = WithQueryFillBlockCache(ctx, false)
+	ctx = WithQueryOptimizerRules(ctx, "-all", "+use-indexes")
+	ctx = WithQueryMaxPlans(ctx, 3)
+	ctx = WithQueryForceOneShardAttributeValue(ctx, "tenant1")
+
+	req := queryRequest{Query: "RETURN 1"}
+	req.applyContextSettings(ctx)
+	encoded, err := json.Marshal(req)
+	if err != nil {
+		t.Fatalf("Marshal failed: %s", err)
+	}
+	expected := `{"batchSize":10,"memoryLimit":1024,"ttl":60,"query":"RETURN 1","options":{"optimizer":{"rules":["-all","+use-indexes"]},` +
+		`"maxPlans":3,"maxRuntime":2.5,"fillBlockCache":false,"forceOneShardAttributeValue":"tenant1"}}`
+	if string(encoded) != expected {
+		t.Errorf("Expected %s, got %s", expected, string(encoded))
+	}
+}
@@ -0,0 +1,124 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package driver
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	// queueTimeoutHeader is the request header used to specify the maximum time a request may be queued on the server.
+	queueTimeoutHeader = "x-arango-queue-time-seconds"
+	// queueTimeHeader is the response header containing the current queue time of the server.
+	queueTimeHeader = "X-Arango-Queue-Time-Seconds"
+)
+
+// QueueTimeExceededError is returned when the server rejects a request because it could not be
+// dequeued within the time given using WithArangoQueueTimeout.
+type QueueTimeExceededError struct {
+	ArangoError
+	// QueueTime is the queue time reported by the server (if any).
+	QueueTime time.Duration
+}
+
+// Error returns the error message of a QueueTimeExceededError.
+func (e QueueTimeExceededError) Error() string {
+	return fmt.Sprintf("Queue time requirement violated (queue time %s): %s", e.QueueTime, e.ArangoError.Error())
+}
+
+// Temporary returns true, since the request may succeed once the server is less busy.
+func (e QueueTimeExceededError) Temporary() bool {
+	return true
+}
+
+// Unwrap returns the underlying ArangoError.
+func (e QueueTimeExceededError) Unwrap() error {
+	return e.ArangoError
+}
+
+// IsQueueTimeExceeded returns true if the given error is (or is caused by) a QueueTimeExceededError.
+func IsQueueTimeExceeded(err error) bool {
+	return isCausedBy(err, func(e error) bool { _, ok := e.(QueueTimeExceededError); return ok })
+}
+
+// WithArangoQueueTimeout is used to configure a context that makes the server reject requests
+// that have been queued for longer than the given timeout, with a QueueTimeExceededError.
+// This needs ArangoDB 3.9 and up.
+func WithArangoQueueTimeout(parent context.Context, timeout time.Duration) context.Context {
+	return context.WithValue(contextOrBackground(parent), keyQueueTimeout, timeout)
+}
+
+// WithArangoQueueTime is used to configure a context that stores the queue time reported
+// by the server into the given value. This can be used to throttle requests when the server is busy.
+func WithArangoQueueTime(parent context.Context, value *time.Duration) context.Context {
+	return context.WithValue(contextOrBackground(parent), keyQueueTime, value)
+}
+
+// ArangoQueueTime returns the queue time reported by the server in the given response.
+func ArangoQueueTime(resp Response) (time.Duration, bool) {
+	value := resp.Header(queueTimeHeader)
+	if value == "" {
+		return 0, false
+	}
+	seconds, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, false
+	}
+	return time.Duration(seconds * float64(time.Second)), true
+}
+
+// ApplyQueueTimeSettings sets the queue timeout configured using WithArangoQueueTimeout on the given request.
+// It is intended to be called by Connection implementations.
+func ApplyQueueTimeSettings(ctx context.Context, req Request) {
+	if ctx == nil {
+		return
+	}
+	if v := ctx.Value(keyQueueTimeout); v != nil {
+		if timeout, ok := v.(time.Duration); ok && timeout > 0 {
+			req.SetHeader(queueTimeoutHeader, strconv.FormatFloat(timeout.Seconds(), 'f', -1, 64))
+		}
+	}
+}
+
+// LoadQueueTimeResponse stores the queue time of the given response in the value configured
+// using WithArangoQueueTime and returns a QueueTimeExceededError when the server rejected the
+// request because of its queue time requirement.
+// It is intended to be called by Connection implementations.
+func LoadQueueTimeResponse(ctx context.Context, resp Response) error {
+	queueTime, found := ArangoQueueTime(resp)
+	if found && ctx != nil {
+		if v := ctx.Value(keyQueueTime); v != nil {
+			if ref, ok := v.(*time.Duration); ok && ref != nil {
+				*ref = queueTime
+			}
+		}
+	}
+	if resp.StatusCode() == http.StatusPreconditionFailed {
+		if ae, ok := asArangoError(resp.CheckStatus()); ok && ae.ErrorNum == ErrQueueTimeRequirementViolated {
+			return QueueTimeExceededError{ArangoError: ae, QueueTime: queueTime}
+		}
+	}
+	return nil
+}
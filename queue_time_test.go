@@ -0,0 +1,81 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package driver
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+type testQueueTimeResponse struct {
+	Response
+	status    int
+	queueTime string
+	err       error
+}
+
+func (r *testQueueTimeResponse) StatusCode() int { return r.status }
+
+func (r *testQueueTimeResponse) Header(key string) string {
+	if key == queueTimeHeader {
+		return r.queueTime
+	}
+	return ""
+}
+
+func (r *testQueueTimeResponse) CheckStatus(validStatusCodes ...int) error { return r.err }
+
+func TestLoadQueueTimeResponse(t *testing.T) {
+	var queueTime time.Duration
+	ctx := WithArangoQueueTime(context.Background(), &queueTime)
+	resp := &testQueueTimeResponse{status: 200, queueTime: "0.25"}
+	if err := LoadQueueTimeResponse(ctx, resp); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if queueTime != 250*time.Millisecond {
+		t.Errorf("Expected queue time 250ms, got %s", queueTime)
+	}
+
+	resp = &testQueueTimeResponse{status: 412, queueTime: "2", err: newArangoError(412, ErrQueueTimeRequirementViolated, "queue time violated")}
+	err := LoadQueueTimeResponse(ctx, resp)
+	if !IsQueueTimeExceeded(err) {
+		t.Fatalf("Expected QueueTimeExceededError, got %v", err)
+	}
+	if qerr := err.(QueueTimeExceededError); qerr.QueueTime != 2*time.Second {
+		t.Errorf("Expected queue time 2s, got %s", qerr.QueueTime)
+	}
+
+	resp = &testQueueTimeResponse{status: 412, err: newArangoError(412, ErrArangoConflict, "conflict")}
+	if err := LoadQueueTimeResponse(ctx, resp); err != nil {
+		t.Errorf("Expected no error for other precondition failures, got %v", err)
+	}
+}
+
+func TestIsQueueTimeExceededWrapped(t *testing.T) {
+	qerr := QueueTimeExceededError{ArangoError: ArangoError{HasError: true, Code: 412, ErrorNum: ErrQueueTimeRequirementViolated}}
+	if !IsQueueTimeExceeded(&ResponseError{Err: qerr}) {
+		t.Error("Expected QueueTimeExceededError wrapped in a ResponseError to be detected")
+	}
+	if !IsArangoErrorWithErrorNum(qerr, ErrQueueTimeRequirementViolated) || !IsPreconditionFailed(qerr) {
+		t.Error("Expected QueueTimeExceededError to unwrap to its ArangoError")
+	}
+}
@@ -24,6 +24,7 @@ package driver
 
 import (
 	"context"
+	"io"
 	"time"
 )
 
@@ -65,4 +66,29 @@ type Replication interface {
 	// GetRevisionDocuments retrieves documents by revision.
 	GetRevisionDocuments(ctx context.Context, db Database, batchId, collection string,
 		revisions Revisions) ([]map[string]interface{}, error)
+
+	// DumpChunk reads the next chunk of documents of a collection, using the snapshot of the given batch.
+	// Call it repeatedly until the returned chunk has CheckMore set to false.
+	DumpChunk(ctx context.Context, db Database, batchID, collection string, opts *ReplicationDumpOptions) (ReplicationDumpChunk, error)
+
+	// Dump reads all documents of a collection, using the snapshot of the given batch,
+	// and writes them to the given writer (one document per line).
+	Dump(ctx context.Context, db Database, batchID, collection string, opts *ReplicationDumpOptions, w io.Writer) error
+}
+
+// ReplicationDumpOptions holds optional options for dumping a collection.
+type ReplicationDumpOptions struct {
+	// ChunkSize is the approximate maximum size (in bytes) of a single chunk.
+	// If not set, the server default is used.
+	ChunkSize int64
+}
+
+// ReplicationDumpChunk holds a chunk of documents returned by the replication dump API.
+type ReplicationDumpChunk struct {
+	// Data holds the documents of this chunk, one document per line.
+	Data []byte
+	// CheckMore is true when there are more documents to fetch.
+	CheckMore bool
+	// LastIncluded holds the tick of the last document in this chunk.
+	LastIncluded Tick
 }
@@ -25,6 +25,7 @@ package driver
 import (
 	"context"
 	"errors"
+	"io"
 	"path"
 	"strconv"
 	"sync/atomic"
@@ -99,6 +100,58 @@ func (c *client) DatabaseInventory(ctx context.Context, db Database) (DatabaseIn
 	return result, nil
 }
 
+// DumpChunk reads the next chunk of documents of a collection, using the snapshot of the given batch.
+func (c *client) DumpChunk(ctx context.Context, db Database, batchID, collection string, opts *ReplicationDumpOptions) (ReplicationDumpChunk, error) {
+	req, err := c.conn.NewRequest("GET", path.Join("_db", db.Name(), "_api/replication/dump"))
+	if err != nil {
+		return ReplicationDumpChunk{}, WithStack(err)
+	}
+	req = req.SetQuery("batchId", batchID)
+	req = req.SetQuery("collection", collection)
+	if opts != nil && opts.ChunkSize > 0 {
+		req = req.SetQuery("chunkSize", strconv.FormatInt(opts.ChunkSize, 10))
+	}
+	applyContextSettings(ctx, req)
+	var raw []byte
+	// Reading a chunk advances the dump of the batch, so a written request must not be repeated.
+	resp, err := c.conn.Do(WithRawResponse(withRetrySafe(ctx, false), &raw), req)
+	if err != nil {
+		return ReplicationDumpChunk{}, WithStack(err)
+	}
+	if err := resp.CheckStatus(200, 204); err != nil {
+		return ReplicationDumpChunk{}, WithStack(err)
+	}
+	return ReplicationDumpChunk{
+		Data:         raw,
+		CheckMore:    resp.Header("x-arango-replication-checkmore") == "true",
+		LastIncluded: Tick(resp.Header("x-arango-replication-lastincluded")),
+	}, nil
+}
+
+// Dump reads all documents of a collection, using the snapshot of the given batch,
+// and writes them to the given writer.
+func (c *client) Dump(ctx context.Context, db Database, batchID, collection string, opts *ReplicationDumpOptions, w io.Writer) error {
+	for {
+		chunk, err := c.DumpChunk(ctx, db, batchID, collection, opts)
+		if err != nil {
+			return WithStack(err)
+		}
+		if len(chunk.Data) > 0 {
+			if _, err := w.Write(chunk.Data); err != nil {
+				return WithStack(err)
+			}
+			if chunk.Data[len(chunk.Data)-1] != '\n' {
+				if _, err := w.Write([]byte{'\n'}); err != nil {
+					return WithStack(err)
+				}
+			}
+		}
+		if !chunk.CheckMore {
+			return nil
+		}
+	}
+}
+
 // BatchID reported by the server
 func (b batchMetadata) BatchID() string {
 	return b.ID
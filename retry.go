@@ -0,0 +1,202 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package driver
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy configures the automatic retry of failed requests.
+// Requests are retried on network errors and on 429 (too many requests) & 503 (service unavailable)
+// responses. Every attempt uses a fresh clone of the request, so request bodies are always resent.
+// Only requests that are safe to repeat (GET, HEAD, OPTIONS and DELETE requests that do not move
+// server-side state forward) are retried after they have been written to the network or answered
+// with 429 or 503. Other requests (e.g. POST, PUT, PATCH, fetching cursor batches or committing
+// transactions) are only retried on network errors that occurred before the request was written.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts (including the first one).
+	// If this value is <= 1, no retries are performed.
+	MaxAttempts int
+	// InitialBackoff is the time to wait before the first retry. Defaults to 100ms.
+	InitialBackoff time.Duration
+	// MaxBackoff is the maximum time to wait between attempts. Defaults to 5s.
+	MaxBackoff time.Duration
+	// Multiplier is the factor by which the backoff grows after every attempt. Defaults to 2.
+	Multiplier float64
+	// Jitter is the fraction (0-1) of the backoff that is randomized. Defaults to 0.2.
+	Jitter float64
+}
+
+// backoff returns the time to wait before the given retry (1 = first retry).
+func (p RetryPolicy) backoff(retry int) time.Duration {
+	initial, max, multiplier, jitter := p.InitialBackoff, p.MaxBackoff, p.Multiplier, p.Jitter
+	if initial <= 0 {
+		initial = 100 * time.Millisecond
+	}
+	if max <= 0 {
+		max = 5 * time.Second
+	}
+	if multiplier < 1 {
+		multiplier = 2
+	}
+	if jitter <= 0 || jitter > 1 {
+		jitter = 0.2
+	}
+	d := float64(initial)
+	for i := 1; i < retry && d < float64(max); i++ {
+		d *= multiplier
+	}
+	if d > float64(max) {
+		d = float64(max)
+	}
+	d -= d * jitter * rand.Float64()
+	return time.Duration(d)
+}
+
+// isIdempotentMethod returns true if a request with the given method can safely be repeated.
+// PUT is not included, since several PUT requests of the ArangoDB API are not idempotent
+// (e.g. reading the next batch of a cursor, committing a transaction or fetching an async job result).
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case "GET", "HEAD", "OPTIONS", "DELETE":
+		return true
+	default:
+		return false
+	}
+}
+
+// RequestAttempt returns the attempt number of the request performed with the given context:
+// 1 for the first attempt, higher for retries performed according to the RetryPolicy of the client.
+// It is intended to be used by Connection implementations and wrappers (e.g. to report retries as metrics).
+func RequestAttempt(ctx context.Context) int {
+	if ctx != nil {
+		if attempt, ok := ctx.Value(keyRequestAttempt).(int); ok && attempt > 0 {
+			return attempt
+		}
+	}
+	return 1
+}
+
+// newRetryConnection creates a connection that retries requests on the given connection according to the given policy.
+func newRetryConnection(conn Connection, policy RetryPolicy, logger Logger, logLevel LogLevel) Connection {
+	if logLevel == "" {
+		logLevel = LogLevelInfo
+	}
+	return &retryConnection{
+		Connection: conn,
+		policy:     policy,
+		logger:     logger,
+		logLevel:   logLevel,
+	}
+}
+
+// retryConnection is a Connection that retries failed requests.
+type retryConnection struct {
+	Connection
+	policy   RetryPolicy
+	logger   Logger
+	logLevel LogLevel
+}
+
+// Do performs a given request, returning its response.
+func (c *retryConnection) Do(ctx context.Context, req Request) (Response, error) {
+	ctx = contextOrBackground(ctx)
+	for attempt := 1; ; attempt++ {
+		attemptReq := req
+		if attempt < c.policy.MaxAttempts {
+			attemptReq = req.Clone()
+		}
+		resp, err := c.Connection.Do(context.WithValue(ctx, keyRequestAttempt, attempt), attemptReq)
+		if attempt >= c.policy.MaxAttempts || !c.shouldRetry(ctx, attemptReq, resp, err) {
+			return resp, err
+		}
+		wait := c.policy.backoff(attempt)
+		if resp != nil {
+			if d, ok := parseRetryAfter(resp.Header("Retry-After")); ok && d > wait {
+				wait = d
+			}
+		}
+		if c.logger != nil {
+			args := []interface{}{"method", req.Method(), "path", req.Path(), "attempt", attempt, "backoff", wait}
+			if err != nil {
+				args = append(args, "error", err.Error())
+			} else {
+				args = append(args, "status", resp.StatusCode())
+			}
+			logAt(c.logger, c.logLevel, "Retrying request", args...)
+		}
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			return nil, WithStack(ctx.Err())
+		}
+	}
+}
+
+// shouldRetry returns true if the given outcome of a request should result in a retry.
+func (c *retryConnection) shouldRetry(ctx context.Context, req Request, resp Response, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	if err == nil {
+		code := resp.StatusCode()
+		return (code == http.StatusTooManyRequests || code == http.StatusServiceUnavailable) && isRetrySafe(ctx, req)
+	}
+	if IsCanceled(err) || IsTimeout(err) || IsArangoError(err) || IsInvalidArgument(err) {
+		return false
+	}
+	// Network error. Only retry when the server cannot have processed the request.
+	return isRetrySafe(ctx, req) || (!req.Written() && !IsResponse(err))
+}
+
+// isRetrySafe returns true if the given request can be repeated after it has been written.
+// The default derived from the request method can be overridden using withRetrySafe.
+func isRetrySafe(ctx context.Context, req Request) bool {
+	if safe, ok := ctx.Value(keyRetrySafe).(bool); ok {
+		return safe
+	}
+	return isIdempotentMethod(req.Method())
+}
+
+// parseRetryAfter parses the value of a Retry-After header given in seconds.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	seconds, err := strconv.Atoi(value)
+	if err != nil || seconds < 0 {
+		return 0, false
+	}
+	return time.Duration(seconds) * time.Second, true
+}
+
+// SetAuthentication creates a copy of the connection with the given authentication.
+func (c *retryConnection) SetAuthentication(auth Authentication) (Connection, error) {
+	conn, err := c.Connection.SetAuthentication(auth)
+	if err != nil {
+		return nil, WithStack(err)
+	}
+	return newRetryConnection(conn, c.policy, c.logger, c.logLevel), nil
+}
@@ -0,0 +1,171 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package driver
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+type testRetryResponse struct {
+	Response
+	status int
+}
+
+func (r *testRetryResponse) StatusCode() int          { return r.status }
+func (r *testRetryResponse) Header(key string) string { return "" }
+
+type testRetryRequest struct {
+	Request
+	method  string
+	written bool
+}
+
+func (r *testRetryRequest) Method() string { return r.method }
+func (r *testRetryRequest) Path() string   { return "_api/document/c/k" }
+func (r *testRetryRequest) Written() bool  { return r.written }
+func (r *testRetryRequest) Clone() Request { clone := *r; return &clone }
+
+type testRetryConnection struct {
+	Connection
+	results []error
+	calls   int
+}
+
+func (c *testRetryConnection) Do(ctx context.Context, req Request) (Response, error) {
+	err := c.results[c.calls]
+	c.calls++
+	if err == nil {
+		if c.calls < len(c.results) {
+			return &testRetryResponse{status: 503}, nil
+		}
+		return &testRetryResponse{status: 200}, nil
+	}
+	req.(*testRetryRequest).written = true
+	return nil, &ResponseError{Err: err}
+}
+
+func TestRetryConnectionStatus(t *testing.T) {
+	conn := &testRetryConnection{results: []error{nil, nil, nil}}
+	rc := newRetryConnection(conn, RetryPolicy{MaxAttempts: 5, InitialBackoff: time.Millisecond}, nil, "")
+	resp, err := rc.Do(context.Background(), &testRetryRequest{method: "GET"})
+	if err != nil {
+		t.Fatalf("Do failed: %v", err)
+	}
+	if resp.StatusCode() != 200 || conn.calls != 3 {
+		t.Errorf("Expected status 200 after 3 calls, got %d after %d calls", resp.StatusCode(), conn.calls)
+	}
+
+	// Requests that are not safe to repeat are not retried on 503.
+	for _, method := range []string{"POST", "PUT"} {
+		conn = &testRetryConnection{results: []error{nil, nil}}
+		rc = newRetryConnection(conn, RetryPolicy{MaxAttempts: 5, InitialBackoff: time.Millisecond}, nil, "")
+		resp, err = rc.Do(context.Background(), &testRetryRequest{method: method})
+		if err != nil {
+			t.Fatalf("Do failed: %v", err)
+		}
+		if resp.StatusCode() != 503 || conn.calls != 1 {
+			t.Errorf("Expected %s request not to be retried, got status %d after %d calls", method, resp.StatusCode(), conn.calls)
+		}
+	}
+}
+
+func TestRetryConnectionNonIdempotent(t *testing.T) {
+	netErr := errors.New("connection reset")
+	conn := &testRetryConnection{results: []error{netErr, nil}}
+	rc := newRetryConnection(conn, RetryPolicy{MaxAttempts: 5, InitialBackoff: time.Millisecond}, nil, "")
+	if _, err := rc.Do(context.Background(), &testRetryRequest{method: "POST"}); err == nil {
+		t.Fatal("Expected error")
+	}
+	if conn.calls != 1 {
+		t.Errorf("Expected written POST request not to be retried, got %d calls", conn.calls)
+	}
+
+	conn = &testRetryConnection{results: []error{netErr, nil}}
+	rc = newRetryConnection(conn, RetryPolicy{MaxAttempts: 5, InitialBackoff: time.Millisecond}, nil, "")
+	if _, err := rc.Do(context.Background(), &testRetryRequest{method: "GET"}); err != nil {
+		t.Fatalf("Do failed: %v", err)
+	}
+	if conn.calls != 2 {
+		t.Errorf("Expected GET request to be retried, got %d calls", conn.calls)
+	}
+
+	conn = &testRetryConnection{results: []error{netErr, nil}}
+	rc = newRetryConnection(conn, RetryPolicy{MaxAttempts: 5, InitialBackoff: time.Millisecond}, nil, "")
+	if _, err := rc.Do(withRetrySafe(context.Background(), false), &testRetryRequest{method: "GET"}); err == nil {
+		t.Fatal("Expected error")
+	}
+	if conn.calls != 1 {
+		t.Errorf("Expected written GET request marked unsafe not to be retried, got %d calls", conn.calls)
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	p := RetryPolicy{InitialBackoff: time.Second, MaxBackoff: 3 * time.Second, Multiplier: 2, Jitter: 0.5}
+	for retry, max := range map[int]time.Duration{1: time.Second, 2: 2 * time.Second, 5: 3 * time.Second} {
+		if d := p.backoff(retry); d > max || d < max/2 {
+			t.Errorf("Backoff of retry %d out of range: %s", retry, d)
+		}
+	}
+}
+
+type testRetryCursorConnection struct {
+	testRetryConnection
+	methods []string
+}
+
+func (c *testRetryCursorConnection) NewRequest(method, path string) (Request, error) {
+	c.methods = append(c.methods, method)
+	return &testRetryRequest{method: method}, nil
+}
+
+func TestRetryConnectionCursorBatch(t *testing.T) {
+	netErr := errors.New("connection reset")
+	conn := &testRetryCursorConnection{testRetryConnection: testRetryConnection{results: []error{netErr, nil}}}
+	rc := newRetryConnection(conn, RetryPolicy{MaxAttempts: 5, InitialBackoff: time.Millisecond}, nil, "")
+	c, err := newCursor(cursorData{ID: "1", HasMore: true}, "", &database{conn: rc}, false, false, false)
+	if err != nil {
+		t.Fatalf("Expected success, got %s", err)
+	}
+	var doc interface{}
+	if _, err := c.ReadDocument(context.Background(), &doc); err == nil {
+		t.Fatal("Expected error")
+	}
+	// Repeating the written PUT would silently skip the batch whose response was lost.
+	if conn.calls != 1 {
+		t.Errorf("Expected written PUT cursor request not to be retried, got %d calls", conn.calls)
+	}
+
+	conn = &testRetryCursorConnection{testRetryConnection: testRetryConnection{results: []error{netErr, netErr}}}
+	rc = newRetryConnection(conn, RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Millisecond}, nil, "")
+	c, err = newCursor(cursorData{ID: "1", HasMore: true, NextBatchID: "2"}, "", &database{conn: rc}, false, false, true)
+	if err != nil {
+		t.Fatalf("Expected success, got %s", err)
+	}
+	if _, err := c.ReadDocument(context.Background(), &doc); err == nil {
+		t.Fatal("Expected error")
+	}
+	if conn.calls != 2 || conn.methods[0] != "POST" {
+		t.Errorf("Expected written POST request for a batch ID to be retried, got %d calls using %v", conn.calls, conn.methods)
+	}
+}
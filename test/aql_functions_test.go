@@ -0,0 +1,80 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package test
+
+import (
+	"context"
+	"testing"
+
+	driver "github.com/arangodb/go-driver"
+)
+
+// TestAQLFunctions creates, lists, uses and removes user-defined AQL functions.
+func TestAQLFunctions(t *testing.T) {
+	ctx := context.Background()
+	c := createClientFromEnv(t, true)
+	db := ensureDatabase(ctx, c, "aql_functions_test", nil, t)
+
+	name := "gotest::temperature::celsiustofahrenheit"
+	code := "function (celsius) { return celsius * 1.8 + 32; }"
+	created, err := db.CreateAQLFunction(ctx, name, code, &driver.CreateAQLFunctionOptions{IsDeterministic: true})
+	if err != nil {
+		t.Fatalf("CreateAQLFunction failed: %s", describe(err))
+	}
+	if !created {
+		t.Error("Expected function to be newly created")
+	}
+	// Replace the function
+	if created, err := db.CreateAQLFunction(ctx, name, code, nil); err != nil {
+		t.Fatalf("CreateAQLFunction failed: %s", describe(err))
+	} else if created {
+		t.Error("Expected function to be replaced")
+	}
+
+	fns, err := db.AQLFunctions(ctx, "gotest::")
+	if err != nil {
+		t.Fatalf("AQLFunctions failed: %s", describe(err))
+	}
+	if len(fns) != 1 || fns[0].Name != name {
+		t.Errorf("Expected function '%s', got %v", name, fns)
+	}
+
+	cursor, err := db.Query(ctx, "RETURN GOTEST::TEMPERATURE::CELSIUSTOFAHRENHEIT(100)", nil)
+	if err != nil {
+		t.Fatalf("Query failed: %s", describe(err))
+	}
+	var result float64
+	if _, err := cursor.ReadDocument(ctx, &result); err != nil {
+		t.Errorf("ReadDocument failed: %s", describe(err))
+	} else if result != 212 {
+		t.Errorf("Expected 212, got %f", result)
+	}
+	cursor.Close()
+
+	if count, err := db.RemoveAQLFunction(ctx, "gotest", &driver.RemoveAQLFunctionOptions{Group: true}); err != nil {
+		t.Fatalf("RemoveAQLFunction failed: %s", describe(err))
+	} else if count != 1 {
+		t.Errorf("Expected 1 removed function, got %d", count)
+	}
+	if _, err := db.RemoveAQLFunction(ctx, name, nil); !driver.IsNotFound(err) {
+		t.Errorf("Expected NotFound error, got %s", describe(err))
+	}
+}
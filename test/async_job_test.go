@@ -0,0 +1,71 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	driver "github.com/arangodb/go-driver"
+)
+
+// TestAsyncJob tests starting an async job and fetching its result.
+func TestAsyncJob(t *testing.T) {
+	c := createClientFromEnv(t, true)
+	ctx := context.Background()
+	db := ensureDatabase(ctx, c, "async_job_test", nil, t)
+	col := ensureCollection(ctx, db, "async_job_test", nil, t)
+
+	doc := UserDoc{Name: "Async", Age: 42}
+	_, err := col.CreateDocument(driver.WithAsync(ctx), doc)
+	jobID, isAsync := driver.IsAsyncJobInProgress(err)
+	require.True(t, isAsync, "Expected async job, got %s", describe(err))
+	require.NotEmpty(t, jobID)
+
+	deadline := time.Now().Add(time.Minute)
+	for {
+		status, err := c.AsyncJob().Status(ctx, jobID)
+		require.NoError(t, err)
+		if status == driver.JobDone {
+			break
+		}
+		require.True(t, time.Now().Before(deadline), "Job did not finish in time")
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	ids, err := c.AsyncJob().List(ctx, driver.JobDone, nil)
+	require.NoError(t, err)
+	require.Contains(t, ids, jobID)
+
+	meta, err := col.CreateDocument(driver.WithAsyncID(ctx, jobID), doc)
+	require.NoError(t, err)
+	require.NotEmpty(t, meta.Key)
+
+	var readDoc UserDoc
+	_, err = col.ReadDocument(ctx, meta.Key, &readDoc)
+	require.NoError(t, err)
+	require.Equal(t, doc, readDoc)
+
+	require.NoError(t, c.AsyncJob().Delete(ctx, driver.DeleteExpiredJobs, &driver.AsyncJobDeleteOptions{Stamp: time.Now()}))
+}
@@ -0,0 +1,75 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	driver "github.com/arangodb/go-driver"
+	"github.com/arangodb/go-driver/changefeed"
+)
+
+// TestChangefeedTailer tests that the Tailer emits the operations on a collection.
+func TestChangefeedTailer(t *testing.T) {
+	ctx := context.Background()
+	c := createClientFromEnv(t, true)
+	if _, err := c.Cluster(ctx); err == nil {
+		t.Skip("Skipping in cluster")
+	} else if !driver.IsPreconditionFailed(err) {
+		t.Fatalf("Failed to query cluster: %s", describe(err))
+	}
+	db := ensureDatabase(ctx, c, "changefeed_test", nil, t)
+	col := ensureCollection(ctx, db, "changes", nil, t)
+
+	tailer := changefeed.NewTailer(c, db, &changefeed.TailerOptions{PollInterval: time.Millisecond * 100})
+	runCtx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+	go tailer.Run(runCtx)
+
+	// Wait until the tailer has determined its start tick
+	for tailer.LastTick() == "" {
+		time.Sleep(time.Millisecond * 10)
+	}
+	meta, err := col.CreateDocument(ctx, UserDoc{Name: "Jan", Age: 40})
+	require.NoError(t, err)
+	_, err = col.RemoveDocument(ctx, meta.Key)
+	require.NoError(t, err)
+
+	var ops []changefeed.Operation
+	for op := range tailer.Operations() {
+		if op.Collection != col.Name() {
+			continue
+		}
+		ops = append(ops, op)
+		if len(ops) == 2 {
+			break
+		}
+	}
+	require.Len(t, ops, 2)
+	require.Equal(t, changefeed.OperationSave, ops[0].Type)
+	require.Equal(t, meta.Key, ops[0].Key)
+	require.Equal(t, changefeed.OperationRemove, ops[1].Type)
+	require.Equal(t, meta.Key, ops[1].Key)
+}
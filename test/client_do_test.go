@@ -0,0 +1,46 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	driver "github.com/arangodb/go-driver"
+)
+
+// TestClientDo tests performing raw requests using Client.Do.
+func TestClientDo(t *testing.T) {
+	c := createClientFromEnv(t, true)
+	ctx := context.Background()
+
+	var version driver.VersionInfo
+	resp, err := c.Do(ctx, "GET", "_api/version", nil, &version)
+	require.NoError(t, err, describe(err))
+	require.Equal(t, 200, resp.StatusCode())
+	require.Equal(t, "arango", version.Server)
+
+	var result []interface{}
+	_, err = c.Do(ctx, "PUT", "_api/simple/does-not-exist", map[string]interface{}{"a": 1}, &result)
+	require.True(t, driver.IsNotFound(err), describe(err))
+}
@@ -548,3 +548,176 @@ func TestClusterMoveShardWithViews(t *testing.T) {
 		}
 	}
 }
+
+// TestClusterShardDistribution tests the Cluster.ShardDistribution method.
+func TestClusterShardDistribution(t *testing.T) {
+	ctx := context.Background()
+	c := createClientFromEnv(t, true)
+	cl, err := c.Cluster(ctx)
+	if driver.IsPreconditionFailed(err) {
+		t.Skip("Not a cluster")
+	} else if err != nil {
+		t.Fatalf("Cluster failed: %s", describe(err))
+	}
+	db := ensureDatabase(ctx, c, "cluster_shard_distribution_test", nil, t)
+	ensureCollection(ctx, db, "shard_distribution", &driver.CreateCollectionOptions{
+		NumberOfShards: 2,
+	}, t)
+
+	distribution, err := cl.ShardDistribution(ctx, db)
+	if err != nil {
+		t.Fatalf("ShardDistribution failed: %s", describe(err))
+	}
+	col, found := distribution["shard_distribution"]
+	if !found {
+		t.Fatalf("Expected collection in shard distribution, got %v", distribution)
+	}
+	if len(col.Plan) != 2 {
+		t.Errorf("Expected 2 planned shards, got %d", len(col.Plan))
+	}
+	for shard, servers := range col.Plan {
+		if servers.Leader == "" {
+			t.Errorf("Expected leader for shard %s", shard)
+		}
+	}
+}
+
+// TestClusterRebalance tests the Cluster rebalance methods.
+func TestClusterRebalance(t *testing.T) {
+	ctx := context.Background()
+	c := createClientFromEnv(t, true)
+	skipBelowVersion(c, "3.10", t)
+	cl, err := c.Cluster(ctx)
+	if driver.IsPreconditionFailed(err) {
+		t.Skip("Not a cluster")
+	} else if err != nil {
+		t.Fatalf("Cluster failed: %s", describe(err))
+	}
+
+	status, err := cl.RebalanceStatus(ctx)
+	if err != nil {
+		t.Fatalf("RebalanceStatus failed: %s", describe(err))
+	}
+	if status.Shards.TotalShards == 0 {
+		t.Error("Expected shards in rebalance status")
+	}
+
+	moveLeaders := true
+	plan, err := cl.ComputeRebalancePlan(ctx, &driver.RebalanceOptions{
+		MaximumNumberOfMoves: 1,
+		MoveLeaders:          &moveLeaders,
+	})
+	if err != nil {
+		t.Fatalf("ComputeRebalancePlan failed: %s", describe(err))
+	}
+	if len(plan.Moves) > 1 {
+		t.Errorf("Expected at most 1 move, got %d", len(plan.Moves))
+	}
+	if err := cl.ExecuteRebalancePlan(ctx, plan.Moves); err != nil {
+		t.Fatalf("ExecuteRebalancePlan failed: %s", describe(err))
+	}
+}
+
+// TestClusterJob tests the Cluster.Job method with the job of a shard move.
+func TestClusterJob(t *testing.T) {
+	ctx := context.Background()
+	c := createClientFromEnv(t, true)
+	cl, err := c.Cluster(ctx)
+	if driver.IsPreconditionFailed(err) {
+		t.Skip("Not a cluster")
+	} else if err != nil {
+		t.Fatalf("Cluster failed: %s", describe(err))
+	}
+	h, err := cl.Health(ctx)
+	if err != nil {
+		t.Fatalf("Health failed: %s", describe(err))
+	}
+	var dbServers []driver.ServerID
+	for id, s := range h.Health {
+		if s.Role == driver.ServerRoleDBServer {
+			dbServers = append(dbServers, id)
+		}
+	}
+	if len(dbServers) < 2 {
+		t.Skip("At least 2 dbservers required")
+	}
+	db := ensureDatabase(ctx, c, "cluster_job_test", nil, t)
+	col := ensureCollection(ctx, db, "cluster_job", &driver.CreateCollectionOptions{
+		NumberOfShards: 1,
+	}, t)
+	shards, err := col.Shards(ctx, true)
+	if err != nil {
+		t.Fatalf("Shards failed: %s", describe(err))
+	}
+	for shard, servers := range shards.Shards {
+		var target driver.ServerID
+		for _, id := range dbServers {
+			if id != servers[0] {
+				target = id
+				break
+			}
+		}
+		var jobID string
+		if err := cl.MoveShard(driver.WithJobIDResponse(ctx, &jobID), col, shard, servers[0], target); err != nil {
+			t.Fatalf("MoveShard failed: %s", describe(err))
+		}
+		job, err := cl.Job(ctx, jobID)
+		if err != nil {
+			t.Fatalf("Job failed: %s", describe(err))
+		}
+		if job.ID != jobID {
+			t.Errorf("Expected job ID %s, got %s", jobID, job.ID)
+		}
+	}
+}
+
+// TestClusterMaintenance tests the cluster and server maintenance methods.
+func TestClusterMaintenance(t *testing.T) {
+	ctx := context.Background()
+	c := createClientFromEnv(t, true)
+	skipBelowVersion(c, "3.8", t)
+	cl, err := c.Cluster(ctx)
+	if driver.IsPreconditionFailed(err) {
+		t.Skip("Not a cluster")
+	} else if err != nil {
+		t.Fatalf("Cluster failed: %s", describe(err))
+	}
+
+	if err := cl.SetMaintenance(ctx, true); err != nil {
+		t.Fatalf("SetMaintenance failed: %s", describe(err))
+	}
+	if err := cl.SetMaintenance(ctx, false); err != nil {
+		t.Fatalf("SetMaintenance failed: %s", describe(err))
+	}
+
+	h, err := cl.Health(ctx)
+	if err != nil {
+		t.Fatalf("Health failed: %s", describe(err))
+	}
+	for id, s := range h.Health {
+		if s.Role != driver.ServerRoleDBServer {
+			continue
+		}
+		if err := cl.SetServerMaintenance(ctx, id, driver.ServerMaintenanceModeMaintenance, time.Minute); err != nil {
+			t.Fatalf("SetServerMaintenance failed: %s", describe(err))
+		}
+		m, err := cl.ServerMaintenance(ctx, id)
+		if err != nil {
+			t.Fatalf("ServerMaintenance failed: %s", describe(err))
+		}
+		if m.Mode != driver.ServerMaintenanceModeMaintenance {
+			t.Errorf("Expected maintenance mode, got '%s'", m.Mode)
+		}
+		if err := cl.SetServerMaintenance(ctx, id, driver.ServerMaintenanceModeNormal, 0); err != nil {
+			t.Fatalf("SetServerMaintenance failed: %s", describe(err))
+		}
+		m, err = cl.ServerMaintenance(ctx, id)
+		if err != nil {
+			t.Fatalf("ServerMaintenance failed: %s", describe(err))
+		}
+		if m.Mode != driver.ServerMaintenanceModeNormal {
+			t.Errorf("Expected normal mode, got '%s'", m.Mode)
+		}
+		break
+	}
+}
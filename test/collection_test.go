@@ -964,3 +964,212 @@ func TestCollectionWriteConcernSetPropInvalid(t *testing.T) {
 	assert.Equalf(t, defaultWriteConcern, prop.WriteConcern, "MinReplicationFactor not updated, expected %d, found %d",
 		minRepl, prop.WriteConcern)
 }
+
+// TestCollectionComputedValues creates a collection with computed values and modifies them.
+func TestCollectionComputedValues(t *testing.T) {
+	ctx := context.Background()
+	c := createClientFromEnv(t, true)
+	skipBelowVersion(c, "3.10", t)
+	db := ensureDatabase(ctx, c, "collection_test", nil, t)
+	name := "test_collection_computed_values"
+	computedValue := driver.ComputedValue{
+		Name:       "createdAt",
+		Expression: "RETURN DATE_NOW()",
+		Overwrite:  true,
+		ComputeOn:  []driver.ComputeOn{driver.ComputeOnInsert},
+		KeepNull:   newBool(false),
+	}
+	col := ensureCollection(ctx, db, name, &driver.CreateCollectionOptions{
+		ComputedValues: []driver.ComputedValue{computedValue},
+	}, t)
+
+	prop, err := col.Properties(ctx)
+	require.NoError(t, err)
+	require.Len(t, prop.ComputedValues, 1)
+	require.Equal(t, computedValue.Name, prop.ComputedValues[0].Name)
+	require.Equal(t, computedValue.Expression, prop.ComputedValues[0].Expression)
+	require.Equal(t, computedValue.ComputeOn, prop.ComputedValues[0].ComputeOn)
+
+	meta, err := col.CreateDocument(ctx, map[string]interface{}{"name": "foo"})
+	require.NoError(t, err)
+	var doc map[string]interface{}
+	_, err = col.ReadDocument(ctx, meta.Key, &doc)
+	require.NoError(t, err)
+	require.Contains(t, doc, "createdAt")
+
+	computedValue.Name = "updatedAt"
+	computedValue.ComputeOn = []driver.ComputeOn{driver.ComputeOnUpdate, driver.ComputeOnReplace}
+	err = col.SetProperties(ctx, driver.SetCollectionPropertiesOptions{
+		ComputedValues: []driver.ComputedValue{computedValue},
+	})
+	require.NoError(t, err)
+
+	prop, err = col.Properties(ctx)
+	require.NoError(t, err)
+	require.Len(t, prop.ComputedValues, 1)
+	require.Equal(t, "updatedAt", prop.ComputedValues[0].Name)
+}
+
+// TestCollectionKeyOptions creates collections with different key generators and checks their properties.
+func TestCollectionKeyOptions(t *testing.T) {
+	ctx := context.Background()
+	c := createClientFromEnv(t, true)
+	db := ensureDatabase(ctx, c, "collection_test", nil, t)
+
+	t.Run("autoincrement", func(t *testing.T) {
+		col := ensureCollection(ctx, db, "test_collection_key_autoincrement", &driver.CreateCollectionOptions{
+			KeyOptions: &driver.CollectionKeyOptions{
+				Type:             driver.KeyGeneratorAutoIncrement,
+				AllowUserKeysPtr: newBool(false),
+				Increment:        5,
+				Offset:           10,
+			},
+		}, t)
+		if _, err := col.CreateDocument(ctx, map[string]interface{}{"name": "foo"}); err != nil {
+			t.Fatalf("Failed to create document: %s", describe(err))
+		}
+		prop, err := col.Properties(ctx)
+		require.NoError(t, err)
+		require.Equal(t, driver.KeyGeneratorAutoIncrement, prop.KeyOptions.Type)
+		require.False(t, prop.KeyOptions.AllowUserKeys)
+		require.Equal(t, 5, prop.KeyOptions.Increment)
+		require.Equal(t, 10, prop.KeyOptions.Offset)
+		require.NotZero(t, prop.KeyOptions.LastValue)
+	})
+
+	t.Run("padded", func(t *testing.T) {
+		skipBelowVersion(c, "3.4", t)
+		col := ensureCollection(ctx, db, "test_collection_key_padded", &driver.CreateCollectionOptions{
+			KeyOptions: &driver.CollectionKeyOptions{
+				Type: driver.KeyGeneratorPadded,
+			},
+		}, t)
+		prop, err := col.Properties(ctx)
+		require.NoError(t, err)
+		require.Equal(t, driver.KeyGeneratorPadded, prop.KeyOptions.Type)
+	})
+
+	t.Run("uuid", func(t *testing.T) {
+		skipBelowVersion(c, "3.4", t)
+		col := ensureCollection(ctx, db, "test_collection_key_uuid", &driver.CreateCollectionOptions{
+			KeyOptions: &driver.CollectionKeyOptions{
+				Type: driver.KeyGeneratorUUID,
+			},
+		}, t)
+		prop, err := col.Properties(ctx)
+		require.NoError(t, err)
+		require.Equal(t, driver.KeyGeneratorUUID, prop.KeyOptions.Type)
+	})
+}
+
+// TestCollectionChecksum creates a collection and checks that its checksum changes when documents are added.
+func TestCollectionChecksum(t *testing.T) {
+	ctx := context.Background()
+	c := createClientFromEnv(t, true)
+	db := ensureDatabase(ctx, c, "collection_test", nil, t)
+	col := ensureCollection(ctx, db, "test_collection_checksum", nil, t)
+
+	before, err := col.Checksum(ctx, true, true)
+	require.NoError(t, err)
+	require.NotEmpty(t, before.Checksum)
+
+	_, err = col.CreateDocument(ctx, map[string]interface{}{"name": "foo"})
+	require.NoError(t, err)
+
+	after, err := col.Checksum(ctx, true, true)
+	require.NoError(t, err)
+	require.NotEqual(t, before.Checksum, after.Checksum)
+	require.NotEqual(t, before.Revision, after.Revision)
+}
+
+// TestCollectionStatisticsDetails checks that collection statistics contain engine details when requested.
+func TestCollectionStatisticsDetails(t *testing.T) {
+	ctx := context.Background()
+	c := createClientFromEnv(t, true)
+	skipBelowVersion(c, "3.8", t)
+	db := ensureDatabase(ctx, c, "collection_test", nil, t)
+	col := ensureCollection(ctx, db, "test_collection_statistics_details", nil, t)
+
+	_, err := col.CreateDocument(ctx, map[string]interface{}{"name": "foo"})
+	require.NoError(t, err)
+
+	stats, err := col.Statistics(ctx)
+	require.NoError(t, err)
+	require.Nil(t, stats.Figures.Engine)
+
+	stats, err = col.Statistics(driver.WithDetails(ctx))
+	require.NoError(t, err)
+	require.NotNil(t, stats.Figures.Engine)
+	require.EqualValues(t, 1, stats.Figures.Engine.Documents)
+	require.NotEmpty(t, stats.Figures.Engine.Indexes)
+}
+
+// TestCollectionShards checks the shards of a collection in a cluster.
+func TestCollectionShards(t *testing.T) {
+	ctx := context.Background()
+	c := createClientFromEnv(t, true)
+	skipNoCluster(c, t)
+	db := ensureDatabase(ctx, c, "collection_test", nil, t)
+	col := ensureCollection(ctx, db, "test_collection_shards", &driver.CreateCollectionOptions{
+		NumberOfShards:    3,
+		ReplicationFactor: 2,
+	}, t)
+
+	shards, err := col.Shards(ctx, false)
+	require.NoError(t, err)
+	require.Len(t, shards.Shards, 3)
+	require.Equal(t, 3, shards.Properties.NumberOfShards)
+	require.Equal(t, []string{"_key"}, shards.Properties.ShardKeys)
+	for _, servers := range shards.Shards {
+		require.Empty(t, servers)
+	}
+
+	shards, err = col.Shards(ctx, true)
+	require.NoError(t, err)
+	require.Len(t, shards.Shards, 3)
+	for shardID, servers := range shards.Shards {
+		require.Len(t, servers, 2, "shard %s", shardID)
+	}
+}
+
+// TestCollectionCompactAndLoadIndexes truncates and compacts a collection and loads its indexes into memory.
+func TestCollectionCompactAndLoadIndexes(t *testing.T) {
+	ctx := context.Background()
+	c := createClientFromEnv(t, true)
+	skipBelowVersion(c, "3.6", t)
+	db := ensureDatabase(ctx, c, "collection_test", nil, t)
+	col := ensureCollection(ctx, db, "test_collection_compact", nil, t)
+
+	_, err := col.CreateDocument(ctx, map[string]interface{}{"name": "foo"})
+	require.NoError(t, err)
+
+	loaded, err := col.LoadIndexesIntoMemory(ctx)
+	require.NoError(t, err)
+	require.True(t, loaded)
+
+	require.NoError(t, col.Truncate(driver.WithCompact(driver.WithWaitForSync(ctx), false)))
+	count, err := col.Count(ctx)
+	require.NoError(t, err)
+	require.Zero(t, count)
+
+	require.NoError(t, col.Compact(ctx))
+}
+
+// TestCreateCollectionDistributeShardsLike creates a collection that follows the sharding of another collection.
+func TestCreateCollectionDistributeShardsLike(t *testing.T) {
+	ctx := context.Background()
+	c := createClientFromEnv(t, true)
+	skipNoCluster(c, t)
+	db := ensureDatabase(ctx, c, "collection_test", nil, t)
+	ensureCollection(ctx, db, "test_collection_prototype", &driver.CreateCollectionOptions{
+		NumberOfShards: 2,
+	}, t)
+	col := ensureCollection(ctx, db, "test_collection_follower", &driver.CreateCollectionOptions{
+		DistributeShardsLike: "test_collection_prototype",
+	}, t)
+
+	prop, err := col.Properties(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "test_collection_prototype", prop.DistributeShardsLike)
+	require.Equal(t, 2, prop.NumberOfShards)
+}
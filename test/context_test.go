@@ -25,6 +25,7 @@ package test
 import (
 	"context"
 	"testing"
+	"time"
 
 	driver "github.com/arangodb/go-driver"
 )
@@ -46,4 +47,11 @@ func TestContextParentNil(t *testing.T) {
 	testValue(driver.WithSilent(nil))
 	testValue(driver.WithWaitForSync(nil))
 	testValue(driver.WithRawResponse(nil, &[]byte{}))
+	testValue(driver.WithIndexStats(nil))
+	testValue(driver.WithDropCollections(nil))
+	testValue(driver.WithRefillIndexCaches(nil))
+	testValue(driver.WithCompact(nil))
+	testValue(driver.WithStreamingResponse(nil))
+	testValue(driver.WithArangoQueueTimeout(nil, time.Second))
+	testValue(driver.WithArangoQueueTime(nil, nil))
 }
@@ -448,3 +448,145 @@ func TestCreateStreamCursor(t *testing.T) {
 		t.Errorf("Expected to read %d documents, instead got %d", expectedResults, readCount)
 	}
 }
+
+// TestCreateCursorWithAllowRetry creates a cursor with allowRetry and re-reads documents.
+func TestCreateCursorWithAllowRetry(t *testing.T) {
+	c := createClientFromEnv(t, true)
+	skipBelowVersion(c, "3.11", t)
+
+	db := ensureDatabase(nil, c, "cursor_test", nil, t)
+	ctx := driver.WithQueryAllowRetry(driver.WithQueryBatchSize(nil, 2))
+	cursor, err := db.Query(ctx, "FOR i IN 1..5 RETURN i", nil)
+	if err != nil {
+		t.Fatalf("Query failed: %s", describe(err))
+	}
+	defer cursor.Close()
+
+	var results []int
+	for cursor.HasMore() {
+		var v int
+		if _, err := cursor.ReadDocument(nil, &v); err != nil {
+			t.Fatalf("ReadDocument failed: %s", describe(err))
+		}
+		var retried int
+		if _, err := cursor.RetryReadDocument(nil, &retried); err != nil {
+			t.Fatalf("RetryReadDocument failed: %s", describe(err))
+		}
+		if retried != v {
+			t.Errorf("Expected retried document %d, got %d", v, retried)
+		}
+		results = append(results, v)
+	}
+	if len(results) != 5 {
+		t.Errorf("Expected 5 documents, got %d", len(results))
+	}
+
+	// Cursors without allowRetry cannot retry
+	cursor2, err := db.Query(nil, "FOR i IN 1..5 RETURN i", nil)
+	if err != nil {
+		t.Fatalf("Query failed: %s", describe(err))
+	}
+	defer cursor2.Close()
+	var v int
+	if _, err := cursor2.RetryReadDocument(nil, &v); !driver.IsInvalidArgument(err) {
+		t.Errorf("Expected InvalidArgumentError, got %s", describe(err))
+	}
+}
+
+// TestCursorExtra checks the statistics and warnings of a cursor.
+func TestCursorExtra(t *testing.T) {
+	c := createClientFromEnv(t, true)
+	skipBelowVersion(c, "3.8", t)
+
+	db := ensureDatabase(nil, c, "cursor_test", nil, t)
+	ctx := driver.WithQueryFullCount(nil)
+	cursor, err := db.Query(ctx, "FOR i IN 1..10 FILTER i > 2 LIMIT 2 RETURN i / 0", nil)
+	if err != nil {
+		t.Fatalf("Query failed: %s", describe(err))
+	}
+	defer cursor.Close()
+
+	extra := cursor.Extra()
+	if len(extra.GetWarnings()) == 0 {
+		t.Error("Expected warnings, got none")
+	}
+	stats := extra.GetStatistics()
+	if stats.FullCount() != 8 {
+		t.Errorf("Expected fullCount 8, got %d", stats.FullCount())
+	}
+	if stats.Filtered() != 2 {
+		t.Errorf("Expected filtered 2, got %d", stats.Filtered())
+	}
+	if stats.PeakMemoryUsage() <= 0 {
+		t.Errorf("Expected peakMemoryUsage > 0, got %d", stats.PeakMemoryUsage())
+	}
+	if stats.ExecutionTime() <= 0 {
+		t.Errorf("Expected executionTime > 0, got %s", stats.ExecutionTime())
+	}
+}
+
+// TestCursorReadAll reads all documents of a query into a slice.
+func TestCursorReadAll(t *testing.T) {
+	c := createClientFromEnv(t, true)
+	db := ensureDatabase(nil, c, "cursor_test", nil, t)
+	ctx := driver.WithQueryBatchSize(nil, 3)
+
+	cursor, err := db.Query(ctx, "FOR i IN 1..10 RETURN {name: CONCAT('user', i), age: i}", nil)
+	if err != nil {
+		t.Fatalf("Query failed: %s", describe(err))
+	}
+	var users []UserDoc
+	if err := cursor.ReadAll(ctx, &users); err != nil {
+		t.Fatalf("ReadAll failed: %s", describe(err))
+	}
+	if len(users) != 10 {
+		t.Fatalf("Expected 10 documents, got %d", len(users))
+	}
+	if users[9].Name != "user10" || users[9].Age != 10 {
+		t.Errorf("Unexpected last document: %v", users[9])
+	}
+
+	var ages []int
+	if err := driver.QueryAll(ctx, db, "FOR i IN 1..@n RETURN i", map[string]interface{}{"n": 5}, &ages); err != nil {
+		t.Fatalf("QueryAll failed: %s", describe(err))
+	}
+	if len(ages) != 5 || ages[4] != 5 {
+		t.Errorf("Expected [1 2 3 4 5], got %v", ages)
+	}
+
+	var invalid []int
+	if err := driver.QueryAll(ctx, db, "RETURN 1", nil, invalid); !driver.IsInvalidArgument(err) {
+		t.Errorf("Expected InvalidArgumentError, got %s", describe(err))
+	}
+}
+
+// TestCursorDocuments reads all documents of a query using the documents channel.
+func TestCursorDocuments(t *testing.T) {
+	c := createClientFromEnv(t, true)
+	db := ensureDatabase(nil, c, "cursor_test", nil, t)
+	ctx := driver.WithQueryBatchSize(context.Background(), 4)
+
+	cursor, err := db.Query(ctx, "FOR i IN 1..25 RETURN i", nil)
+	if err != nil {
+		t.Fatalf("Query failed: %s", describe(err))
+	}
+	defer cursor.Close()
+
+	expected := 1
+	for doc := range cursor.Documents(ctx) {
+		var value int
+		if _, err := doc.Read(&value); err != nil {
+			t.Fatalf("Read failed: %s", describe(err))
+		}
+		if value != expected {
+			t.Errorf("Expected %d, got %d", expected, value)
+		}
+		expected++
+	}
+	if expected != 26 {
+		t.Errorf("Expected 25 documents, got %d", expected-1)
+	}
+	if cursor.HasMore() {
+		t.Error("Expected cursor to be drained")
+	}
+}
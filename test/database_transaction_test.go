@@ -147,3 +147,35 @@ func TestTransactionAbort(t *testing.T) {
 	// document should exist
 	documentExists(ctx, col, meta1.Key, false, t)
 }
+
+func TestTransactions(t *testing.T) {
+	c := createClientFromEnv(t, true)
+	skipBelowVersion(c, "3.5", t)
+	colname := "trx_test_col_list"
+	ctx := context.Background()
+	db := ensureDatabase(ctx, c, "trx_test", nil, t)
+	ensureCollection(ctx, db, colname, nil, t)
+
+	trxid, err := db.BeginTransaction(ctx, driver.TransactionCollections{Read: []string{colname}}, nil)
+	if err != nil {
+		t.Fatalf("Failed to begin transaction: %s", describe(err))
+	}
+	defer db.AbortTransaction(ctx, trxid, nil)
+
+	trxs, err := db.Transactions(ctx)
+	if err != nil {
+		t.Fatalf("Failed to list transactions: %s", describe(err))
+	}
+	found := false
+	for _, trx := range trxs {
+		if trx.ID == trxid {
+			found = true
+			if trx.State != driver.TransactionRunning {
+				t.Errorf("Expected state '%s', got '%s'", driver.TransactionRunning, trx.State)
+			}
+		}
+	}
+	if !found {
+		t.Errorf("Transaction %s not found in %v", trxid, trxs)
+	}
+}
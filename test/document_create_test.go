@@ -188,3 +188,22 @@ func TestCreateDocumentInWaitForSyncCollection(t *testing.T) {
 		t.Errorf("Got wrong document. Expected %+v, got %+v", doc, readDoc)
 	}
 }
+
+// TestCreateDocumentRefillIndexCaches creates a document with refillIndexCaches enabled.
+func TestCreateDocumentRefillIndexCaches(t *testing.T) {
+	c := createClientFromEnv(t, true)
+	skipBelowVersion(c, "3.10", t)
+	db := ensureDatabase(nil, c, "document_test", nil, t)
+	col := ensureCollection(nil, db, "document_test", nil, t)
+	ctx := driver.WithRefillIndexCaches(nil)
+	meta, err := col.CreateDocument(ctx, UserDoc{"Jan", 40})
+	if err != nil {
+		t.Fatalf("Failed to create new document: %s", describe(err))
+	}
+	if _, err := col.UpdateDocument(ctx, meta.Key, map[string]interface{}{"age": 41}); err != nil {
+		t.Fatalf("Failed to update document: %s", describe(err))
+	}
+	if _, err := col.RemoveDocument(ctx, meta.Key); err != nil {
+		t.Fatalf("Failed to remove document: %s", describe(err))
+	}
+}
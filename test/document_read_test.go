@@ -66,3 +66,74 @@ func TestReadDocumentWithIfMatch(t *testing.T) {
 		t.Errorf("Expected status code 412, found %d", resp.StatusCode())
 	}
 }
+
+// TestDocumentsExist creates a document and checks the existence of it and a missing document.
+func TestDocumentsExist(t *testing.T) {
+	c := createClientFromEnv(t, true)
+	db := ensureDatabase(nil, c, "document_read_test", nil, t)
+	col := ensureCollection(nil, db, "document_read_test", nil, t)
+	meta, err := col.CreateDocument(nil, UserDoc{"Jan", 40})
+	if err != nil {
+		t.Fatalf("Failed to create new document: %s", describe(err))
+	}
+
+	if found, err := col.DocumentExists(nil, meta.Key); err != nil {
+		t.Errorf("DocumentExists failed: %s", describe(err))
+	} else if !found {
+		t.Error("DocumentExists returned false, expected true")
+	}
+
+	found, err := col.DocumentsExist(nil, []string{meta.Key, "does_not_exist", meta.Key})
+	if err != nil {
+		t.Fatalf("DocumentsExist failed: %s", describe(err))
+	}
+	if len(found) != 3 || !found[0] || found[1] || !found[2] {
+		t.Errorf("Expected [true false true], got %v", found)
+	}
+}
+
+// TestReadDocumentProjection creates a document and reads only some of its attributes.
+func TestReadDocumentProjection(t *testing.T) {
+	ctx := context.Background()
+	c := createClientFromEnv(t, true)
+	db := ensureDatabase(ctx, c, "document_test", nil, t)
+	col := ensureCollection(ctx, db, "document_test", nil, t)
+	doc := map[string]interface{}{
+		"name":    "Projected",
+		"age":     42,
+		"payload": "a large attribute that is not needed",
+	}
+	meta, err := col.CreateDocument(ctx, doc)
+	if err != nil {
+		t.Fatalf("Failed to create new document: %s", describe(err))
+	}
+
+	var readDoc map[string]interface{}
+	readMeta, err := col.ReadDocument(driver.WithProjection(ctx, "name", "age"), meta.Key, &readDoc)
+	if err != nil {
+		t.Fatalf("Failed to read document '%s': %s", meta.Key, describe(err))
+	}
+	if readMeta != meta {
+		t.Errorf("Expected meta %+v, got %+v", meta, readMeta)
+	}
+	if _, found := readDoc["payload"]; found {
+		t.Errorf("Expected payload to be omitted, got %+v", readDoc)
+	}
+	if readDoc["name"] != "Projected" || readDoc["age"] != float64(42) {
+		t.Errorf("Got wrong document %+v", readDoc)
+	}
+
+	if _, err := col.ReadDocument(driver.WithProjection(ctx, "name"), "does_not_exist", nil); !driver.IsNotFound(err) {
+		t.Errorf("Expected NotFoundError, got %s", describe(err))
+	}
+
+	// The revision configured using WithRevision is checked
+	revCtx := driver.WithProjection(driver.WithRevision(ctx, meta.Rev), "name")
+	if _, err := col.ReadDocument(revCtx, meta.Key, nil); err != nil {
+		t.Errorf("Expected success for matching revision, got %s", describe(err))
+	}
+	revCtx = driver.WithProjection(driver.WithRevision(ctx, "12345"), "name")
+	if _, err := col.ReadDocument(revCtx, meta.Key, nil); !driver.IsPreconditionFailed(err) {
+		t.Errorf("Expected PreconditionFailedError for other revision, got %s", describe(err))
+	}
+}
@@ -0,0 +1,79 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package test
+
+import (
+	"context"
+	"testing"
+
+	driver "github.com/arangodb/go-driver"
+)
+
+// TestUpsertDocument upserts the same document twice and checks that it is inserted once and updated once.
+func TestUpsertDocument(t *testing.T) {
+	ctx := context.Background()
+	c := createClientFromEnv(t, true)
+	db := ensureDatabase(ctx, c, "document_test", nil, t)
+	col := ensureCollection(ctx, db, "document_upsert_test", nil, t)
+
+	search := map[string]interface{}{"name": "Upsert"}
+	insert := UserDoc{Name: "Upsert", Age: 1}
+	update := map[string]interface{}{"age": 2}
+
+	var created UserDoc
+	meta, inserted, err := col.UpsertDocument(driver.WithReturnNew(ctx, &created), search, insert, update)
+	if err != nil {
+		t.Fatalf("Failed to upsert document: %s", describe(err))
+	}
+	if !inserted {
+		t.Error("Expected document to be inserted")
+	}
+	if meta.Key == "" || meta.Rev == "" {
+		t.Errorf("Expected key and revision to be set, got %+v", meta)
+	}
+	if created != insert {
+		t.Errorf("Expected new document %+v, got %+v", insert, created)
+	}
+
+	var old, updated UserDoc
+	updateCtx := driver.WithReturnOld(driver.WithReturnNew(ctx, &updated), &old)
+	meta2, inserted, err := col.UpsertDocument(updateCtx, search, insert, update)
+	if err != nil {
+		t.Fatalf("Failed to upsert document: %s", describe(err))
+	}
+	if inserted {
+		t.Error("Expected document to be updated")
+	}
+	if meta2.Key != meta.Key || meta2.Rev == meta.Rev {
+		t.Errorf("Expected same key and new revision, got %+v after %+v", meta2, meta)
+	}
+	if old.Age != 1 || updated.Age != 2 {
+		t.Errorf("Expected age to change from 1 to 2, got %d to %d", old.Age, updated.Age)
+	}
+
+	count, err := col.Count(ctx)
+	if err != nil {
+		t.Fatalf("Count failed: %s", describe(err))
+	}
+	if count != 1 {
+		t.Errorf("Expected 1 document, got %d", count)
+	}
+}
@@ -152,8 +152,8 @@ func TestCreateDocumentsSilent(t *testing.T) {
 		if len(metas) != 0 {
 			t.Errorf("Expected 0 metas, got %d", len(metas))
 		}
-		if len(errs) != 0 {
-			t.Errorf("Expected 0 errors, got %d", len(errs))
+		if err := errs.FirstNonNil(); err != nil {
+			t.Errorf("Expected no errors, got %s", describe(err))
 		}
 	}
 }
@@ -226,3 +226,35 @@ func TestCreateDocumentsInWaitForSyncCollection(t *testing.T) {
 		}
 	}
 }
+
+// TestCreateDocumentsSilentWithErrors creates documents with WithSilent, where one of them fails.
+func TestCreateDocumentsSilentWithErrors(t *testing.T) {
+	c := createClientFromEnv(t, true)
+	db := ensureDatabase(nil, c, "document_test", nil, t)
+	col := ensureCollection(nil, db, "documents_test", nil, t)
+	meta, err := col.CreateDocument(nil, UserDoc{"Jan", 40})
+	if err != nil {
+		t.Fatalf("Failed to create new document: %s", describe(err))
+	}
+	docs := []UserDocWithKeyWithOmit{
+		{Name: "Piet", Age: 3},
+		{Key: meta.Key, Name: "Duplicate", Age: 4},
+		{Name: "Mies", Age: 2},
+	}
+	metas, errs, err := col.CreateDocuments(driver.WithSilent(nil), docs)
+	if err != nil {
+		t.Fatalf("Failed to create new documents: %s", describe(err))
+	}
+	if len(metas) != 0 {
+		t.Errorf("Expected 0 metas, got %d", len(metas))
+	}
+	if len(errs) != len(docs) {
+		t.Fatalf("Expected %d errors, got %d", len(docs), len(errs))
+	}
+	if errs[0] != nil || errs[2] != nil {
+		t.Errorf("Expected no errors at index 0 and 2, got %v", errs)
+	}
+	if !driver.IsConflict(errs[1]) {
+		t.Errorf("Expected ConflictError at index 1, got %s", describe(errs[1]))
+	}
+}
@@ -23,6 +23,7 @@
 package test
 
 import (
+	"fmt"
 	"testing"
 
 	driver "github.com/arangodb/go-driver"
@@ -603,3 +604,31 @@ func TestImportDocumentsWithKeysInWaitForSyncCollection(t *testing.T) {
 		}
 	}
 }
+
+// TestImportWriter imports JSON lines in chunks using an ImportWriter.
+func TestImportWriter(t *testing.T) {
+	c := createClientFromEnv(t, true)
+	db := ensureDatabase(nil, c, "document_test", nil, t)
+	col := ensureCollection(nil, db, "import_writer_test", nil, t)
+	if err := col.Truncate(nil); err != nil {
+		t.Fatalf("Failed to truncate collection: %s", describe(err))
+	}
+
+	w := driver.NewImportWriter(nil, col, &driver.ImportWriterOptions{ChunkSize: 10})
+	for i := 0; i < 25; i++ {
+		if _, err := fmt.Fprintf(w, "{\"name\":\"user%d\",\"age\":%d}\n", i, i); err != nil {
+			t.Fatalf("Failed to write document: %s", describe(err))
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Failed to close import writer: %s", describe(err))
+	}
+	if stats := w.Statistics(); stats.Created != 25 {
+		t.Errorf("Expected 25 created documents, got %d", stats.Created)
+	}
+	if count, err := col.Count(nil); err != nil {
+		t.Errorf("Failed to count documents: %s", describe(err))
+	} else if count != 25 {
+		t.Errorf("Expected 25 documents, got %d", count)
+	}
+}
@@ -137,8 +137,8 @@ func TestRemoveDocumentsSilent(t *testing.T) {
 		if len(rmetas) > 0 {
 			t.Errorf("Expected empty metas, got %d", len(rmetas))
 		}
-		if len(rerrs) > 0 {
-			t.Errorf("Expected empty errors, got %d", len(rerrs))
+		if err := rerrs.FirstNonNil(); err != nil {
+			t.Errorf("Expected no errors, got %s", describe(err))
 		}
 	}
 	// Should not longer exist
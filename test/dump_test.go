@@ -0,0 +1,92 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	driver "github.com/arangodb/go-driver"
+	"github.com/arangodb/go-driver/dump"
+)
+
+// TestDumpRestoreCollection dumps a collection and restores it into another collection.
+func TestDumpRestoreCollection(t *testing.T) {
+	ctx := context.Background()
+	c := createClientFromEnv(t, true)
+	db := ensureDatabase(ctx, c, "dump_test", nil, t)
+	col := ensureCollection(ctx, db, "dump_source", nil, t)
+	target := ensureCollection(ctx, db, "dump_target", nil, t)
+
+	docs := make([]UserDoc, 25)
+	for i := range docs {
+		docs[i] = UserDoc{Name: "user", Age: i}
+	}
+	_, _, err := col.CreateDocuments(ctx, docs)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	count, err := dump.DumpCollection(ctx, db, col.Name(), &buf, &dump.DumpOptions{BatchSize: 10})
+	require.NoError(t, err)
+	require.Equal(t, int64(len(docs)), count)
+
+	restored, err := dump.RestoreCollection(ctx, target, &buf, &dump.RestoreOptions{BatchSize: 4, Parallelism: 3})
+	require.NoError(t, err)
+	require.Equal(t, int64(len(docs)), restored)
+
+	targetCount, err := target.Count(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(len(docs)), targetCount)
+}
+
+// TestDumpRestoreDatabase dumps a database into a directory and restores it into another database.
+func TestDumpRestoreDatabase(t *testing.T) {
+	ctx := context.Background()
+	c := createClientFromEnv(t, true)
+	source := ensureDatabase(ctx, c, "dump_source_db", nil, t)
+	col := ensureCollection(ctx, source, "books", nil, t)
+	_, _, err := col.EnsurePersistentIndex(ctx, []string{"name"}, &driver.EnsurePersistentIndexOptions{Name: "by_name"})
+	require.NoError(t, err)
+	_, err = col.CreateDocument(ctx, UserDoc{Name: "Jan", Age: 40})
+	require.NoError(t, err)
+
+	dir, err := ioutil.TempDir("", "dump_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	require.NoError(t, dump.DumpDatabase(ctx, c, source, dir, nil))
+
+	target := ensureDatabase(ctx, c, "dump_target_db", nil, t)
+	require.NoError(t, dump.RestoreDatabase(ctx, c, target, dir, &dump.DatabaseRestoreOptions{Overwrite: true}))
+
+	restored, err := target.Collection(ctx, "books")
+	require.NoError(t, err)
+	count, err := restored.Count(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), count)
+	found, err := restored.IndexExists(ctx, "by_name")
+	require.NoError(t, err)
+	require.True(t, found)
+}
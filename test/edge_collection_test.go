@@ -206,3 +206,102 @@ func TestSetVertexConstraints(t *testing.T) {
 		}
 	}
 }
+
+// TestRemoveEdgeCollectionFromGraph creates a graph with an edge collection and then removes the edge definition by name.
+func TestRemoveEdgeCollectionFromGraph(t *testing.T) {
+	c := createClientFromEnv(t, true)
+	db := ensureDatabase(nil, c, "edge_collection_test", nil, t)
+	name := "test_remove_edge_collection_from_graph"
+	g, err := db.CreateGraph(nil, name, nil)
+	if err != nil {
+		t.Fatalf("Failed to create graph '%s': %s", name, describe(err))
+	}
+
+	colName := "remove_edge_collection_from_graph_friends"
+	if _, err := g.CreateEdgeCollection(nil, colName, driver.VertexConstraints{From: []string{"person"}, To: []string{"person"}}); err != nil {
+		t.Fatalf("CreateEdgeCollection failed: %s", describe(err))
+	}
+
+	// Remove edge definition and drop the collection
+	if err := g.RemoveEdgeCollection(driver.WithDropCollections(nil), colName); err != nil {
+		t.Fatalf("RemoveEdgeCollection failed: %s", describe(err))
+	}
+	if found, err := g.EdgeCollectionExists(nil, colName); err != nil {
+		t.Errorf("EdgeCollectionExists failed: %s", describe(err))
+	} else if found {
+		t.Errorf("EdgeCollectionExists return true, expected false")
+	}
+	if found, err := db.CollectionExists(nil, colName); err != nil {
+		t.Errorf("CollectionExists failed: %s", describe(err))
+	} else if found {
+		t.Errorf("CollectionExists return true, expected false")
+	}
+
+	// Removing it again must fail
+	if err := g.RemoveEdgeCollection(nil, colName); !driver.IsNotFound(err) {
+		t.Errorf("Expected NotFound error, got %s", describe(err))
+	}
+}
+
+// TestEdges creates edges between vertices and fetches the edges of a vertex in every direction.
+func TestEdges(t *testing.T) {
+	ctx := context.Background()
+	c := createClientFromEnv(t, true)
+	db := ensureDatabase(ctx, c, "edge_collection_test", nil, t)
+	g := ensureGraph(ctx, db, "edges_test", nil, t)
+	ec := ensureEdgeCollection(ctx, g, "edges_test_routes", []string{"edges_test_cities"}, []string{"edges_test_cities"}, t)
+	vc, err := g.VertexCollection(ctx, "edges_test_cities")
+	if err != nil {
+		t.Fatalf("VertexCollection failed: %s", describe(err))
+	}
+
+	cities := []UserDocWithKey{{Key: "amsterdam"}, {Key: "berlin"}, {Key: "cologne"}}
+	if _, _, err := vc.CreateDocuments(ctx, cities); err != nil {
+		t.Fatalf("CreateDocuments failed: %s", describe(err))
+	}
+	if err := ec.Truncate(ctx); err != nil {
+		t.Fatalf("Truncate failed: %s", describe(err))
+	}
+	routes := []RouteEdge{
+		{From: "edges_test_cities/amsterdam", To: "edges_test_cities/berlin", Distance: 650},
+		{From: "edges_test_cities/berlin", To: "edges_test_cities/cologne", Distance: 570},
+		{From: "edges_test_cities/cologne", To: "edges_test_cities/amsterdam", Distance: 260},
+	}
+	if _, _, err := ec.CreateDocuments(ctx, routes); err != nil {
+		t.Fatalf("CreateDocuments failed: %s", describe(err))
+	}
+
+	countEdges := func(cursor driver.Cursor, err error) int {
+		if err != nil {
+			t.Fatalf("Edges failed: %s", describe(err))
+		}
+		defer cursor.Close()
+		count := 0
+		for cursor.HasMore() {
+			var edge RouteEdge
+			if _, err := cursor.ReadDocument(ctx, &edge); err != nil {
+				t.Fatalf("ReadDocument failed: %s", describe(err))
+			}
+			count++
+		}
+		return count
+	}
+	vertex := driver.NewDocumentID("edges_test_cities", "berlin")
+	expected := map[driver.EdgeDirection]int{
+		driver.EdgeDirectionIn:  1,
+		driver.EdgeDirectionOut: 1,
+		driver.EdgeDirectionAny: 2,
+	}
+	for direction, count := range expected {
+		if n := countEdges(ec.Edges(ctx, vertex, direction)); n != count {
+			t.Errorf("Expected %d %s edges in collection, got %d", count, direction, n)
+		}
+		if n := countEdges(g.Edges(ctx, vertex, direction)); n != count {
+			t.Errorf("Expected %d %s edges in graph, got %d", count, direction, n)
+		}
+	}
+
+	if _, err := ec.Edges(ctx, "invalid", driver.EdgeDirectionAny); !driver.IsInvalidArgument(err) {
+		t.Errorf("Expected InvalidArgumentError, got %s", describe(err))
+	}
+}
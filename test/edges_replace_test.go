@@ -247,8 +247,8 @@ func TestReplaceEdgesSilent(t *testing.T) {
 	if metas, errs, err := ec.ReplaceDocuments(ctx, metas.Keys(), replacements); err != nil {
 		t.Fatalf("Failed to replace documents: %s", describe(err))
 	} else {
-		if len(errs) > 0 {
-			t.Errorf("Expected 0 errors, got %d", len(errs))
+		if err := errs.FirstNonNil(); err != nil {
+			t.Errorf("Expected no errors, got %s", describe(err))
 		}
 		if len(metas) > 0 {
 			t.Errorf("Expected 0 metas, got %d", len(metas))
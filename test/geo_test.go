@@ -0,0 +1,90 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package test
+
+import (
+	"context"
+	"testing"
+
+	driver "github.com/arangodb/go-driver"
+	"github.com/arangodb/go-driver/geo"
+)
+
+type geoShop struct {
+	Name     string    `json:"name"`
+	Location geo.Point `json:"location"`
+}
+
+// TestGeoQueries stores GeoJSON points and queries them using a geo index.
+func TestGeoQueries(t *testing.T) {
+	ctx := context.Background()
+	c := createClientFromEnv(t, true)
+	db := ensureDatabase(ctx, c, "geo_test", nil, t)
+	col := ensureCollection(ctx, db, "geo_shops", nil, t)
+	if _, _, err := col.EnsureGeoIndex(ctx, []string{"location"}, &driver.EnsureGeoIndexOptions{GeoJSON: true}); err != nil {
+		t.Fatalf("Failed to create geo index: %s", describe(err))
+	}
+	if err := col.Truncate(ctx); err != nil {
+		t.Fatalf("Failed to truncate collection: %s", describe(err))
+	}
+	shops := []geoShop{
+		{"Dom", geo.NewPoint(6.9582, 50.9413)},
+		{"Hauptbahnhof", geo.NewPoint(6.9589, 50.9430)},
+		{"Bonn", geo.NewPoint(7.0982, 50.7374)},
+	}
+	if _, _, err := col.CreateDocuments(ctx, shops); err != nil {
+		t.Fatalf("Failed to create documents: %s", describe(err))
+	}
+
+	readNames := func(q interface {
+		Build() (string, map[string]interface{}, error)
+	}) []string {
+		query, bindVars, err := q.Build()
+		if err != nil {
+			t.Fatalf("Build failed: %s", err)
+		}
+		cursor, err := db.Query(ctx, query, bindVars)
+		if err != nil {
+			t.Fatalf("Query '%s' failed: %s", query, describe(err))
+		}
+		defer cursor.Close()
+		var names []string
+		for cursor.HasMore() {
+			var shop geoShop
+			if _, err := cursor.ReadDocument(ctx, &shop); err != nil {
+				t.Fatalf("ReadDocument failed: %s", describe(err))
+			}
+			names = append(names, shop.Name)
+		}
+		return names
+	}
+
+	names := readNames(geo.Near("s", col.Name(), "location", geo.NewPoint(6.9583, 50.9414), 1000).Return("s"))
+	if len(names) != 2 || names[0] != "Dom" || names[1] != "Hauptbahnhof" {
+		t.Errorf("Expected [Dom Hauptbahnhof], got %v", names)
+	}
+
+	cologne := geo.NewPolygon([]geo.Position{{6.8, 50.85}, {7.1, 50.85}, {7.1, 51.05}, {6.8, 51.05}})
+	names = readNames(geo.Contains("s", col.Name(), "location", cologne).Return("s"))
+	if len(names) != 2 {
+		t.Errorf("Expected 2 shops in Cologne, got %v", names)
+	}
+}
@@ -107,3 +107,37 @@ func TestRemoveGraph(t *testing.T) {
 		t.Errorf("GraphExists('%s') return true, expected false", name)
 	}
 }
+
+// TestRemoveGraphDropCollections creates a graph and then removes it including its collections.
+func TestRemoveGraphDropCollections(t *testing.T) {
+	c := createClientFromEnv(t, true)
+	db := ensureDatabase(nil, c, "graph_test", nil, t)
+	name := "test_remove_graph_drop_collections"
+	options := &driver.CreateGraphOptions{
+		EdgeDefinitions: []driver.EdgeDefinition{
+			{Collection: "drop_edges", From: []string{"drop_from"}, To: []string{"drop_to"}},
+		},
+		OrphanVertexCollections: []string{"drop_orphan"},
+	}
+	g, err := db.CreateGraph(nil, name, options)
+	if err != nil {
+		t.Fatalf("Failed to create graph '%s': %s", name, describe(err))
+	}
+	if defs := g.EdgeDefinitions(); len(defs) != 1 || defs[0].Collection != "drop_edges" {
+		t.Errorf("Expected edge definition 'drop_edges', got %v", defs)
+	}
+	if orphans := g.OrphanCollections(); len(orphans) != 1 || orphans[0] != "drop_orphan" {
+		t.Errorf("Expected orphan collection 'drop_orphan', got %v", orphans)
+	}
+	// Now remove it with its collections
+	if err := g.Remove(driver.WithDropCollections(nil)); err != nil {
+		t.Fatalf("Failed to remove graph '%s': %s", name, describe(err))
+	}
+	for _, colName := range []string{"drop_edges", "drop_from", "drop_to", "drop_orphan"} {
+		if found, err := db.CollectionExists(nil, colName); err != nil {
+			t.Errorf("CollectionExists('%s') failed: %s", colName, describe(err))
+		} else if found {
+			t.Errorf("CollectionExists('%s') return true, expected false", colName)
+		}
+	}
+}
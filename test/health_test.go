@@ -0,0 +1,46 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestClientHealth tests the Client.Ping, Client.Health & Client.WaitUntilReady methods.
+func TestClientHealth(t *testing.T) {
+	c := createClientFromEnv(t, true)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	require.NoError(t, c.Ping(ctx, ""))
+
+	health := c.Health(ctx)
+	require.Len(t, health, len(c.Connection().Endpoints()))
+	for _, h := range health {
+		require.True(t, h.Healthy(), "Endpoint %s is not healthy: %s", h.Endpoint, describe(h.Error))
+	}
+
+	require.NoError(t, c.WaitUntilReady(ctx, 0))
+}
@@ -23,8 +23,10 @@
 package test
 
 import (
+	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	driver "github.com/arangodb/go-driver"
 )
@@ -139,6 +141,29 @@ func TestEnsureGeoIndex(t *testing.T) {
 	}
 }
 
+// TestEnsureGeoIndexLegacyPolygons creates a geo index using the legacy polygon semantics.
+func TestEnsureGeoIndexLegacyPolygons(t *testing.T) {
+	c := createClientFromEnv(t, true)
+	skipBelowVersion(c, "3.10", t)
+	db := ensureDatabase(nil, c, "index_test", nil, t)
+	col := ensureCollection(nil, db, "geo_index_legacy_polygons_test", nil, t)
+
+	for _, legacy := range []bool{true, false} {
+		options := &driver.EnsureGeoIndexOptions{
+			GeoJSON:        true,
+			LegacyPolygons: legacy,
+			Name:           fmt.Sprintf("geo_legacy_%t", legacy),
+		}
+		idx, _, err := col.EnsureGeoIndex(nil, []string{fmt.Sprintf("geo_%t", legacy)}, options)
+		if err != nil {
+			t.Fatalf("Failed to create new index: %s", describe(err))
+		}
+		if idx.LegacyPolygons() != legacy {
+			t.Errorf("Expected legacyPolygons %t, got %t", legacy, idx.LegacyPolygons())
+		}
+	}
+}
+
 // TestEnsureHashIndex creates a collection with a hash index.
 func TestEnsureHashIndex(t *testing.T) {
 	c := createClientFromEnv(t, true)
@@ -253,6 +278,41 @@ func TestEnsurePersistentIndex(t *testing.T) {
 	}
 }
 
+// TestEnsurePersistentIndexStoredValues creates a persistent index with stored values and an in-memory cache.
+func TestEnsurePersistentIndexStoredValues(t *testing.T) {
+	c := createClientFromEnv(t, true)
+	skipBelowVersion(c, "3.10", t)
+	db := ensureDatabase(nil, c, "index_test", nil, t)
+	col := ensureCollection(nil, db, "persistent_index_stored_values_test", nil, t)
+
+	options := &driver.EnsurePersistentIndexOptions{
+		StoredValues: []string{"age"},
+		CacheEnabled: true,
+		Estimates:    boolRef(false),
+	}
+	idx, created, err := col.EnsurePersistentIndex(nil, []string{"name"}, options)
+	if err != nil {
+		t.Fatalf("Failed to create new index: %s", describe(err))
+	}
+	if !created {
+		t.Error("Expected created to be true, got false")
+	}
+
+	idx, err = col.Index(nil, idx.Name())
+	if err != nil {
+		t.Fatalf("Failed to open index '%s': %s", idx.Name(), describe(err))
+	}
+	if sv := idx.StoredValues(); len(sv) != 1 || sv[0] != "age" {
+		t.Errorf("Expected stored values [age], got %v", sv)
+	}
+	if !idx.CacheEnabled() {
+		t.Error("Expected cache to be enabled")
+	}
+	if idx.Estimates() {
+		t.Error("Expected estimates to be disabled")
+	}
+}
+
 // TestEnsureSkipListIndex creates a collection with a skiplist index.
 func TestEnsureSkipListIndex(t *testing.T) {
 	c := createClientFromEnv(t, true)
@@ -356,3 +416,47 @@ func TestEnsureTTLIndex(t *testing.T) {
 		t.Errorf("Index '%s' does exist, expected it not to exist", idx.Name())
 	}
 }
+
+// TestEnsureIndexInBackground creates a persistent index in the background and waits until it is built.
+func TestEnsureIndexInBackground(t *testing.T) {
+	c := createClientFromEnv(t, true)
+	skipBelowVersion(c, "3.5", t)
+	db := ensureDatabase(nil, c, "index_test", nil, t)
+	col := ensureCollection(nil, db, "background_index_test", nil, t)
+
+	sendBulks(t, col, nil, func(t *testing.T, i int) interface{} {
+		return UserDoc{Name: fmt.Sprintf("user_%d", i), Age: i}
+	}, 1000)
+
+	idx, _, err := col.EnsurePersistentIndex(nil, []string{"name"}, &driver.EnsurePersistentIndexOptions{InBackground: true})
+	if err != nil {
+		t.Fatalf("Failed to create new index: %s", describe(err))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	for {
+		progress, err := idx.BuildProgress(ctx)
+		if err != nil {
+			t.Fatalf("Failed to get index build progress: %s", describe(err))
+		}
+		if !progress.IsBuilding {
+			if progress.Progress != 100 {
+				t.Errorf("Expected progress 100, got %f", progress.Progress)
+			}
+			break
+		}
+		select {
+		case <-ctx.Done():
+			t.Fatalf("Index not built in time: %s", describe(ctx.Err()))
+		case <-time.After(time.Second):
+		}
+	}
+
+	if err := idx.Remove(nil); err != nil {
+		t.Fatalf("Failed to remove index '%s': %s", idx.Name(), describe(err))
+	}
+	if _, err := idx.BuildProgress(nil); !driver.IsNotFound(err) {
+		t.Errorf("Expected NotFoundError, got %s", describe(err))
+	}
+}
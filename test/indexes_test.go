@@ -129,6 +129,44 @@ func TestIndexes(t *testing.T) {
 	}
 }
 
+// TestIndexesSelectivityEstimate creates a collection with a persistent index and checks its selectivity estimate.
+func TestIndexesSelectivityEstimate(t *testing.T) {
+	c := createClientFromEnv(t, true)
+	skipBelowVersion(c, "3.5", t)
+	db := ensureDatabase(nil, c, "index_test", nil, t)
+	col := ensureCollection(nil, db, "indexes_estimate_test", nil, t)
+
+	sendBulks(t, col, nil, func(t *testing.T, i int) interface{} {
+		return UserDoc{Name: fmt.Sprintf("user_%d", i), Age: i % 10}
+	}, 100)
+
+	idx, _, err := col.EnsurePersistentIndex(nil, []string{"name"}, &driver.EnsurePersistentIndexOptions{Unique: true})
+	if err != nil {
+		t.Fatalf("Failed to create new index: %s", describe(err))
+	}
+
+	idxs, err := col.Indexes(driver.WithIndexStats(nil))
+	if err != nil {
+		t.Fatalf("Failed to get indexes: %s", describe(err))
+	}
+	found := false
+	for _, x := range idxs {
+		if x.ID() != idx.ID() {
+			continue
+		}
+		found = true
+		if x.SelectivityEstimate() != 1 {
+			t.Errorf("Expected selectivity estimate 1, got %f", x.SelectivityEstimate())
+		}
+		if x.Figures() == nil {
+			t.Error("Expected index figures, got nil")
+		}
+	}
+	if !found {
+		t.Fatal("Index not found in list")
+	}
+}
+
 // TestMultipleIndexes creates a collection with a full text index.
 func TestMultipleIndexes(t *testing.T) {
 	c := createClientFromEnv(t, true)
@@ -452,6 +490,43 @@ func TestNamedIndexes(t *testing.T) {
 	}
 }
 
+// TestIndexByName looks up and removes indexes by their user provided name.
+func TestIndexByName(t *testing.T) {
+	c := createClientFromEnv(t, true)
+	skipBelowVersion(c, "3.5", t)
+
+	db := ensureDatabase(nil, c, "named_index_test", nil, t)
+	col := ensureCollection(nil, db, "index_by_name_test_col", nil, t)
+
+	for _, testCase := range namedIndexTestCases {
+		t.Run(fmt.Sprintf("TestIndexByName%s", testCase.Name), func(t *testing.T) {
+			idx, err := testCase.CreateCallback(col, testCase.Name)
+			if err != nil {
+				t.Fatalf("Failed to create index: %s", describe(err))
+			}
+
+			idx2, err := col.IndexByName(nil, testCase.Name)
+			if err != nil {
+				t.Fatalf("Failed to get index by user name: %s", describe(err))
+			}
+			if idx2.ID() != idx.ID() {
+				t.Errorf("Expected index ID: %s, found: %s", idx.ID(), idx2.ID())
+			}
+
+			if err := col.DeleteIndexByName(nil, testCase.Name); err != nil {
+				t.Fatalf("Failed to remove index by user name: %s", describe(err))
+			}
+
+			if _, err := col.IndexByName(nil, testCase.Name); !driver.IsNotFound(err) {
+				t.Errorf("Expected NotFoundError, got %s", describe(err))
+			}
+			if err := col.DeleteIndexByName(nil, testCase.Name); !driver.IsNotFound(err) {
+				t.Errorf("Expected NotFoundError, got %s", describe(err))
+			}
+		})
+	}
+}
+
 func TestNamedIndexesClusterInventory(t *testing.T) {
 	c := createClientFromEnv(t, true)
 	skipBelowVersion(c, "3.5", t)
@@ -560,3 +635,56 @@ func TestTTLIndexesClusterInventory(t *testing.T) {
 	}
 
 }
+
+// TestIndexesTypedOptions creates indexes of several types and checks their options after listing them.
+func TestIndexesTypedOptions(t *testing.T) {
+	c := createClientFromEnv(t, true)
+	skipBelowVersion(c, "3.10", t)
+	db := ensureDatabase(nil, c, "index_test", nil, t)
+	col := ensureCollection(nil, db, "indexes_typed_options_test", nil, t)
+
+	if _, _, err := col.EnsurePersistentIndex(nil, []string{"name"}, &driver.EnsurePersistentIndexOptions{
+		Name: "persistent", Unique: true, Sparse: true, StoredValues: []string{"age"},
+	}); err != nil {
+		t.Fatalf("Failed to create persistent index: %s", describe(err))
+	}
+	if _, _, err := col.EnsureTTLIndex(nil, "createdAt", 3600, &driver.EnsureTTLIndexOptions{Name: "ttl"}); err != nil {
+		t.Fatalf("Failed to create ttl index: %s", describe(err))
+	}
+	if _, _, err := col.EnsureGeoIndex(nil, []string{"location"}, &driver.EnsureGeoIndexOptions{Name: "geo", GeoJSON: true}); err != nil {
+		t.Fatalf("Failed to create geo index: %s", describe(err))
+	}
+
+	indexes, err := col.Indexes(nil)
+	if err != nil {
+		t.Fatalf("Failed to list indexes: %s", describe(err))
+	}
+	byName := make(map[string]driver.Index)
+	for _, idx := range indexes {
+		byName[idx.UserName()] = idx
+	}
+
+	if idx := byName["persistent"]; idx == nil {
+		t.Error("Persistent index not found")
+	} else {
+		if !idx.Unique() || !idx.Sparse() {
+			t.Errorf("Expected unique and sparse index, got unique=%t sparse=%t", idx.Unique(), idx.Sparse())
+		}
+		if fields := idx.Fields(); len(fields) != 1 || fields[0] != "name" {
+			t.Errorf("Expected fields [name], got %v", fields)
+		}
+		if sv := idx.StoredValues(); len(sv) != 1 || sv[0] != "age" {
+			t.Errorf("Expected stored values [age], got %v", sv)
+		}
+	}
+	if idx := byName["ttl"]; idx == nil {
+		t.Error("TTL index not found")
+	} else if idx.ExpireAfter() != 3600 {
+		t.Errorf("Expected expireAfter 3600, got %d", idx.ExpireAfter())
+	}
+	if idx := byName["geo"]; idx == nil {
+		t.Error("Geo index not found")
+	} else if !idx.GeoJSON() {
+		t.Error("Expected geoJson to be true")
+	}
+}
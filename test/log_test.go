@@ -0,0 +1,83 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package test
+
+import (
+	"context"
+	"testing"
+
+	driver "github.com/arangodb/go-driver"
+)
+
+// TestLogLevels tests ClientLog.GetLogLevels and ClientLog.SetLogLevels.
+func TestLogLevels(t *testing.T) {
+	c := createClientFromEnv(t, true)
+	ctx := context.Background()
+
+	levels, err := c.GetLogLevels(ctx)
+	if err != nil {
+		t.Fatalf("GetLogLevels failed: %s", describe(err))
+	}
+	original, found := levels["queries"]
+	if !found {
+		t.Fatalf("Expected log topic 'queries' in %v", levels)
+	}
+	defer func() {
+		if err := c.SetLogLevels(ctx, driver.LogLevels{"queries": original}); err != nil {
+			t.Errorf("Failed to restore log level: %s", describe(err))
+		}
+	}()
+
+	if err := c.SetLogLevels(ctx, driver.LogLevels{"queries": driver.LogLevelDebug}); err != nil {
+		t.Fatalf("SetLogLevels failed: %s", describe(err))
+	}
+	levels, err = c.GetLogLevels(ctx)
+	if err != nil {
+		t.Fatalf("GetLogLevels failed: %s", describe(err))
+	}
+	if levels["queries"] != driver.LogLevelDebug {
+		t.Errorf("Expected log level '%s', got '%s'", driver.LogLevelDebug, levels["queries"])
+	}
+}
+
+// TestLogEntries tests ClientLog.LogEntries.
+func TestLogEntries(t *testing.T) {
+	c := createClientFromEnv(t, true)
+	skipBelowVersion(c, "3.8", t)
+	ctx := context.Background()
+
+	entries, err := c.LogEntries(ctx, &driver.LogEntriesFilter{
+		UpTo:           driver.LogLevelInfo,
+		Size:           5,
+		SortDescending: true,
+	})
+	if err != nil {
+		t.Fatalf("LogEntries failed: %s", describe(err))
+	}
+	if len(entries.Messages) > 5 {
+		t.Errorf("Expected at most 5 log entries, got %d", len(entries.Messages))
+	}
+	for i := 1; i < len(entries.Messages); i++ {
+		if entries.Messages[i-1].ID < entries.Messages[i].ID {
+			t.Errorf("Expected log entries in descending order")
+		}
+	}
+}
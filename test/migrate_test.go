@@ -0,0 +1,228 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	driver "github.com/arangodb/go-driver"
+	"github.com/arangodb/go-driver/migrate"
+)
+
+// TestMigrateReconcile reconciles a schema twice and checks that the second run is a no-op.
+func TestMigrateReconcile(t *testing.T) {
+	ctx := context.Background()
+	c := createClientFromEnv(t, true)
+	skipBelowVersion(c, "3.10", t)
+
+	const dbName = "migrate_reconcile_test"
+	if db, err := c.Database(ctx, dbName); err == nil {
+		require.NoError(t, db.Remove(ctx))
+	} else {
+		require.True(t, driver.IsNotFound(err), describe(err))
+	}
+
+	waitForSync := true
+	schema := migrate.Schema{
+		Databases: []migrate.Database{{
+			Name: dbName,
+			Analyzers: []driver.ArangoSearchAnalyzerDefinition{{
+				Name:       "migrate_lower",
+				Type:       driver.ArangoSearchAnalyzerTypeNorm,
+				Properties: driver.ArangoSearchAnalyzerProperties{Locale: "en", Case: driver.ArangoSearchCaseLower},
+			}},
+			Collections: []migrate.Collection{{
+				Name:       "users",
+				Properties: &driver.SetCollectionPropertiesOptions{WaitForSync: &waitForSync},
+				Indexes: []migrate.Index{
+					{Name: "byName", Type: driver.PersistentIndex, Fields: []string{"name"}, Unique: true},
+					{Name: "expiry", Type: driver.TTLIndex, Fields: []string{"expiresAt"}, ExpireAfter: 3600},
+				},
+			}},
+			Views: []migrate.View{{
+				Name: "users_view",
+				Properties: &driver.ArangoSearchViewProperties{
+					Links: driver.ArangoSearchLinks{
+						"users": driver.ArangoSearchElementProperties{Analyzers: []string{"migrate_lower"}},
+					},
+				},
+			}},
+		}},
+	}
+
+	dryRun, err := migrate.NewReconciler(c, schema, &migrate.ReconcileOptions{DryRun: true}).Reconcile(ctx)
+	require.NoError(t, err, describe(err))
+	require.Len(t, dryRun, 6)
+	exists, err := c.DatabaseExists(ctx, dbName)
+	require.NoError(t, err)
+	require.False(t, exists, "dry run must not create the database")
+
+	changes, err := migrate.NewReconciler(c, schema, nil).Reconcile(ctx)
+	require.NoError(t, err, describe(err))
+	require.Equal(t, dryRun, changes)
+
+	db, err := c.Database(ctx, dbName)
+	require.NoError(t, err)
+	col, err := db.Collection(ctx, "users")
+	require.NoError(t, err)
+	props, err := col.Properties(ctx)
+	require.NoError(t, err)
+	require.True(t, props.WaitForSync)
+	for _, name := range []string{"byName", "expiry"} {
+		found, err := col.IndexExists(ctx, name)
+		require.NoError(t, err)
+		require.True(t, found, name)
+	}
+
+	changes, err = migrate.NewReconciler(c, schema, nil).Reconcile(ctx)
+	require.NoError(t, err, describe(err))
+	require.Empty(t, changes)
+
+	// Adding a collection only creates that collection.
+	schema.Databases[0].Collections = append(schema.Databases[0].Collections, migrate.Collection{Name: "orders"})
+	changes, err = migrate.NewReconciler(c, schema, nil).Reconcile(ctx)
+	require.NoError(t, err, describe(err))
+	require.Equal(t, []migrate.Change{{Type: migrate.ChangeCreateCollection, Database: dbName, Collection: "orders", Name: "orders"}}, changes)
+
+	require.NoError(t, db.Remove(ctx))
+}
+
+// TestMigrateRunner applies and reverts versioned migrations.
+func TestMigrateRunner(t *testing.T) {
+	ctx := context.Background()
+	c := createClientFromEnv(t, true)
+	db := ensureDatabase(ctx, c, "migrate_runner_test", nil, t)
+	if col, err := db.Collection(ctx, migrate.DefaultMigrationsCollection); err == nil {
+		require.NoError(t, col.Remove(ctx))
+	}
+
+	var calls []string
+	migration := func(name string) migrate.MigrationFunc {
+		return func(ctx context.Context, db driver.Database) error {
+			calls = append(calls, name)
+			return nil
+		}
+	}
+	migrations := []migrate.Migration{
+		{Version: 1, Name: "first", Up: migration("up1"), Down: migration("down1")},
+		{Version: 2, Name: "second", Up: migration("up2"), Down: migration("down2")},
+	}
+
+	dryRun, err := migrate.NewRunner(db, migrations, &migrate.RunnerOptions{DryRun: true})
+	require.NoError(t, err)
+	pending, err := dryRun.Up(ctx)
+	require.NoError(t, err, describe(err))
+	require.Len(t, pending, 2)
+	require.Empty(t, calls)
+
+	runner, err := migrate.NewRunner(db, migrations, nil)
+	require.NoError(t, err)
+	applied, err := runner.Up(ctx)
+	require.NoError(t, err, describe(err))
+	require.Len(t, applied, 2)
+	require.Equal(t, []string{"up1", "up2"}, calls)
+
+	// Running again is a no-op.
+	applied, err = runner.Up(ctx)
+	require.NoError(t, err, describe(err))
+	require.Empty(t, applied)
+
+	list, err := runner.Applied(ctx)
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	require.Equal(t, "second", list[1].Name)
+
+	// A runner cannot run while another runner holds the lock.
+	col, err := db.Collection(ctx, migrate.DefaultMigrationsCollection)
+	require.NoError(t, err)
+	_, err = col.CreateDocument(ctx, map[string]interface{}{"_key": "lock", "owner": "other", "expiresAt": time.Now().Add(time.Hour)})
+	require.NoError(t, err)
+	_, err = runner.Down(ctx, 0)
+	require.True(t, errors.Is(err, migrate.ErrLocked), describe(err))
+	_, err = col.RemoveDocument(ctx, "lock")
+	require.NoError(t, err)
+
+	reverted, err := runner.Down(ctx, 1)
+	require.NoError(t, err, describe(err))
+	require.Len(t, reverted, 1)
+	require.Equal(t, []string{"up1", "up2", "down2"}, calls)
+
+	// A failing migration is reported and not recorded.
+	failing := append(migrations, migrate.Migration{Version: 3, Name: "failing", Up: func(ctx context.Context, db driver.Database) error {
+		return errors.New("boom")
+	}})
+	runner, err = migrate.NewRunner(db, failing, nil)
+	require.NoError(t, err)
+	applied, err = runner.Up(ctx)
+	require.Len(t, applied, 1)
+	var migrationErr *migrate.MigrationError
+	require.True(t, errors.As(err, &migrationErr))
+	require.Equal(t, int64(3), migrationErr.Version)
+	list, err = runner.Applied(ctx)
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+}
+
+// TestMigrateFulltext replaces a fulltext index by an ArangoSearch view.
+func TestMigrateFulltext(t *testing.T) {
+	ctx := context.Background()
+	c := createClientFromEnv(t, true)
+	skipBelowVersion(c, "3.10", t)
+	db := ensureDatabase(ctx, c, "migrate_fulltext_test", nil, t)
+	col := ensureCollection(ctx, db, "articles", nil, t)
+	_, _, err := col.EnsureFullTextIndex(ctx, []string{"title"}, &driver.EnsureFullTextIndexOptions{MinLength: 3})
+	require.NoError(t, err, describe(err))
+	_, err = col.CreateDocument(ctx, map[string]interface{}{"title": "Migrating Fulltext Indexes"})
+	require.NoError(t, err)
+
+	m, err := migrate.MigrateFulltext(ctx, db, col, &migrate.FulltextMigrationOptions{RemoveIndexes: true})
+	require.NoError(t, err, describe(err))
+	require.Len(t, m.Indexes, 1)
+	require.Equal(t, []string{"title"}, m.Indexes[0].Fields())
+	require.Equal(t, 3, m.Indexes[0].MinLength())
+
+	exists, err := db.ViewExists(ctx, m.ViewName)
+	require.NoError(t, err)
+	require.True(t, exists)
+	indexes, err := col.Indexes(ctx)
+	require.NoError(t, err)
+	for _, idx := range indexes {
+		require.NotEqual(t, driver.FullTextIndex, idx.Type())
+	}
+
+	query := "FOR d IN @@view SEARCH ANALYZER(d.title IN TOKENS(@word, @analyzer), @analyzer) OPTIONS { waitForSync: true } RETURN d.title"
+	cursor, err := db.Query(ctx, query, map[string]interface{}{"@view": m.ViewName, "word": "FULLTEXT", "analyzer": m.Analyzer.Name})
+	require.NoError(t, err, describe(err))
+	defer cursor.Close()
+	var titles []string
+	for cursor.HasMore() {
+		var title string
+		_, err := cursor.ReadDocument(ctx, &title)
+		require.NoError(t, err)
+		titles = append(titles, title)
+	}
+	require.Contains(t, titles, "Migrating Fulltext Indexes")
+}
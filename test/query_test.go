@@ -24,7 +24,11 @@ package test
 
 import (
 	"context"
+	"strings"
 	"testing"
+	"time"
+
+	driver "github.com/arangodb/go-driver"
 )
 
 type validateQueryTest struct {
@@ -117,3 +121,158 @@ func TestValidateQuery(t *testing.T) {
 		}
 	}
 }
+
+// TestExplainQuery explains an AQL query.
+func TestExplainQuery(t *testing.T) {
+	ctx := context.Background()
+	c := createClientFromEnv(t, true)
+	db := ensureDatabase(ctx, c, "explain_query_test", nil, t)
+	ensureCollection(ctx, db, "books", nil, t)
+
+	query := "FOR d IN books FILTER d.Title == @title RETURN d"
+	bindVars := map[string]interface{}{"title": "Book 01"}
+	result, err := db.ExplainQuery(ctx, query, bindVars, nil)
+	if err != nil {
+		t.Fatalf("Expected success, got %s", describe(err))
+	}
+	if result.Plan == nil {
+		t.Fatal("Expected plan, got nil")
+	}
+	if len(result.Plan.Nodes) == 0 {
+		t.Error("Expected plan nodes, got none")
+	}
+	if result.Plan.EstimatedCost <= 0 {
+		t.Errorf("Expected estimated cost > 0, got %f", result.Plan.EstimatedCost)
+	}
+	if len(result.Plan.Collections) != 1 || result.Plan.Collections[0].Name != "books" {
+		t.Errorf("Expected collection 'books', got %v", result.Plan.Collections)
+	}
+
+	// All plans
+	result, err = db.ExplainQuery(ctx, query, bindVars, &driver.ExplainQueryOptions{AllPlans: true})
+	if err != nil {
+		t.Fatalf("Expected success, got %s", describe(err))
+	}
+	if len(result.Plans) == 0 {
+		t.Error("Expected plans, got none")
+	}
+
+	// Invalid query
+	if _, err := db.ExplainQuery(ctx, "FOR d IN books RETURN", nil, nil); !driver.IsInvalidRequest(err) {
+		t.Errorf("Expected InvalidRequest error, got %s", describe(err))
+	}
+}
+
+// TestQueryProfile runs a query with profiling enabled.
+func TestQueryProfile(t *testing.T) {
+	ctx := context.Background()
+	c := createClientFromEnv(t, true)
+	db := ensureDatabase(ctx, c, "explain_query_test", nil, t)
+
+	cursor, err := db.Query(driver.WithQueryProfile(ctx, 2), "FOR i IN 1..10 RETURN i", nil)
+	if err != nil {
+		t.Fatalf("Expected success, got %s", describe(err))
+	}
+	defer cursor.Close()
+
+	extra := cursor.Extra()
+	if len(extra.GetProfile()) == 0 {
+		t.Error("Expected profile, got none")
+	}
+	if extra.GetPlan() == nil {
+		t.Error("Expected plan, got nil")
+	}
+	if len(extra.GetStatistics().Nodes()) == 0 {
+		t.Error("Expected node statistics, got none")
+	}
+}
+
+// TestKillQuery lists the running queries and kills a long running query.
+func TestKillQuery(t *testing.T) {
+	ctx := context.Background()
+	c := createClientFromEnv(t, true)
+	db := ensureDatabase(ctx, c, "explain_query_test", nil, t)
+
+	errCh := make(chan error, 1)
+	go func() {
+		_, err := db.Query(ctx, "RETURN SLEEP(30)", nil)
+		errCh <- err
+	}()
+
+	var queryID string
+	deadline := time.Now().Add(10 * time.Second)
+	for queryID == "" {
+		queries, err := db.ListRunningQueries(ctx)
+		if err != nil {
+			t.Fatalf("Expected success, got %s", describe(err))
+		}
+		for _, q := range queries {
+			if strings.Contains(q.Query, "SLEEP(30)") {
+				queryID = q.ID
+			}
+		}
+		if queryID == "" {
+			if time.Now().After(deadline) {
+				t.Fatal("Running query not found")
+			}
+			time.Sleep(100 * time.Millisecond)
+		}
+	}
+
+	if err := db.KillQuery(ctx, queryID); err != nil {
+		t.Fatalf("Expected success, got %s", describe(err))
+	}
+	if err := <-errCh; err == nil {
+		t.Error("Expected killed query to fail")
+	}
+	if err := db.KillQuery(ctx, queryID); !driver.IsNotFound(err) {
+		t.Errorf("Expected NotFound error, got %s", describe(err))
+	}
+
+	if _, err := db.ListSlowQueries(ctx); err != nil {
+		t.Errorf("Expected success, got %s", describe(err))
+	}
+	if err := db.ClearSlowQueries(ctx); err != nil {
+		t.Errorf("Expected success, got %s", describe(err))
+	}
+}
+
+// TestQueryCache changes the query cache properties and runs a cached query.
+func TestQueryCache(t *testing.T) {
+	ctx := context.Background()
+	c := createClientFromEnv(t, true)
+	db := ensureDatabase(ctx, c, "explain_query_test", nil, t)
+
+	original, err := db.QueryCacheProperties(ctx)
+	if err != nil {
+		t.Fatalf("Expected success, got %s", describe(err))
+	}
+	defer db.SetQueryCacheProperties(ctx, original)
+
+	props, err := db.SetQueryCacheProperties(ctx, driver.QueryCacheProperties{Mode: driver.QueryCacheModeDemand, MaxResults: 64})
+	if err != nil {
+		t.Fatalf("Expected success, got %s", describe(err))
+	}
+	if props.Mode != driver.QueryCacheModeDemand {
+		t.Errorf("Expected mode '%s', got '%s'", driver.QueryCacheModeDemand, props.Mode)
+	}
+	if props.MaxResults != 64 {
+		t.Errorf("Expected maxResults 64, got %d", props.MaxResults)
+	}
+
+	if err := db.ClearQueryCache(ctx); err != nil {
+		t.Fatalf("Expected success, got %s", describe(err))
+	}
+	ensureCollection(ctx, db, "books", nil, t)
+	query := "FOR d IN books RETURN d"
+	for i, expected := range []bool{false, true} {
+		cursor, err := db.Query(driver.WithQueryCache(ctx), query, nil)
+		if err != nil {
+			t.Fatalf("Expected success, got %s", describe(err))
+		}
+		if cursor.Cached() != expected {
+			t.Errorf("Expected cached %t in run %d, got %t", expected, i, cursor.Cached())
+		}
+		cursor.Close()
+	}
+}
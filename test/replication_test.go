@@ -23,7 +23,9 @@
 package test
 
 import (
+	"bytes"
 	"context"
+	"strings"
 	"testing"
 	"time"
 
@@ -85,3 +87,37 @@ func TestReplicationDatabaseInventory(t *testing.T) {
 		}
 	}
 }
+
+// TestReplicationDump dumps a collection using the replication dump API.
+func TestReplicationDump(t *testing.T) {
+	ctx := context.Background()
+	c := createClientFromEnv(t, true)
+	if _, err := c.Cluster(ctx); err == nil {
+		// Cluster, not supported for this test
+		t.Skip("Skipping in cluster")
+	} else if !driver.IsPreconditionFailed(err) {
+		t.Errorf("Failed to query cluster: %s", describe(err))
+	}
+	db := ensureDatabase(ctx, c, "replication_dump_test", nil, t)
+	col := ensureCollection(ctx, db, "dump", nil, t)
+	docs := []UserDoc{{Name: "Jan", Age: 40}, {Name: "Piet", Age: 41}, {Name: "Klaas", Age: 42}}
+	if _, _, err := col.CreateDocuments(ctx, docs); err != nil {
+		t.Fatalf("Failed to create documents: %s", describe(err))
+	}
+
+	rep := c.Replication()
+	batch, err := rep.CreateBatch(ctx, db, 1337, time.Second*60)
+	if err != nil {
+		t.Fatalf("CreateBatch failed: %s", describe(err))
+	}
+	defer batch.Delete(ctx)
+
+	var buf bytes.Buffer
+	if err := rep.Dump(ctx, db, batch.BatchID(), col.Name(), &driver.ReplicationDumpOptions{ChunkSize: 16}, &buf); err != nil {
+		t.Fatalf("Dump failed: %s", describe(err))
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != len(docs) {
+		t.Errorf("Expected %d documents, got %d: %s", len(docs), len(lines), buf.String())
+	}
+}
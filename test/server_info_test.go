@@ -57,3 +57,81 @@ func TestServerID(t *testing.T) {
 		}
 	}
 }
+
+// TestServerAvailability tests ClientServerInfo.Availability.
+func TestServerAvailability(t *testing.T) {
+	c := createClientFromEnv(t, true)
+	ctx := context.Background()
+
+	availability, err := c.Availability(ctx)
+	if err != nil {
+		t.Fatalf("Availability failed: %s", describe(err))
+	}
+	if !availability.Available {
+		t.Error("Expected server to be available")
+	}
+	if availability.Mode != driver.ServerModeDefault && availability.Mode != driver.ServerModeReadOnly {
+		t.Errorf("Unexpected server mode '%s'", availability.Mode)
+	}
+}
+
+// TestServerEngineInfo tests ClientServerInfo.EngineInfo.
+func TestServerEngineInfo(t *testing.T) {
+	c := createClientFromEnv(t, true)
+	ctx := context.Background()
+
+	info, err := c.EngineInfo(ctx)
+	if err != nil {
+		t.Fatalf("EngineInfo failed: %s", describe(err))
+	}
+	if info.Type != driver.EngineTypeRocksDB && info.Type != driver.EngineTypeMMFiles {
+		t.Errorf("Unexpected engine type '%s'", info.Type)
+	}
+}
+
+// TestServerSupportInfo tests ClientServerInfo.SupportInfo.
+func TestServerSupportInfo(t *testing.T) {
+	c := createClientFromEnv(t, true)
+	skipBelowVersion(c, "3.9", t)
+	ctx := context.Background()
+
+	info, err := c.SupportInfo(ctx)
+	if err != nil {
+		t.Fatalf("SupportInfo failed: %s", describe(err))
+	}
+	if info.Deployment.Type == "" {
+		t.Error("Expected deployment type to be set")
+	}
+	if info.Deployment.Type == "cluster" {
+		if len(info.Deployment.Servers) == 0 {
+			t.Error("Expected servers of cluster to be set")
+		}
+	} else if info.Host == nil || info.Host.Version == "" {
+		t.Errorf("Expected host information, got %+v", info.Host)
+	}
+}
+
+// TestServerRequireVersion tests ClientServerInfo.ServerSemVer and ClientServerInfo.RequireVersion.
+func TestServerRequireVersion(t *testing.T) {
+	c := createClientFromEnv(t, true)
+	ctx := context.Background()
+
+	info, err := c.Version(ctx)
+	if err != nil {
+		t.Fatalf("Version failed: %s", describe(err))
+	}
+	version, err := c.ServerSemVer(ctx)
+	if err != nil {
+		t.Fatalf("ServerSemVer failed: %s", describe(err))
+	}
+	if version != info.Version {
+		t.Errorf("Expected version %s, got %s", info.Version, version)
+	}
+
+	if err := c.RequireVersion(ctx, ">=3.0"); err != nil {
+		t.Errorf("RequireVersion(>=3.0) failed: %s", describe(err))
+	}
+	if err := c.RequireVersion(ctx, ">=99.0"); !driver.IsUnsupportedServerVersion(err) {
+		t.Errorf("Expected UnsupportedServerVersionError, got %s", describe(err))
+	}
+}
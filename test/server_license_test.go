@@ -0,0 +1,61 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package test
+
+import (
+	"context"
+	"testing"
+
+	driver "github.com/arangodb/go-driver"
+)
+
+// TestLicense tests ClientServerAdmin.License.
+func TestLicense(t *testing.T) {
+	c := createClientFromEnv(t, true)
+	skipBelowVersion(c, "3.9", t)
+	skipNoEnterprise(t)
+	ctx := context.Background()
+
+	license, err := c.License(ctx)
+	if err != nil {
+		t.Fatalf("License failed: %s", describe(err))
+	}
+	switch license.Status {
+	case driver.LicenseStatusGood, driver.LicenseStatusExpiring, driver.LicenseStatusExpired, driver.LicenseStatusReadOnly:
+	default:
+		t.Errorf("Unexpected license status '%s'", license.Status)
+	}
+	if license.Features.Expires == 0 {
+		t.Error("Expected license expiry to be set")
+	}
+}
+
+// TestSetLicenseInvalid tests ClientServerAdmin.SetLicense with an invalid license key.
+func TestSetLicenseInvalid(t *testing.T) {
+	c := createClientFromEnv(t, true)
+	skipBelowVersion(c, "3.9", t)
+	skipNoEnterprise(t)
+	ctx := context.Background()
+
+	if err := c.SetLicense(ctx, "invalid", false); err == nil {
+		t.Error("Expected SetLicense to fail for an invalid license key")
+	}
+}
@@ -0,0 +1,103 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package test
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+)
+
+// TestJWTSecrets tests ClientServerAdmin.JWTSecrets and RotateJWTSecrets.
+func TestJWTSecrets(t *testing.T) {
+	c := createClientFromEnv(t, true)
+	skipBelowVersion(c, "3.7", t)
+	skipNoEnterprise(t)
+	if os.Getenv("TEST_JWTSECRET") == "" {
+		t.Skip("Skipping JWT secret test because no JWT secret is configured")
+	}
+	ctx := context.Background()
+
+	secrets, err := c.JWTSecrets(ctx)
+	if err != nil {
+		t.Fatalf("JWTSecrets failed: %s", describe(err))
+	}
+	if secrets.Active == nil || secrets.Active.SHA256 == "" {
+		t.Fatalf("Expected active JWT secret, got %+v", secrets)
+	}
+	rotated, err := c.RotateJWTSecrets(ctx)
+	if err != nil {
+		t.Fatalf("RotateJWTSecrets failed: %s", describe(err))
+	}
+	if rotated.Active == nil || rotated.Active.SHA256 != secrets.Active.SHA256 {
+		t.Errorf("Expected active JWT secret to be unchanged, got %+v", rotated)
+	}
+}
+
+// TestTLS tests ClientServerAdmin.TLS and ReloadTLS.
+func TestTLS(t *testing.T) {
+	c := createClientFromEnv(t, true)
+	skipBelowVersion(c, "3.7", t)
+	skipNoEnterprise(t)
+	if endpoints := getEndpointsFromEnv(t); !strings.HasPrefix(endpoints[0], "https") && !strings.HasPrefix(endpoints[0], "ssl") {
+		t.Skip("Skipping TLS test because the server does not use TLS")
+	}
+	ctx := context.Background()
+
+	tls, err := c.TLS(ctx)
+	if err != nil {
+		t.Fatalf("TLS failed: %s", describe(err))
+	}
+	if tls.Keyfile.SHA256 == "" || len(tls.Keyfile.Certificates) == 0 {
+		t.Fatalf("Expected keyfile with certificates, got %+v", tls)
+	}
+	reloaded, err := c.ReloadTLS(ctx)
+	if err != nil {
+		t.Fatalf("ReloadTLS failed: %s", describe(err))
+	}
+	if reloaded.Keyfile.SHA256 != tls.Keyfile.SHA256 {
+		t.Errorf("Expected keyfile to be unchanged, got %+v", reloaded)
+	}
+}
+
+// TestEncryptionKeys tests ClientServerAdmin.EncryptionKeys and RotateEncryptionKeys.
+func TestEncryptionKeys(t *testing.T) {
+	c := createClientFromEnv(t, true)
+	skipBelowVersion(c, "3.7", t)
+	skipNoEnterprise(t)
+	ctx := context.Background()
+
+	keys, err := c.EncryptionKeys(ctx)
+	if err != nil {
+		t.Fatalf("EncryptionKeys failed: %s", describe(err))
+	}
+	if len(keys) == 0 {
+		t.Skip("Skipping encryption key rotation because no encryption keys are configured")
+	}
+	rotated, err := c.RotateEncryptionKeys(ctx)
+	if err != nil {
+		t.Fatalf("RotateEncryptionKeys failed: %s", describe(err))
+	}
+	if len(rotated) != len(keys) || rotated[0].SHA256 != keys[0].SHA256 {
+		t.Errorf("Expected encryption keys to be unchanged, got %+v", rotated)
+	}
+}
@@ -0,0 +1,89 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	driver "github.com/arangodb/go-driver"
+)
+
+// TestTasks creates, lists, reads and removes server-side tasks.
+func TestTasks(t *testing.T) {
+	ctx := context.Background()
+	c := createClientFromEnv(t, true)
+	db := ensureDatabase(ctx, c, "tasks_test", nil, t)
+
+	task, err := db.CreateTask(ctx, driver.TaskOptions{
+		ID:      "gotest_periodic",
+		Name:    "periodic test task",
+		Command: "(function (params) { require('@arangodb').print(params.msg); })(params)",
+		Params:  map[string]interface{}{"msg": "hello"},
+		Period:  time.Minute,
+		Offset:  time.Minute,
+	})
+	if err != nil {
+		t.Fatalf("CreateTask failed: %s", describe(err))
+	}
+	defer db.RemoveTask(ctx, task.ID)
+	if task.ID != "gotest_periodic" || task.Type != driver.TaskTypePeriodic || task.Period != time.Minute {
+		t.Errorf("Unexpected task %+v", task)
+	}
+
+	oneOff, err := db.CreateTask(ctx, driver.TaskOptions{
+		Name:    "one-off test task",
+		Command: "(function () {})()",
+		Offset:  time.Hour,
+	})
+	if err != nil {
+		t.Fatalf("CreateTask failed: %s", describe(err))
+	}
+	if oneOff.ID == "" || oneOff.Type != driver.TaskTypeTimed {
+		t.Errorf("Unexpected task %+v", oneOff)
+	}
+
+	if found, err := db.Task(ctx, task.ID); err != nil {
+		t.Errorf("Task failed: %s", describe(err))
+	} else if found.Name != task.Name {
+		t.Errorf("Expected task '%s', got '%s'", task.Name, found.Name)
+	}
+
+	tasks, err := db.Tasks(ctx)
+	if err != nil {
+		t.Fatalf("Tasks failed: %s", describe(err))
+	}
+	ids := map[string]bool{}
+	for _, x := range tasks {
+		ids[x.ID] = true
+	}
+	if !ids[task.ID] || !ids[oneOff.ID] {
+		t.Errorf("Expected tasks %s and %s, got %v", task.ID, oneOff.ID, tasks)
+	}
+
+	if err := db.RemoveTask(ctx, oneOff.ID); err != nil {
+		t.Errorf("RemoveTask failed: %s", describe(err))
+	}
+	if _, err := db.Task(ctx, oneOff.ID); !driver.IsNotFound(err) {
+		t.Errorf("Expected NotFound error, got %s", describe(err))
+	}
+}
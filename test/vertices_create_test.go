@@ -158,8 +158,8 @@ func TestCreateVerticesSilent(t *testing.T) {
 		if len(metas) != 0 {
 			t.Errorf("Expected 0 metas, got %d", len(metas))
 		}
-		if len(errs) != 0 {
-			t.Errorf("Expected 0 errors, got %d", len(errs))
+		if err := errs.FirstNonNil(); err != nil {
+			t.Errorf("Expected no errors, got %s", describe(err))
 		}
 	}
 }
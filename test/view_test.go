@@ -555,6 +555,43 @@ func TestArangoSearchViewProperties35(t *testing.T) {
 	}
 }
 
+// TestArangoSearchViewStoredValues creates an arangosearch view with stored values and checks its properties.
+func TestArangoSearchViewStoredValues(t *testing.T) {
+	ctx := context.Background()
+	c := createClientFromEnv(t, true)
+	skipBelowVersion(c, "3.7", t)
+	db := ensureDatabase(ctx, c, "view_test", nil, t)
+	ensureCollection(ctx, db, "someCol", nil, t)
+	name := "test_asview_stored_values"
+	opts := &driver.ArangoSearchViewProperties{
+		Links: driver.ArangoSearchLinks{
+			"someCol": driver.ArangoSearchElementProperties{},
+		},
+		PrimarySortCompression: driver.PrimarySortCompressionNone,
+		StoredValues: []driver.StoredValue{{
+			Fields:      []string{"name", "age"},
+			Compression: driver.PrimarySortCompressionLz4,
+		}},
+	}
+	asv, err := db.CreateArangoSearchView(ctx, name, opts)
+	if err != nil {
+		t.Fatalf("Failed to create view '%s': %s", name, describe(err))
+	}
+	p, err := asv.Properties(ctx)
+	if err != nil {
+		t.Fatalf("Properties failed: %s", describe(err))
+	}
+	if p.PrimarySortCompression != driver.PrimarySortCompressionNone {
+		t.Errorf("PrimarySortCompression expected %s, found %s", driver.PrimarySortCompressionNone, p.PrimarySortCompression)
+	}
+	if len(p.StoredValues) != 1 {
+		t.Fatalf("StoredValues expected length: %d, found %d", 1, len(p.StoredValues))
+	}
+	if sv := p.StoredValues[0]; len(sv.Fields) != 2 || sv.Compression != driver.PrimarySortCompressionLz4 {
+		t.Errorf("StoredValues not set properly: %v", sv)
+	}
+}
+
 // TestArangoSearchPrimarySort
 func TestArangoSearchPrimarySort(t *testing.T) {
 	ctx := context.Background()
@@ -766,3 +803,91 @@ func TestArangoSearchViewProperties353(t *testing.T) {
 	require.EqualValues(t, analyzer.Properties.Locale, "en_US.utf-8")
 	require.EqualValues(t, analyzer.Properties.Case, driver.ArangoSearchCaseLower)
 }
+
+// TestArangoSearchAliasView creates a search-alias view and adds/removes inverted indexes.
+func TestArangoSearchAliasView(t *testing.T) {
+	ctx := context.Background()
+	c := createClientFromEnv(t, true)
+	skipBelowVersion(c, "3.10", t)
+	db := ensureDatabase(ctx, c, "view_test", nil, t)
+	col := ensureCollection(ctx, db, "aliasCol", nil, t)
+
+	idx1, _, err := col.EnsureInvertedIndex(ctx, &driver.InvertedIndexOptions{
+		Name:   "inv_name",
+		Fields: []driver.InvertedIndexField{{Name: "name"}},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create inverted index: %s", describe(err))
+	}
+	idx2, _, err := col.EnsureInvertedIndex(ctx, &driver.InvertedIndexOptions{
+		Name:   "inv_age",
+		Fields: []driver.InvertedIndexField{{Name: "age"}},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create inverted index: %s", describe(err))
+	}
+
+	name := "test_alias_view"
+	v, err := db.CreateArangoSearchAliasView(ctx, name, &driver.ArangoSearchAliasViewProperties{
+		Indexes: []driver.ArangoSearchAliasIndex{{Collection: col.Name(), Index: idx1.UserName()}},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create view '%s': %s", name, describe(err))
+	}
+	if v.Type() != driver.ViewTypeArangoSearchAlias {
+		t.Errorf("Expected type %s, found %s", driver.ViewTypeArangoSearchAlias, v.Type())
+	}
+
+	p, err := v.AddIndexes(ctx, driver.ArangoSearchAliasIndex{Collection: col.Name(), Index: idx2.UserName()})
+	if err != nil {
+		t.Fatalf("AddIndexes failed: %s", describe(err))
+	}
+	if len(p.Indexes) != 2 {
+		t.Errorf("Expected 2 indexes, found %d", len(p.Indexes))
+	}
+
+	p, err = v.RemoveIndexes(ctx, driver.ArangoSearchAliasIndex{Collection: col.Name(), Index: idx1.UserName()})
+	if err != nil {
+		t.Fatalf("RemoveIndexes failed: %s", describe(err))
+	}
+	if len(p.Indexes) != 1 || p.Indexes[0].Index != idx2.UserName() {
+		t.Errorf("Expected only index %s, found %v", idx2.UserName(), p.Indexes)
+	}
+
+	// Get view
+	view, err := db.View(ctx, name)
+	if err != nil {
+		t.Fatalf("View('%s') failed: %s", name, describe(err))
+	}
+	if _, err := view.ArangoSearchView(); err == nil {
+		t.Error("ArangoSearchView() expected to fail for a search-alias view")
+	}
+	alias, err := view.ArangoSearchViewAlias()
+	if err != nil {
+		t.Fatalf("ArangoSearchViewAlias() failed: %s", describe(err))
+	}
+	if err := alias.SetProperties(ctx, driver.ArangoSearchAliasViewProperties{}); err != nil {
+		t.Fatalf("SetProperties failed: %s", describe(err))
+	}
+	if p, err := alias.Properties(ctx); err != nil {
+		t.Fatalf("Properties failed: %s", describe(err))
+	} else if len(p.Indexes) != 0 {
+		t.Errorf("Expected no indexes, found %d", len(p.Indexes))
+	}
+
+	// Inverted indexes must be listed with their options
+	idxs, err := col.Indexes(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get indexes: %s", describe(err))
+	}
+	for _, idx := range idxs {
+		if idx.ID() == idx1.ID() {
+			if idx.Type() != driver.InvertedIndex {
+				t.Errorf("Expected InvertedIndex, found `%s`", idx.Type())
+			}
+			if f := idx.InvertedIndexOptions().Fields; len(f) != 1 || f[0].Name != "name" {
+				t.Errorf("Expected inverted index field `name`, found %v", f)
+			}
+		}
+	}
+}
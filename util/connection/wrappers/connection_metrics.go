@@ -0,0 +1,133 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package wrappers
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/arangodb/go-driver"
+)
+
+const keyEndpoint driver.ContextKey = "arangodb-endpoint"
+
+// RequestMetrics holds the observations made for a single completed request.
+type RequestMetrics struct {
+	// Method is the HTTP method of the request.
+	Method string
+	// Path is the path of the request.
+	Path string
+	// Endpoint is the endpoint that handled the request.
+	// When no response was received, it is the endpoint the request was sent to (if known).
+	Endpoint string
+	// StatusCode is the status code of the response. Zero when no response was received.
+	StatusCode int
+	// Duration is the time it took to perform the request.
+	Duration time.Duration
+	// Err is the error returned by the connection (if any).
+	Err error
+	// Attempt is the attempt number of the request: 1 for the first attempt,
+	// higher for retries performed according to the RetryPolicy of the client.
+	Attempt int
+}
+
+// MetricsRecorder receives metrics about requests performed on a connection.
+// Implement it on top of your metrics library of choice (for example with prometheus
+// counters, gauges & histograms registered by your application).
+// Implementations must be safe for concurrent use.
+type MetricsRecorder interface {
+	// RequestStarted is called right before a request is sent. It can be used to track in-flight requests.
+	RequestStarted(method, path string)
+	// RequestFinished is called once a request has completed, successfully or not.
+	RequestFinished(m RequestMetrics)
+}
+
+// NewMetricsMiddleware creates a middleware that reports request counts, latencies,
+// in-flight requests, retries and per-endpoint errors to the given recorder.
+func NewMetricsMiddleware(r MetricsRecorder) Middleware {
+	return newMetricsMiddleware(r, nil)
+}
+
+// newMetricsMiddleware creates a metrics middleware that uses the given endpoints (if any)
+// to find the endpoint of a request that did not receive a response.
+func newMetricsMiddleware(r MetricsRecorder, endpoints func() []string) Middleware {
+	return func(next RoundTrip) RoundTrip {
+		return func(ctx context.Context, req driver.Request) (driver.Response, error) {
+			method, path := req.Method(), req.Path()
+			r.RequestStarted(method, path)
+			t := time.Now()
+			resp, err := next(ctx, req)
+			m := RequestMetrics{
+				Method:   method,
+				Path:     path,
+				Duration: time.Since(t),
+				Err:      err,
+				Attempt:  driver.RequestAttempt(ctx),
+			}
+			if resp != nil {
+				m.Endpoint = resp.Endpoint()
+				m.StatusCode = resp.StatusCode()
+			} else {
+				m.Endpoint = requestEndpoint(ctx, err, endpoints)
+			}
+			r.RequestFinished(m)
+			return resp, err
+		}
+	}
+}
+
+// NewMetricsConnection creates a connection that reports metrics of all requests to the given recorder.
+func NewMetricsConnection(c driver.Connection, r MetricsRecorder) driver.Connection {
+	return NewMiddlewareConnection(c, newMetricsMiddleware(r, c.Endpoints))
+}
+
+// requestEndpoint returns the endpoint a request that did not receive a response was sent to.
+// It is taken from the context (see driver.WithEndpoint), the error or the only endpoint of the connection.
+func requestEndpoint(ctx context.Context, err error, endpoints func() []string) string {
+	if ctx != nil {
+		if endpoint, ok := ctx.Value(keyEndpoint).(string); ok && endpoint != "" {
+			return endpoint
+		}
+	}
+	for err != nil {
+		err = driver.Cause(err)
+		if uerr, ok := err.(*url.Error); ok {
+			if u, perr := url.Parse(uerr.URL); perr == nil && u.Host != "" {
+				return u.Scheme + "://" + u.Host
+			}
+			break
+		}
+		if rerr, ok := err.(*driver.ResponseError); ok {
+			err = rerr.Err
+		} else if werr, ok := err.(interface{ Unwrap() error }); ok {
+			err = werr.Unwrap()
+		} else {
+			break
+		}
+	}
+	if endpoints != nil {
+		if list := endpoints(); len(list) == 1 {
+			return list[0]
+		}
+	}
+	return ""
+}
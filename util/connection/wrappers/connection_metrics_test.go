@@ -0,0 +1,139 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package wrappers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/arangodb/go-driver"
+	driverhttp "github.com/arangodb/go-driver/http"
+	"github.com/stretchr/testify/require"
+)
+
+type metricsTestRecorder struct {
+	mutex    sync.Mutex
+	started  []string
+	finished []RequestMetrics
+}
+
+func (r *metricsTestRecorder) RequestStarted(method, path string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.started = append(r.started, method+" "+path)
+}
+
+func (r *metricsTestRecorder) RequestFinished(m RequestMetrics) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.finished = append(r.finished, m)
+}
+
+type metricsTestConnection struct {
+	driver.Connection
+	endpoints []string
+	errs      []error
+}
+
+func (c *metricsTestConnection) Endpoints() []string { return c.endpoints }
+
+func (c *metricsTestConnection) Do(ctx context.Context, req driver.Request) (driver.Response, error) {
+	if len(c.errs) > 0 {
+		err := c.errs[0]
+		c.errs = c.errs[1:]
+		return nil, err
+	}
+	u, _ := url.Parse("http://db1:8529/_api/version")
+	resp := &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Request:    &http.Request{Method: req.Method(), URL: u},
+	}
+	return driverhttp.NewJSONResponse(resp, []byte(`{}`)), nil
+}
+
+func newMetricsTestConnection(t *testing.T, endpoints []string, errs ...error) *metricsTestConnection {
+	conn, err := driverhttp.NewConnection(driverhttp.ConnectionConfig{Endpoints: []string{"http://db1:8529"}})
+	require.NoError(t, err)
+	return &metricsTestConnection{Connection: conn, endpoints: endpoints, errs: errs}
+}
+
+func TestMetricsConnection(t *testing.T) {
+	r := &metricsTestRecorder{}
+	c := NewMetricsConnection(newMetricsTestConnection(t, []string{"http://db1:8529"}), r)
+	req, err := c.NewRequest("GET", "/_api/version")
+	require.NoError(t, err)
+	_, err = c.Do(context.Background(), req)
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"GET /_api/version"}, r.started)
+	require.Len(t, r.finished, 1)
+	m := r.finished[0]
+	require.Equal(t, "GET", m.Method)
+	require.Equal(t, "/_api/version", m.Path)
+	require.Equal(t, "http://db1:8529", m.Endpoint)
+	require.Equal(t, http.StatusOK, m.StatusCode)
+	require.Equal(t, 1, m.Attempt)
+	require.NoError(t, m.Err)
+}
+
+func TestMetricsConnectionRetries(t *testing.T) {
+	r := &metricsTestRecorder{}
+	netErr := &url.Error{Op: "Get", URL: "http://db2:8529/_api/version", Err: errors.New("connection refused")}
+	conn := NewMetricsConnection(newMetricsTestConnection(t, []string{"http://db1:8529", "http://db2:8529"}, netErr), r)
+	client, err := driver.NewClient(driver.ClientConfig{
+		Connection:  conn,
+		RetryPolicy: &driver.RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Millisecond},
+	})
+	require.NoError(t, err)
+	_, err = client.Version(context.Background())
+	require.NoError(t, err)
+
+	require.Len(t, r.finished, 2)
+	require.Equal(t, 1, r.finished[0].Attempt)
+	require.Equal(t, "http://db2:8529", r.finished[0].Endpoint, "endpoint is taken from the error")
+	require.Equal(t, 0, r.finished[0].StatusCode)
+	require.Error(t, r.finished[0].Err)
+	require.Equal(t, 2, r.finished[1].Attempt)
+	require.Equal(t, http.StatusOK, r.finished[1].StatusCode)
+}
+
+func TestMetricsConnectionEndpointWithoutResponse(t *testing.T) {
+	r := &metricsTestRecorder{}
+	failure := errors.New("failure")
+	c := NewMetricsConnection(newMetricsTestConnection(t, []string{"http://db1:8529"}, failure, failure), r)
+	req, err := c.NewRequest("GET", "/_api/version")
+	require.NoError(t, err)
+
+	_, err = c.Do(driver.WithEndpoint(context.Background(), "http://db3:8529"), req)
+	require.Error(t, err)
+	_, err = c.Do(context.Background(), req)
+	require.Error(t, err)
+
+	require.Len(t, r.finished, 2)
+	require.Equal(t, "http://db3:8529", r.finished[0].Endpoint, "endpoint is taken from the context")
+	require.Equal(t, "http://db1:8529", r.finished[1].Endpoint, "endpoint is the only endpoint of the connection")
+}
@@ -0,0 +1,96 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package wrappers
+
+import (
+	"context"
+
+	"github.com/arangodb/go-driver"
+)
+
+// RoundTrip performs a single request on a connection, returning its response.
+type RoundTrip func(ctx context.Context, req driver.Request) (driver.Response, error)
+
+// Middleware wraps a RoundTrip with additional behavior such as logging, tracing,
+// metrics or request mutation. A middleware must call next to continue the chain.
+type Middleware func(next RoundTrip) RoundTrip
+
+// NewMiddlewareConnection creates a connection that passes every request through the given
+// middlewares before it is sent using the given connection.
+// The first middleware is the outermost one, i.e. it sees the request first and the response last.
+func NewMiddlewareConnection(c driver.Connection, middlewares ...Middleware) driver.Connection {
+	m := &middlewareConnection{
+		connection:  c,
+		middlewares: middlewares,
+	}
+	m.roundTrip = chainMiddlewares(c.Do, middlewares)
+	return m
+}
+
+var _ driver.Connection = &middlewareConnection{}
+
+type middlewareConnection struct {
+	connection  driver.Connection
+	middlewares []Middleware
+	roundTrip   RoundTrip
+}
+
+// chainMiddlewares builds a single RoundTrip out of the given middlewares, ending with the given RoundTrip.
+func chainMiddlewares(last RoundTrip, middlewares []Middleware) RoundTrip {
+	rt := last
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		rt = middlewares[i](rt)
+	}
+	return rt
+}
+
+func (m *middlewareConnection) NewRequest(method, path string) (driver.Request, error) {
+	return m.connection.NewRequest(method, path)
+}
+
+func (m *middlewareConnection) Do(ctx context.Context, req driver.Request) (driver.Response, error) {
+	return m.roundTrip(ctx, req)
+}
+
+func (m *middlewareConnection) Unmarshal(data driver.RawObject, result interface{}) error {
+	return m.connection.Unmarshal(data, result)
+}
+
+func (m *middlewareConnection) Endpoints() []string {
+	return m.connection.Endpoints()
+}
+
+func (m *middlewareConnection) UpdateEndpoints(endpoints []string) error {
+	return m.connection.UpdateEndpoints(endpoints)
+}
+
+func (m *middlewareConnection) SetAuthentication(authentication driver.Authentication) (driver.Connection, error) {
+	c, err := m.connection.SetAuthentication(authentication)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewMiddlewareConnection(c, m.middlewares...), nil
+}
+
+func (m *middlewareConnection) Protocols() driver.ProtocolSet {
+	return m.connection.Protocols()
+}
@@ -0,0 +1,58 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package wrappers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/arangodb/go-driver"
+	"github.com/stretchr/testify/require"
+)
+
+type middlewareTestConnection struct {
+	driver.Connection
+	calls []string
+}
+
+func (c *middlewareTestConnection) Do(ctx context.Context, req driver.Request) (driver.Response, error) {
+	c.calls = append(c.calls, "do")
+	return nil, nil
+}
+
+func TestMiddlewareConnectionOrder(t *testing.T) {
+	conn := &middlewareTestConnection{}
+	named := func(name string) Middleware {
+		return func(next RoundTrip) RoundTrip {
+			return func(ctx context.Context, req driver.Request) (driver.Response, error) {
+				conn.calls = append(conn.calls, name+"-before")
+				resp, err := next(ctx, req)
+				conn.calls = append(conn.calls, name+"-after")
+				return resp, err
+			}
+		}
+	}
+
+	c := NewMiddlewareConnection(conn, named("a"), named("b"))
+	_, err := c.Do(context.Background(), nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a-before", "b-before", "do", "b-after", "a-after"}, conn.calls)
+}
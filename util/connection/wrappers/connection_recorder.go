@@ -0,0 +1,419 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package wrappers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sync"
+
+	"github.com/arangodb/go-driver"
+	driverhttp "github.com/arangodb/go-driver/http"
+)
+
+const (
+	keyRawResponse driver.ContextKey = "arangodb-rawResponse"
+	keyResponse    driver.ContextKey = "arangodb-response"
+)
+
+// RecorderMode specifies whether a Recorder records or replays interactions.
+type RecorderMode int
+
+const (
+	// RecorderModeRecord sends all requests to the wrapped connection and records them together with their responses.
+	RecorderModeRecord RecorderMode = iota
+	// RecorderModeReplay answers all requests with previously recorded responses.
+	// The wrapped connection is only used to create requests.
+	RecorderModeReplay
+)
+
+// redactedValue replaces redacted header values.
+const redactedValue = "REDACTED"
+
+// RecordedRequest is a request as recorded by a Recorder.
+type RecordedRequest struct {
+	Method  string            `json:"method"`
+	Path    string            `json:"path"`
+	Query   map[string]string `json:"query,omitempty"`
+	Headers map[string]string `json:"headers,omitempty"`
+	Body    json.RawMessage   `json:"body,omitempty"`
+}
+
+// RecordedResponse is a response as recorded by a Recorder.
+type RecordedResponse struct {
+	StatusCode int               `json:"statusCode"`
+	Endpoint   string            `json:"endpoint,omitempty"`
+	Headers    map[string]string `json:"headers,omitempty"`
+	Body       json.RawMessage   `json:"body,omitempty"`
+}
+
+// Interaction is a request together with the response it received.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecorderOptions holds the options of a Recorder.
+type RecorderOptions struct {
+	// Mode specifies whether to record or replay.
+	Mode RecorderMode
+	// Path of the file that interactions are saved to (record mode) or loaded from (replay mode).
+	Path string
+	// MatchBody, if set, requires the body of a request to be equal to the recorded body in replay mode.
+	// Otherwise requests are matched on method, path and query only.
+	MatchBody bool
+	// Headers are the response headers that are recorded.
+	// If not set, DefaultRecordedHeaders are recorded.
+	Headers []string
+	// Redact, if set, is called for every interaction before it is recorded.
+	// Use it to remove secrets (e.g. passwords in request bodies) from the recording.
+	// The Authorization request header is always redacted.
+	Redact func(i *Interaction)
+}
+
+// DefaultRecordedHeaders are the response headers that are recorded when RecorderOptions.Headers is not set.
+var DefaultRecordedHeaders = []string{
+	"Content-Type",
+	"Etag",
+	"Location",
+	"X-Arango-Async-Id",
+	"X-Arango-Potential-Dirty-Read",
+	"X-Arango-Queue-Time-Seconds",
+	"X-Arango-Replication-Checkmore",
+	"X-Arango-Replication-Lastincluded",
+	"X-Arango-Replication-Lastscanned",
+	"X-Arango-Replication-Frompresent",
+}
+
+// Recorder records requests and responses of a connection to a file, and replays them in tests,
+// so integration tests can run deterministically without a server.
+// Replaying is supported for JSON encoded responses only.
+type Recorder struct {
+	options RecorderOptions
+
+	mutex        sync.Mutex
+	interactions []Interaction
+	used         []bool
+}
+
+// NewRecorder creates a new Recorder.
+// In replay mode, the interactions are loaded from the file specified in the options.
+func NewRecorder(options RecorderOptions) (*Recorder, error) {
+	r := &Recorder{options: options}
+	if len(r.options.Headers) == 0 {
+		r.options.Headers = DefaultRecordedHeaders
+	}
+	if options.Mode == RecorderModeReplay {
+		data, err := ioutil.ReadFile(options.Path)
+		if err != nil {
+			return nil, driver.WithStack(err)
+		}
+		if err := json.Unmarshal(data, &r.interactions); err != nil {
+			return nil, driver.WithStack(err)
+		}
+		r.used = make([]bool, len(r.interactions))
+	}
+	return r, nil
+}
+
+// Wrap returns a connection that records (or replays) the requests made on the given connection.
+func (r *Recorder) Wrap(c driver.Connection) driver.Connection {
+	return &recorderConnection{connection: c, recorder: r}
+}
+
+// Interactions returns the interactions that have been recorded (or loaded for replaying).
+func (r *Recorder) Interactions() []Interaction {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return append([]Interaction(nil), r.interactions...)
+}
+
+// Save writes the recorded interactions to the file specified in the options.
+func (r *Recorder) Save() error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	data, err := json.MarshalIndent(r.interactions, "", "  ")
+	if err != nil {
+		return driver.WithStack(err)
+	}
+	if err := ioutil.WriteFile(r.options.Path, data, 0644); err != nil {
+		return driver.WithStack(err)
+	}
+	return nil
+}
+
+// record adds a recorded interaction for the given request and response.
+func (r *Recorder) record(req *recorderRequest, resp driver.Response) {
+	i := Interaction{
+		Request: req.recorded(),
+		Response: RecordedResponse{
+			StatusCode: resp.StatusCode(),
+			Endpoint:   resp.Endpoint(),
+			Headers:    map[string]string{},
+		},
+	}
+	if body := resp.RawBody(); len(body) > 0 && json.Valid(body) {
+		i.Response.Body = json.RawMessage(body)
+	}
+	for _, h := range r.options.Headers {
+		if v := resp.Header(h); v != "" {
+			i.Response.Headers[h] = v
+		}
+	}
+	for k := range i.Request.Headers {
+		if http.CanonicalHeaderKey(k) == "Authorization" {
+			i.Request.Headers[k] = redactedValue
+		}
+	}
+	if r.options.Redact != nil {
+		r.options.Redact(&i)
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.interactions = append(r.interactions, i)
+}
+
+// replay returns the response of the first unused interaction that matches the given request.
+func (r *Recorder) replay(req *recorderRequest) (driver.Response, error) {
+	recorded := req.recorded()
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	for idx, i := range r.interactions {
+		if r.used[idx] || !r.matches(i.Request, recorded) {
+			continue
+		}
+		r.used[idx] = true
+		endpoint, err := url.Parse(i.Response.Endpoint)
+		if err != nil {
+			return nil, driver.WithStack(err)
+		}
+		header := http.Header{}
+		for k, v := range i.Response.Headers {
+			header.Set(k, v)
+		}
+		httpResp := &http.Response{
+			StatusCode: i.Response.StatusCode,
+			Header:     header,
+			Request:    &http.Request{Method: recorded.Method, URL: endpoint},
+		}
+		body := []byte(i.Response.Body)
+		if len(body) == 0 {
+			body = []byte("{}")
+		}
+		return driverhttp.NewJSONResponse(httpResp, body), nil
+	}
+	return nil, driver.WithStack(fmt.Errorf("No recorded interaction found for %s %s", recorded.Method, recorded.Path))
+}
+
+// matches returns true when the given request matches the given recorded request.
+func (r *Recorder) matches(recorded, req RecordedRequest) bool {
+	if recorded.Method != req.Method || recorded.Path != req.Path || len(recorded.Query) != len(req.Query) {
+		return false
+	}
+	for k, v := range recorded.Query {
+		if req.Query[k] != v {
+			return false
+		}
+	}
+	if r.options.MatchBody && !bytes.Equal(compactJSON(recorded.Body), compactJSON(req.Body)) {
+		return false
+	}
+	return true
+}
+
+// compactJSON removes insignificant whitespace from the given JSON data.
+func compactJSON(data json.RawMessage) []byte {
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, data); err != nil {
+		return data
+	}
+	return buf.Bytes()
+}
+
+var _ driver.Connection = &recorderConnection{}
+
+type recorderConnection struct {
+	connection driver.Connection
+	recorder   *Recorder
+}
+
+func (c *recorderConnection) NewRequest(method, path string) (driver.Request, error) {
+	req, err := c.connection.NewRequest(method, path)
+	if err != nil {
+		return nil, err
+	}
+	return &recorderRequest{Request: req, method: method, path: path}, nil
+}
+
+func (c *recorderConnection) Do(ctx context.Context, req driver.Request) (driver.Response, error) {
+	rr, ok := req.(*recorderRequest)
+	if !ok {
+		return c.connection.Do(ctx, req)
+	}
+	if c.recorder.options.Mode == RecorderModeRecord {
+		resp, err := c.connection.Do(ctx, rr.Request)
+		if err != nil {
+			return nil, err
+		}
+		c.recorder.record(rr, resp)
+		return resp, nil
+	}
+
+	resp, err := c.recorder.replay(rr)
+	if err != nil {
+		return nil, err
+	}
+	if ctx != nil {
+		if v, ok := ctx.Value(keyRawResponse).(*[]byte); ok && v != nil {
+			*v = resp.RawBody()
+		}
+		if v, ok := ctx.Value(keyResponse).(*driver.Response); ok && v != nil {
+			*v = resp
+		}
+	}
+	return resp, nil
+}
+
+func (c *recorderConnection) Unmarshal(data driver.RawObject, result interface{}) error {
+	return c.connection.Unmarshal(data, result)
+}
+
+func (c *recorderConnection) Endpoints() []string {
+	return c.connection.Endpoints()
+}
+
+func (c *recorderConnection) UpdateEndpoints(endpoints []string) error {
+	return c.connection.UpdateEndpoints(endpoints)
+}
+
+func (c *recorderConnection) SetAuthentication(authentication driver.Authentication) (driver.Connection, error) {
+	conn, err := c.connection.SetAuthentication(authentication)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.recorder.Wrap(conn), nil
+}
+
+func (c *recorderConnection) Protocols() driver.ProtocolSet {
+	return c.connection.Protocols()
+}
+
+// recorderRequest keeps track of the query, headers and body of a request so it can be recorded.
+type recorderRequest struct {
+	driver.Request
+	method  string
+	path    string
+	query   map[string]string
+	headers map[string]string
+	body    json.RawMessage
+}
+
+func (r *recorderRequest) SetQuery(key, value string) driver.Request {
+	if r.query == nil {
+		r.query = make(map[string]string)
+	}
+	r.query[key] = value
+	r.Request.SetQuery(key, value)
+	return r
+}
+
+func (r *recorderRequest) SetHeader(key, value string) driver.Request {
+	if r.headers == nil {
+		r.headers = make(map[string]string)
+	}
+	r.headers[key] = value
+	r.Request.SetHeader(key, value)
+	return r
+}
+
+func (r *recorderRequest) SetBody(body ...interface{}) (driver.Request, error) {
+	if _, err := r.Request.SetBody(body...); err != nil {
+		return nil, err
+	}
+	if len(body) == 1 {
+		r.body = marshalRecordedBody(body[0])
+	} else {
+		r.body = marshalRecordedBody(body)
+	}
+	return r, nil
+}
+
+func (r *recorderRequest) SetBodyArray(bodyArray interface{}, mergeArray []map[string]interface{}) (driver.Request, error) {
+	if _, err := r.Request.SetBodyArray(bodyArray, mergeArray); err != nil {
+		return nil, err
+	}
+	r.body = marshalRecordedBody([]interface{}{bodyArray, mergeArray})
+	return r, nil
+}
+
+func (r *recorderRequest) SetBodyImportArray(bodyArray interface{}) (driver.Request, error) {
+	if _, err := r.Request.SetBodyImportArray(bodyArray); err != nil {
+		return nil, err
+	}
+	r.body = marshalRecordedBody(bodyArray)
+	return r, nil
+}
+
+func (r *recorderRequest) Clone() driver.Request {
+	clone := *r
+	clone.Request = r.Request.Clone()
+	clone.query = copyStringMap(r.query)
+	clone.headers = copyStringMap(r.headers)
+	return &clone
+}
+
+// recorded returns the request as it is recorded.
+func (r *recorderRequest) recorded() RecordedRequest {
+	return RecordedRequest{
+		Method:  r.method,
+		Path:    r.path,
+		Query:   copyStringMap(r.query),
+		Headers: copyStringMap(r.headers),
+		Body:    r.body,
+	}
+}
+
+// marshalRecordedBody encodes the given body as JSON, returning nil when that is not possible.
+func marshalRecordedBody(body interface{}) json.RawMessage {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil
+	}
+	return data
+}
+
+func copyStringMap(m map[string]string) map[string]string {
+	if m == nil {
+		return nil
+	}
+	result := make(map[string]string, len(m))
+	for k, v := range m {
+		result[k] = v
+	}
+	return result
+}
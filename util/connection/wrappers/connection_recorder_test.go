@@ -0,0 +1,124 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package wrappers
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/arangodb/go-driver"
+	driverhttp "github.com/arangodb/go-driver/http"
+	"github.com/stretchr/testify/require"
+)
+
+type recorderTestConnection struct {
+	driver.Connection
+	calls int
+}
+
+func (c *recorderTestConnection) Do(ctx context.Context, req driver.Request) (driver.Response, error) {
+	c.calls++
+	u, _ := url.Parse("http://localhost:8529")
+	resp := &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Request:    &http.Request{Method: req.Method(), URL: u},
+	}
+	return driverhttp.NewJSONResponse(resp, []byte(`{"name":"`+req.Path()+`"}`)), nil
+}
+
+func newRecorderTestConnection(t *testing.T) *recorderTestConnection {
+	conn, err := driverhttp.NewConnection(driverhttp.ConnectionConfig{Endpoints: []string{"http://localhost:8529"}})
+	require.NoError(t, err)
+	return &recorderTestConnection{Connection: conn}
+}
+
+func TestRecorderRecordAndReplay(t *testing.T) {
+	dir, err := ioutil.TempDir("", "recorder")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "cassette.json")
+	ctx := context.Background()
+
+	// Record
+	base := newRecorderTestConnection(t)
+	recorder, err := NewRecorder(RecorderOptions{
+		Mode: RecorderModeRecord,
+		Path: path,
+		Redact: func(i *Interaction) {
+			i.Request.Headers["X-Secret"] = "hidden"
+		},
+	})
+	require.NoError(t, err)
+	conn := recorder.Wrap(base)
+	req, err := conn.NewRequest("POST", "_api/foo")
+	require.NoError(t, err)
+	req.SetQuery("a", "1").SetHeader("Authorization", "bearer xyz").SetHeader("X-Secret", "secret")
+	_, err = req.SetBody(map[string]interface{}{"x": 1})
+	require.NoError(t, err)
+	_, err = conn.Do(ctx, req)
+	require.NoError(t, err)
+	require.NoError(t, recorder.Save())
+	require.Equal(t, 1, base.calls)
+
+	interactions := recorder.Interactions()
+	require.Len(t, interactions, 1)
+	require.Equal(t, "REDACTED", interactions[0].Request.Headers["Authorization"])
+	require.Equal(t, "hidden", interactions[0].Request.Headers["X-Secret"])
+	require.Equal(t, "application/json", interactions[0].Response.Headers["Content-Type"])
+
+	// Replay
+	base = newRecorderTestConnection(t)
+	replayer, err := NewRecorder(RecorderOptions{Mode: RecorderModeReplay, Path: path, MatchBody: true})
+	require.NoError(t, err)
+	conn = replayer.Wrap(base)
+	req, err = conn.NewRequest("POST", "_api/foo")
+	require.NoError(t, err)
+	req.SetQuery("a", "1")
+	_, err = req.SetBody(map[string]interface{}{"x": 1})
+	require.NoError(t, err)
+	var raw []byte
+	resp, err := conn.Do(driver.WithRawResponse(ctx, &raw), req)
+	require.NoError(t, err)
+	require.Equal(t, 0, base.calls)
+	require.Equal(t, http.StatusOK, resp.StatusCode())
+	require.Equal(t, "http://localhost:8529", resp.Endpoint())
+	var result struct {
+		Name string `json:"name"`
+	}
+	require.NoError(t, resp.ParseBody("", &result))
+	require.Equal(t, "_api/foo", result.Name)
+	require.JSONEq(t, `{"name":"_api/foo"}`, string(raw))
+
+	// Every interaction is replayed only once
+	req, err = conn.NewRequest("POST", "_api/foo")
+	require.NoError(t, err)
+	req.SetQuery("a", "1")
+	_, err = req.SetBody(map[string]interface{}{"x": 1})
+	require.NoError(t, err)
+	_, err = conn.Do(ctx, req)
+	require.Error(t, err)
+}
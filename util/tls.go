@@ -0,0 +1,72 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package util
+
+import (
+	"crypto/tls"
+	"sync"
+)
+
+// ClientCertificateReloader provides a client certificate for mutual TLS that can be reloaded
+// from disk without creating a new connection.
+// Use it by setting the GetClientCertificate field of the tls.Config of the connection to
+// the GetClientCertificate method of the reloader. New TLS connections use the most recently loaded certificate.
+type ClientCertificateReloader struct {
+	certFile string
+	keyFile  string
+
+	mutex sync.RWMutex
+	cert  *tls.Certificate
+}
+
+// NewClientCertificateReloader creates a reloader for the given PEM encoded certificate and key files
+// and loads them.
+func NewClientCertificateReloader(certFile, keyFile string) (*ClientCertificateReloader, error) {
+	r := &ClientCertificateReloader{
+		certFile: certFile,
+		keyFile:  keyFile,
+	}
+	if err := r.Reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Reload loads the certificate and key files again.
+// When loading fails, the previously loaded certificate remains in use.
+func (r *ClientCertificateReloader) Reload() error {
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return err
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.cert = &cert
+	return nil
+}
+
+// GetClientCertificate returns the most recently loaded certificate.
+// It is compatible with the GetClientCertificate field of tls.Config.
+func (r *ClientCertificateReloader) GetClientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	return r.cert, nil
+}
@@ -23,6 +23,7 @@
 package driver
 
 import (
+	"fmt"
 	"strconv"
 	"strings"
 )
@@ -107,3 +108,41 @@ func (v Version) CompareTo(other Version) int {
 	}
 	return 0
 }
+
+// Satisfies returns true if the version satisfies the given constraint.
+// A constraint is a comma separated list of comparisons that must all hold,
+// e.g. ">=3.10, <3.12". Supported operators are =, ==, !=, <, <=, > and >=.
+// A comparison without operator is handled as >=.
+func (v Version) Satisfies(constraint string) (bool, error) {
+	parts := strings.Split(constraint, ",")
+	for _, part := range parts {
+		part = strings.TrimSpace(part)
+		i := strings.IndexAny(part, "0123456789")
+		if i < 0 {
+			return false, WithStack(InvalidArgumentError{Message: fmt.Sprintf("invalid version constraint '%s'", constraint)})
+		}
+		op, other := strings.TrimSpace(part[:i]), Version(part[i:])
+		cmp := v.CompareTo(other)
+		var ok bool
+		switch op {
+		case "=", "==":
+			ok = cmp == 0
+		case "!=":
+			ok = cmp != 0
+		case "<":
+			ok = cmp < 0
+		case "<=":
+			ok = cmp <= 0
+		case ">":
+			ok = cmp > 0
+		case ">=", "":
+			ok = cmp >= 0
+		default:
+			return false, WithStack(InvalidArgumentError{Message: fmt.Sprintf("invalid operator '%s' in version constraint '%s'", op, constraint)})
+		}
+		if !ok {
+			return false, nil
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,56 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package driver
+
+import "testing"
+
+func TestVersionSatisfies(t *testing.T) {
+	tests := []struct {
+		version    Version
+		constraint string
+		expected   bool
+	}{
+		{"3.11.2", ">=3.11", true},
+		{"3.10.9", ">=3.11", false},
+		{"3.11.2", "3.11", true},
+		{"3.11.2", ">3.11.2", false},
+		{"3.11.2", "<3.12", true},
+		{"3.11.2", "<=3.11.1", false},
+		{"3.11.2", "==3.11.2", true},
+		{"3.11.2", "!=3.11.2", false},
+		{"3.11.2", ">=3.10, <3.12", true},
+		{"3.12.0", ">=3.10, <3.12", false},
+	}
+	for _, test := range tests {
+		ok, err := test.version.Satisfies(test.constraint)
+		if err != nil {
+			t.Errorf("Satisfies(%q) of %s failed: %v", test.constraint, test.version, err)
+		} else if ok != test.expected {
+			t.Errorf("Expected %s satisfies %q to be %v, got %v", test.version, test.constraint, test.expected, ok)
+		}
+	}
+
+	for _, constraint := range []string{"", ">=", "~3.11", ">=3.10,"} {
+		if _, err := Version("3.11.0").Satisfies(constraint); !IsInvalidArgument(err) {
+			t.Errorf("Expected invalid argument error for %q, got %v", constraint, err)
+		}
+	}
+}
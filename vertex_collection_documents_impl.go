@@ -38,10 +38,27 @@ func (c *vertexCollection) DocumentExists(ctx context.Context, key string) (bool
 	}
 }
 
+// DocumentsExist checks for every given key if a document with that key exists in the collection.
+func (c *vertexCollection) DocumentsExist(ctx context.Context, keys []string) ([]bool, error) {
+	result, err := c.rawCollection().DocumentsExist(ctx, keys)
+	if err != nil {
+		return nil, WithStack(err)
+	}
+	return result, nil
+}
+
 // ReadDocument reads a single document with given key from the collection.
 // The document data is stored into result, the document meta data is returned.
 // If no document exists with given key, a NotFoundError is returned.
 func (c *vertexCollection) ReadDocument(ctx context.Context, key string, result interface{}) (DocumentMeta, error) {
+	if _, ok := projectionFromContext(ctx); ok {
+		// Projections are not supported by the graph API, so read from the collection itself.
+		meta, err := c.rawCollection().ReadDocument(ctx, key, result)
+		if err != nil {
+			return DocumentMeta{}, WithStack(err)
+		}
+		return meta, nil
+	}
 	meta, _, err := c.readDocument(ctx, key, result)
 	if err != nil {
 		return DocumentMeta{}, WithStack(err)
@@ -120,12 +137,13 @@ func (c *vertexCollection) ReadDocuments(ctx context.Context, keys []string, res
 		meta, cs, err := c.readDocument(ctx, key, result.Interface())
 		if cs.Silent {
 			silent = true
+			errs[i] = err
 		} else {
 			metas[i], errs[i] = meta, err
 		}
 	}
 	if silent {
-		return nil, nil, nil
+		return nil, errs, nil
 	}
 	return metas, errs, nil
 }
@@ -213,12 +231,13 @@ func (c *vertexCollection) CreateDocuments(ctx context.Context, documents interf
 		meta, cs, err := c.createDocument(ctx, doc.Interface())
 		if cs.Silent {
 			silent = true
+			errs[i] = err
 		} else {
 			metas[i], errs[i] = meta, err
 		}
 	}
 	if silent {
-		return nil, nil, nil
+		return nil, errs, nil
 	}
 	return metas, errs, nil
 }
@@ -332,12 +351,13 @@ func (c *vertexCollection) UpdateDocuments(ctx context.Context, keys []string, u
 		meta, cs, err := c.updateDocument(ctx, key, update.Interface())
 		if cs.Silent {
 			silent = true
+			errs[i] = err
 		} else {
 			metas[i], errs[i] = meta, err
 		}
 	}
 	if silent {
-		return nil, nil, nil
+		return nil, errs, nil
 	}
 	return metas, errs, nil
 }
@@ -451,16 +471,28 @@ func (c *vertexCollection) ReplaceDocuments(ctx context.Context, keys []string,
 		meta, cs, err := c.replaceDocument(ctx, key, doc.Interface())
 		if cs.Silent {
 			silent = true
+			errs[i] = err
 		} else {
 			metas[i], errs[i] = meta, err
 		}
 	}
 	if silent {
-		return nil, nil, nil
+		return nil, errs, nil
 	}
 	return metas, errs, nil
 }
 
+// UpsertDocument inserts the insert document when no document in the collection matches all attributes
+// of the search document, otherwise it updates the matching document with the update document.
+// The document meta data is returned, together with true when a document was inserted and false when it was updated.
+func (c *vertexCollection) UpsertDocument(ctx context.Context, search, insert, update interface{}) (DocumentMeta, bool, error) {
+	meta, inserted, err := c.rawCollection().UpsertDocument(ctx, search, insert, update)
+	if err != nil {
+		return DocumentMeta{}, false, WithStack(err)
+	}
+	return meta, inserted, nil
+}
+
 // RemoveDocument removes a single document with given key from the collection.
 // The document meta data is returned.
 // To return the OLD document, prepare a context with `WithReturnOld`.
@@ -536,12 +568,13 @@ func (c *vertexCollection) RemoveDocuments(ctx context.Context, keys []string) (
 		meta, cs, err := c.removeDocument(ctx, key)
 		if cs.Silent {
 			silent = true
+			errs[i] = err
 		} else {
 			metas[i], errs[i] = meta, err
 		}
 	}
 	if silent {
-		return nil, nil, nil
+		return nil, errs, nil
 	}
 	return metas, errs, nil
 }
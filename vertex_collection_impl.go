@@ -109,6 +109,15 @@ func (c *vertexCollection) Revision(ctx context.Context) (string, error) {
 	return result, nil
 }
 
+// Checksum calculates a checksum of the collection.
+func (c *vertexCollection) Checksum(ctx context.Context, withRevisions bool, withData bool) (CollectionChecksum, error) {
+	result, err := c.rawCollection().Checksum(ctx, withRevisions, withData)
+	if err != nil {
+		return CollectionChecksum{}, WithStack(err)
+	}
+	return result, nil
+}
+
 // Properties fetches extended information about the collection.
 func (c *vertexCollection) Properties(ctx context.Context) (CollectionProperties, error) {
 	result, err := c.rawCollection().Properties(ctx)
@@ -126,6 +135,15 @@ func (c *vertexCollection) SetProperties(ctx context.Context, options SetCollect
 	return nil
 }
 
+// Shards fetches the shards of the collection together with its properties.
+func (c *vertexCollection) Shards(ctx context.Context, details bool) (CollectionShards, error) {
+	result, err := c.rawCollection().Shards(ctx, details)
+	if err != nil {
+		return CollectionShards{}, WithStack(err)
+	}
+	return result, nil
+}
+
 // Load the collection into memory.
 func (c *vertexCollection) Load(ctx context.Context) error {
 	if err := c.rawCollection().Load(ctx); err != nil {
@@ -166,3 +184,29 @@ func (c *vertexCollection) Truncate(ctx context.Context) error {
 	}
 	return nil
 }
+
+// Compact compacts the data of the collection to reclaim disk space.
+func (c *vertexCollection) Compact(ctx context.Context) error {
+	if err := c.rawCollection().Compact(ctx); err != nil {
+		return WithStack(err)
+	}
+	return nil
+}
+
+// LoadIndexesIntoMemory loads the indexes of the collection into memory.
+func (c *vertexCollection) LoadIndexesIntoMemory(ctx context.Context) (bool, error) {
+	result, err := c.rawCollection().LoadIndexesIntoMemory(ctx)
+	if err != nil {
+		return false, WithStack(err)
+	}
+	return result, nil
+}
+
+// Edges returns a cursor over all edges in this (edge) collection that are connected to the given vertex.
+func (c *vertexCollection) Edges(ctx context.Context, vertex DocumentID, direction EdgeDirection) (Cursor, error) {
+	result, err := c.rawCollection().Edges(ctx, vertex, direction)
+	if err != nil {
+		return nil, WithStack(err)
+	}
+	return result, nil
+}
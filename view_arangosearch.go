@@ -183,6 +183,35 @@ type ArangoSearchViewProperties struct {
 
 	// PrimarySort describes how individual fields are sorted
 	PrimarySort []ArangoSearchPrimarySortEntry `json:"primarySort,omitempty"`
+
+	// PrimarySortCompression defines how to compress the primary sort data.
+	// This option is immutable.
+	// Available from 3.7 arangod version.
+	PrimarySortCompression PrimarySortCompression `json:"primarySortCompression,omitempty"`
+
+	// StoredValues defines attribute paths which are stored in the view index, in addition to the ones
+	// in PrimarySort, so they can be returned by queries without fetching the documents.
+	// This option is immutable.
+	// Available from 3.7 arangod version.
+	StoredValues []StoredValue `json:"storedValues,omitempty"`
+}
+
+// PrimarySortCompression defines how to compress the primary sort data of a view.
+type PrimarySortCompression string
+
+const (
+	// PrimarySortCompressionLz4 uses LZ4 fast compression (default).
+	PrimarySortCompressionLz4 PrimarySortCompression = "lz4"
+	// PrimarySortCompressionNone disables compression to trade space for speed.
+	PrimarySortCompressionNone PrimarySortCompression = "none"
+)
+
+// StoredValue defines a set of attribute paths that are stored together in a view.
+type StoredValue struct {
+	// Fields is a slice of attribute paths.
+	Fields []string `json:"fields,omitempty"`
+	// Compression defines how to compress the stored values.
+	Compression PrimarySortCompression `json:"compression,omitempty"`
 }
 
 // ArangoSearchSortDirection describes the sorting direction
@@ -0,0 +1,74 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package driver
+
+import (
+	"context"
+)
+
+// ArangoSearchViewAlias provides access to the information of a view of type search-alias.
+// Views of type search-alias are only available in ArangoDB 3.10 and higher.
+type ArangoSearchViewAlias interface {
+	// Include generic View functions
+	View
+
+	// Properties fetches extended information about the view.
+	Properties(ctx context.Context) (ArangoSearchAliasViewProperties, error)
+
+	// SetProperties replaces the properties of the view.
+	// All inverted indexes that are not part of the given properties are removed from the view.
+	SetProperties(ctx context.Context, options ArangoSearchAliasViewProperties) error
+
+	// AddIndexes adds the given inverted indexes to the view.
+	// Indexes that are already part of the view are left untouched.
+	AddIndexes(ctx context.Context, indexes ...ArangoSearchAliasIndex) (ArangoSearchAliasViewProperties, error)
+
+	// RemoveIndexes removes the given inverted indexes from the view.
+	RemoveIndexes(ctx context.Context, indexes ...ArangoSearchAliasIndex) (ArangoSearchAliasViewProperties, error)
+}
+
+// ArangoSearchAliasViewProperties contains properties of a search-alias view.
+type ArangoSearchAliasViewProperties struct {
+	// Indexes is a list of inverted indexes that are part of the view.
+	Indexes []ArangoSearchAliasIndex `json:"indexes,omitempty"`
+}
+
+// ArangoSearchAliasOperation specifies what is done with an inverted index when the properties of a
+// search-alias view are partially updated.
+type ArangoSearchAliasOperation string
+
+const (
+	// ArangoSearchAliasOperationAdd adds the index to the view.
+	ArangoSearchAliasOperationAdd ArangoSearchAliasOperation = "add"
+	// ArangoSearchAliasOperationDelete removes the index from the view.
+	ArangoSearchAliasOperationDelete ArangoSearchAliasOperation = "del"
+)
+
+// ArangoSearchAliasIndex references an inverted index that is part of a search-alias view.
+type ArangoSearchAliasIndex struct {
+	// Collection is the name of the collection containing the inverted index.
+	Collection string `json:"collection"`
+	// Index is the name of the inverted index.
+	Index string `json:"index"`
+	// Operation is only used when the properties of the view are partially updated.
+	// AddIndexes and RemoveIndexes set it automatically.
+	Operation ArangoSearchAliasOperation `json:"operation,omitempty"`
+}
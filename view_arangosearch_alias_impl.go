@@ -0,0 +1,114 @@
+//
+// DISCLAIMER
+//
+// Copyright 2020 ArangoDB GmbH, Cologne, Germany
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright holder is ArangoDB GmbH, Cologne, Germany
+//
+
+package driver
+
+import (
+	"context"
+	"path"
+)
+
+// viewArangoSearchAlias implements ArangoSearchViewAlias
+type viewArangoSearchAlias struct {
+	view
+}
+
+// Properties fetches extended information about the view.
+func (v *viewArangoSearchAlias) Properties(ctx context.Context) (ArangoSearchAliasViewProperties, error) {
+	req, err := v.conn.NewRequest("GET", path.Join(v.relPath(), "properties"))
+	if err != nil {
+		return ArangoSearchAliasViewProperties{}, WithStack(err)
+	}
+	applyContextSettings(ctx, req)
+	resp, err := v.conn.Do(ctx, req)
+	if err != nil {
+		return ArangoSearchAliasViewProperties{}, WithStack(err)
+	}
+	if err := resp.CheckStatus(200); err != nil {
+		return ArangoSearchAliasViewProperties{}, WithStack(err)
+	}
+	var data ArangoSearchAliasViewProperties
+	if err := resp.ParseBody("", &data); err != nil {
+		return ArangoSearchAliasViewProperties{}, WithStack(err)
+	}
+	return data, nil
+}
+
+// SetProperties replaces the properties of the view.
+func (v *viewArangoSearchAlias) SetProperties(ctx context.Context, options ArangoSearchAliasViewProperties) error {
+	if _, err := v.updateProperties(ctx, "PUT", options); err != nil {
+		return WithStack(err)
+	}
+	return nil
+}
+
+// AddIndexes adds the given inverted indexes to the view.
+func (v *viewArangoSearchAlias) AddIndexes(ctx context.Context, indexes ...ArangoSearchAliasIndex) (ArangoSearchAliasViewProperties, error) {
+	result, err := v.updateProperties(ctx, "PATCH", withAliasOperation(indexes, ArangoSearchAliasOperationAdd))
+	if err != nil {
+		return ArangoSearchAliasViewProperties{}, WithStack(err)
+	}
+	return result, nil
+}
+
+// RemoveIndexes removes the given inverted indexes from the view.
+func (v *viewArangoSearchAlias) RemoveIndexes(ctx context.Context, indexes ...ArangoSearchAliasIndex) (ArangoSearchAliasViewProperties, error) {
+	result, err := v.updateProperties(ctx, "PATCH", withAliasOperation(indexes, ArangoSearchAliasOperationDelete))
+	if err != nil {
+		return ArangoSearchAliasViewProperties{}, WithStack(err)
+	}
+	return result, nil
+}
+
+// updateProperties sends the given properties to the view using the given method (PUT replaces, PATCH updates).
+func (v *viewArangoSearchAlias) updateProperties(ctx context.Context, method string, options ArangoSearchAliasViewProperties) (ArangoSearchAliasViewProperties, error) {
+	req, err := v.conn.NewRequest(method, path.Join(v.relPath(), "properties"))
+	if err != nil {
+		return ArangoSearchAliasViewProperties{}, WithStack(err)
+	}
+	if _, err := req.SetBody(options); err != nil {
+		return ArangoSearchAliasViewProperties{}, WithStack(err)
+	}
+	applyContextSettings(ctx, req)
+	resp, err := v.conn.Do(ctx, req)
+	if err != nil {
+		return ArangoSearchAliasViewProperties{}, WithStack(err)
+	}
+	if err := resp.CheckStatus(200); err != nil {
+		return ArangoSearchAliasViewProperties{}, WithStack(err)
+	}
+	var data ArangoSearchAliasViewProperties
+	if err := resp.ParseBody("", &data); err != nil {
+		return ArangoSearchAliasViewProperties{}, WithStack(err)
+	}
+	return data, nil
+}
+
+// withAliasOperation returns view properties containing the given indexes with the given operation.
+func withAliasOperation(indexes []ArangoSearchAliasIndex, op ArangoSearchAliasOperation) ArangoSearchAliasViewProperties {
+	result := ArangoSearchAliasViewProperties{
+		Indexes: make([]ArangoSearchAliasIndex, len(indexes)),
+	}
+	for i, idx := range indexes {
+		idx.Operation = op
+		result.Indexes[i] = idx
+	}
+	return result
+}
@@ -80,6 +80,15 @@ func (v *view) ArangoSearchView() (ArangoSearchView, error) {
 	return &viewArangoSearch{view: *v}, nil
 }
 
+// ArangoSearchViewAlias returns this view as an ArangoSearch view of type search-alias.
+// When the type of the view is not search-alias, an error is returned.
+func (v *view) ArangoSearchViewAlias() (ArangoSearchViewAlias, error) {
+	if v.viewType != ViewTypeArangoSearchAlias {
+		return nil, WithStack(newArangoError(http.StatusConflict, 0, fmt.Sprintf("Type must be '%s', got '%s'", ViewTypeArangoSearchAlias, v.viewType)))
+	}
+	return &viewArangoSearchAlias{view: *v}, nil
+}
+
 // Database returns the database containing the view.
 func (v *view) Database() Database {
 	return v.db
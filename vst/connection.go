@@ -49,6 +49,9 @@ type ConnectionConfig struct {
 	Endpoints []string
 	// TLSConfig holds settings used to configure a TLS (HTTPS) connection.
 	// This is only used for endpoints using the HTTPS scheme.
+	// Client certificates (mutual TLS), custom CA pools, the minimum TLS version and the
+	// server name (SNI) can all be configured here. Use util.ClientCertificateReloader to reload
+	// client certificates without creating a new connection.
 	TLSConfig *tls.Config
 	// Transport allows the use of a custom round tripper.
 	// If Transport is not of type `*http.Transport`, the `TLSConfig` property is not used.
@@ -152,6 +155,7 @@ func (c *vstConnection) do(ctx context.Context, req driver.Request, transport me
 	if !ok {
 		return nil, driver.WithStack(driver.InvalidArgumentError{Message: "request is not a *vstRequest"})
 	}
+	driver.ApplyQueueTimeSettings(ctx, vstReq)
 	msgParts, err := vstReq.createMessageParts()
 	if err != nil {
 		return nil, driver.WithStack(err)
@@ -191,6 +195,9 @@ func (c *vstConnection) do(ctx context.Context, req driver.Request, transport me
 		fmt.Printf("Cannot decode msg %d: %#v\n", msg.ID, err)
 		return nil, driver.WithStack(err)
 	}
+	if err := driver.LoadQueueTimeResponse(ctx, vstResp); err != nil {
+		return nil, driver.WithStack(err)
+	}
 	if ctx != nil {
 		if v := ctx.Value(keyResponse); v != nil {
 			if respPtr, ok := v.(*driver.Response); ok {